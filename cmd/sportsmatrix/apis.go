@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	stockboard "github.com/robbydyer/sports/internal/board/stocks"
+	"github.com/robbydyer/sports/internal/espnboard"
+	"github.com/robbydyer/sports/internal/mlb"
+	"github.com/robbydyer/sports/internal/nhl"
+)
+
+// apiCache keeps the APIs built for the boards. Boards rebuilt after a config reload reuse
+// them, rather than starting another set of cache clearing crons and fetching teams and
+// logos again.
+type apiCache struct {
+	apis map[string]interface{}
+	sync.Mutex
+}
+
+// cachedAPI returns the API cached under key, or builds and caches it
+func cachedAPI[T any](r *rootArgs, key string, build func() (T, error)) (T, error) {
+	r.apis.Lock()
+	defer r.apis.Unlock()
+
+	if api, ok := r.apis.apis[key].(T); ok {
+		return api, nil
+	}
+
+	api, err := build()
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+
+	if r.apis.apis == nil {
+		r.apis.apis = make(map[string]interface{})
+	}
+	r.apis.apis[key] = api
+
+	return api, nil
+}
+
+func (r *rootArgs) espnAPI(ctx context.Context, logger *zap.Logger, league string, newAPI func(context.Context, *zap.Logger, ...espnboard.Option) (*espnboard.ESPNBoard, error)) (*espnboard.ESPNBoard, error) {
+	return cachedAPI(r, "espn_"+league, func() (*espnboard.ESPNBoard, error) {
+		return newAPI(ctx, logger, r.espnOpts...)
+	})
+}
+
+// espnLeagueAPI returns the API of a configured ESPN league. A changed league config gets a new API.
+func (r *rootArgs) espnLeagueAPI(ctx context.Context, logger *zap.Logger, l espnboard.Leaguer, cfg *espnboard.LeagueConfig) (*espnboard.ESPNBoard, error) {
+	key, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cachedAPI(r, "espn_league_"+string(key), func() (*espnboard.ESPNBoard, error) {
+		return espnboard.NewLeague(ctx, l, logger, r.espnOpts...)
+	})
+}
+
+func (r *rootArgs) nhlAPI(ctx context.Context, logger *zap.Logger) (*nhl.NHL, error) {
+	return cachedAPI(r, "nhl", func() (*nhl.NHL, error) {
+		return nhl.New(ctx, logger)
+	})
+}
+
+func (r *rootArgs) mlbAPI(ctx context.Context, logger *zap.Logger) (*mlb.MLB, error) {
+	return cachedAPI(r, "mlb", func() (*mlb.MLB, error) {
+		return mlb.New(ctx, logger)
+	})
+}
+
+// stockAPI returns the stock board's quote provider. Fixtures aren't cached, so that a
+// rebuilt board reads its fixture file again.
+func (r *rootArgs) stockAPI(logger *zap.Logger) (stockboard.API, error) {
+	cfg := r.config.StocksConfig
+	provider := strings.ToLower(cfg.Provider)
+	if provider == "fixture" {
+		return getStockAPI(cfg, logger)
+	}
+
+	return cachedAPI(r, fmt.Sprintf("stocks_%s_%s", provider, cfg.APIKey), func() (stockboard.API, error) {
+		return getStockAPI(cfg, logger)
+	})
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedAPI(t *testing.T) {
+	t.Parallel()
+
+	r := &rootArgs{
+		apis: &apiCache{},
+	}
+	builds := 0
+	build := func() (*int, error) {
+		builds++
+		i := builds
+		return &i, nil
+	}
+
+	_, err := cachedAPI(r, "one", func() (*int, error) {
+		return nil, fmt.Errorf("failed")
+	})
+	require.Error(t, err)
+
+	first, err := cachedAPI(r, "one", build)
+	require.NoError(t, err)
+	again, err := cachedAPI(r, "one", build)
+	require.NoError(t, err)
+	require.Same(t, first, again)
+
+	other, err := cachedAPI(r, "two", build)
+	require.NoError(t, err)
+	require.NotSame(t, first, other)
+	require.Equal(t, 2, builds)
+}
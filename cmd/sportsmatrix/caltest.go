@@ -84,7 +84,11 @@ func (c *calCmd) run(cmd *cobra.Command, args []string) error {
 	var canvases []board.Canvas
 	var matrix matrix.Matrix
 	if c.rArgs.test {
-		matrix = c.rArgs.getTestMatrix(logger)
+		var err error
+		matrix, err = c.rArgs.getTestMatrix(logger)
+		if err != nil {
+			return err
+		}
 	} else {
 		var err error
 		matrix, err = c.rArgs.getRGBMatrix(logger)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"image"
 	"os"
+	"strings"
 	"time"
 
 	yaml "github.com/ghodss/yaml"
@@ -17,26 +18,35 @@ import (
 	"github.com/robbydyer/sports/internal/board"
 	calendarboard "github.com/robbydyer/sports/internal/board/calendar"
 	"github.com/robbydyer/sports/internal/board/clock"
+	fantasyboard "github.com/robbydyer/sports/internal/board/fantasy"
+	golfboard "github.com/robbydyer/sports/internal/board/golf"
 	imageboard "github.com/robbydyer/sports/internal/board/image"
+	layoutboard "github.com/robbydyer/sports/internal/board/layout"
 	racingboard "github.com/robbydyer/sports/internal/board/racing"
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	standingsboard "github.com/robbydyer/sports/internal/board/standings"
 	statboard "github.com/robbydyer/sports/internal/board/stat"
 	stockboard "github.com/robbydyer/sports/internal/board/stocks"
 	sysboard "github.com/robbydyer/sports/internal/board/sys"
+	tennisboard "github.com/robbydyer/sports/internal/board/tennis"
 	textboard "github.com/robbydyer/sports/internal/board/text"
 	weatherboard "github.com/robbydyer/sports/internal/board/weather"
 	"github.com/robbydyer/sports/internal/config"
 	"github.com/robbydyer/sports/internal/espnboard"
+	"github.com/robbydyer/sports/internal/espngolf"
 	"github.com/robbydyer/sports/internal/espnracing"
+	"github.com/robbydyer/sports/internal/espntennis"
+	"github.com/robbydyer/sports/internal/finnhub"
 	"github.com/robbydyer/sports/internal/gcal"
 	"github.com/robbydyer/sports/internal/logo"
 	"github.com/robbydyer/sports/internal/matrix"
-	"github.com/robbydyer/sports/internal/mlb"
 	"github.com/robbydyer/sports/internal/mlblive"
-	"github.com/robbydyer/sports/internal/nhl"
 	"github.com/robbydyer/sports/internal/openweather"
+	"github.com/robbydyer/sports/internal/output"
 	"github.com/robbydyer/sports/internal/pga"
 	rgb "github.com/robbydyer/sports/internal/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/internal/sleeper"
+	"github.com/robbydyer/sports/internal/sportlive"
 	"github.com/robbydyer/sports/internal/sportsmatrix"
 	"github.com/robbydyer/sports/internal/yahoo"
 )
@@ -51,12 +61,16 @@ type rootArgs struct {
 	configFile   string
 	config       *config.Config
 	test         bool
+	testMatrix   string
 	today        string
 	logFile      string
 	writer       *os.File
 	alternateAPI bool
 	debug        bool
 	todayT       *time.Time
+	espnOpts     []espnboard.Option
+	eventBus     *sportsmatrix.EventBus
+	apis         *apiCache
 }
 
 func main() {
@@ -144,6 +158,7 @@ func newRootCmd(args *rootArgs) *cobra.Command {
 	f.StringVarP(&args.configFile, "config", "c", defaultConfigFile, "Config filename")
 	f.StringVarP(&args.level, "log-level", "l", "info", "Log level. 'info', 'warn', 'debug'")
 	f.BoolVarP(&args.test, "test", "t", false, "uses a test console matrix")
+	f.StringVar(&args.testMatrix, "test-matrix", "console", "Type of matrix used with --test. 'console', 'terminal' (24-bit color), 'framebuffer' (/dev/fb0). Use --log-file with 'terminal' so logs don't garble it")
 	f.StringVar(&args.today, "date-str", "", "Set the date of 'Today' for testing past days. Format 2020-01-30")
 	f.StringVarP(&args.logFile, "log-file", "f", "", "Write logs to given file instead of STDOUT")
 	f.BoolVarP(&args.alternateAPI, "alt-api", "a", false, "Use alternative API's where available")
@@ -160,6 +175,8 @@ func newRootCmd(args *rootArgs) *cobra.Command {
 	rootCmd.AddCommand(newWeatherCmd(args))
 	rootCmd.AddCommand(newCalCmd(args))
 	rootCmd.AddCommand(newGcalSetupCmd(args))
+	rootCmd.AddCommand(newReplayCmd(args))
+	rootCmd.AddCommand(newReceiveCmd(args))
 
 	return rootCmd
 }
@@ -170,16 +187,28 @@ func (r *rootArgs) setConfig(filename string) error {
 		return err
 	}
 
-	var c *config.Config
-
-	if err := yaml.Unmarshal(f, &c); err != nil {
-		return fmt.Errorf("failed to parse config file: %w", err)
+	c, err := parseConfig(f)
+	if err != nil {
+		return err
 	}
 
 	r.config = c
 	return nil
 }
 
+func parseConfig(contents []byte) (*config.Config, error) {
+	var c *config.Config
+
+	if err := yaml.Unmarshal(contents, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if c == nil {
+		c = &config.Config{}
+	}
+
+	return c, nil
+}
+
 func (r *rootArgs) setConfigDefaults() {
 	if r.config.SportsMatrixConfig == nil {
 		r.config.SportsMatrixConfig = &sportsmatrix.Config{}
@@ -202,6 +231,7 @@ func (r *rootArgs) setConfigDefaults() {
 		}
 	}
 
+	setStandingsDefaults(r.config.NHLConfig)
 	r.config.NHLConfig.SetDefaults()
 	r.config.NHLConfig.Stats.SetDefaults()
 	r.config.NHLConfig.Headlines.SetDefaults()
@@ -235,6 +265,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.MLBConfig)
 	r.config.MLBConfig.SetDefaults()
 	r.config.MLBConfig.Stats.SetDefaults()
 	r.config.MLBConfig.Headlines.SetDefaults()
@@ -249,6 +280,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.NCAAMConfig)
 	r.config.NCAAMConfig.SetDefaults()
 	r.config.NCAAMConfig.Headlines.SetDefaults()
 
@@ -262,6 +294,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.NCAAFConfig)
 	r.config.NCAAFConfig.SetDefaults()
 	r.config.NCAAFConfig.Headlines.SetDefaults()
 
@@ -275,6 +308,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.NBAConfig)
 	r.config.NBAConfig.SetDefaults()
 	r.config.NBAConfig.Headlines.SetDefaults()
 
@@ -288,6 +322,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.NFLConfig)
 	r.config.NFLConfig.SetDefaults()
 	r.config.NFLConfig.Headlines.SetDefaults()
 
@@ -301,6 +336,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.MLSConfig)
 	r.config.MLSConfig.SetDefaults()
 	r.config.MLSConfig.Headlines.SetDefaults()
 
@@ -314,6 +350,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.EPLConfig)
 	r.config.EPLConfig.SetDefaults()
 	r.config.EPLConfig.Headlines.SetDefaults()
 
@@ -327,6 +364,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.DFLConfig)
 	r.config.DFLConfig.SetDefaults()
 	r.config.DFLConfig.Headlines.SetDefaults()
 
@@ -340,6 +378,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.DFBConfig)
 	r.config.DFBConfig.SetDefaults()
 	r.config.DFBConfig.Headlines.SetDefaults()
 
@@ -353,6 +392,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.UEFAConfig)
 	r.config.UEFAConfig.SetDefaults()
 	r.config.UEFAConfig.Headlines.SetDefaults()
 
@@ -366,6 +406,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.FIFAConfig)
 	r.config.FIFAConfig.SetDefaults()
 	r.config.FIFAConfig.Headlines.SetDefaults()
 
@@ -388,6 +429,34 @@ func (r *rootArgs) setConfigDefaults() {
 	r.config.PGA.SetDefaults()
 	r.config.PGA.Teams = append(r.config.PGA.Teams, "players")
 
+	if r.config.PGAConfig == nil {
+		r.config.PGAConfig = &golfboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.PGAConfig.SetDefaults()
+
+	if r.config.LPGAConfig == nil {
+		r.config.LPGAConfig = &golfboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.LPGAConfig.SetDefaults()
+
+	if r.config.DPWorldConfig == nil {
+		r.config.DPWorldConfig = &golfboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.DPWorldConfig.SetDefaults()
+
+	if r.config.ChampionsConfig == nil {
+		r.config.ChampionsConfig = &golfboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.ChampionsConfig.SetDefaults()
+
 	if r.config.StocksConfig == nil {
 		r.config.StocksConfig = &stockboard.Config{
 			StartEnabled: atomic.NewBool(false),
@@ -402,6 +471,27 @@ func (r *rootArgs) setConfigDefaults() {
 	}
 	r.config.WeatherConfig.SetDefaults()
 
+	if r.config.FantasyConfig == nil {
+		r.config.FantasyConfig = &fantasyboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.FantasyConfig.SetDefaults()
+
+	if r.config.ATPConfig == nil {
+		r.config.ATPConfig = &tennisboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.ATPConfig.SetDefaults()
+
+	if r.config.WTAConfig == nil {
+		r.config.WTAConfig = &tennisboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.WTAConfig.SetDefaults()
+
 	if r.config.F1Config == nil {
 		r.config.F1Config = &racingboard.Config{
 			StartEnabled: atomic.NewBool(false),
@@ -416,6 +506,27 @@ func (r *rootArgs) setConfigDefaults() {
 	}
 	r.config.IRLConfig.SetDefaults()
 
+	if r.config.NASCARConfig == nil {
+		r.config.NASCARConfig = &racingboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.NASCARConfig.SetDefaults()
+
+	if r.config.XfinityConfig == nil {
+		r.config.XfinityConfig = &racingboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.XfinityConfig.SetDefaults()
+
+	if r.config.MotoGPConfig == nil {
+		r.config.MotoGPConfig = &racingboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	r.config.MotoGPConfig.SetDefaults()
+
 	if r.config.CalenderConfig == nil {
 		r.config.CalenderConfig = &calendarboard.Config{
 			StartEnabled: atomic.NewBool(false),
@@ -433,6 +544,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.NCAAWConfig)
 	r.config.NCAAWConfig.SetDefaults()
 	r.config.NCAAWConfig.Headlines.SetDefaults()
 
@@ -446,6 +558,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.WNBAConfig)
 	r.config.WNBAConfig.SetDefaults()
 	r.config.WNBAConfig.Headlines.SetDefaults()
 
@@ -460,6 +573,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.LigueConfig)
 	r.config.LigueConfig.SetDefaults()
 	r.config.LigueConfig.Headlines.SetDefaults()
 
@@ -473,6 +587,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.SerieaConfig)
 	r.config.SerieaConfig.SetDefaults()
 	r.config.SerieaConfig.Headlines.SetDefaults()
 
@@ -486,6 +601,7 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.LaligaConfig)
 	r.config.LaligaConfig.SetDefaults()
 	r.config.LaligaConfig.Headlines.SetDefaults()
 
@@ -499,8 +615,39 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	setStandingsDefaults(r.config.XFLConfig)
 	r.config.XFLConfig.SetDefaults()
 	r.config.XFLConfig.Headlines.SetDefaults()
+
+	for _, l := range r.config.ESPNLeagues {
+		if l.Board == nil {
+			l.Board = &sportboard.Config{
+				StartEnabled: atomic.NewBool(false),
+			}
+		}
+		if l.Board.Headlines == nil {
+			l.Board.Headlines = &textboard.Config{
+				StartEnabled: atomic.NewBool(false),
+			}
+		}
+		setStandingsDefaults(l.Board)
+		l.Board.SetDefaults()
+		l.Board.Headlines.SetDefaults()
+	}
+
+	for _, l := range r.config.Layouts {
+		l.SetDefaults()
+	}
+}
+
+// setStandingsDefaults sets the defaults of a league's standings board, which starts disabled
+func setStandingsDefaults(c *sportboard.Config) {
+	if c.Standings == nil {
+		c.Standings = &standingsboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
+	c.Standings.SetDefaults()
 }
 
 func (r *rootArgs) getRGBMatrix(logger *zap.Logger) (matrix.Matrix, error) {
@@ -512,11 +659,7 @@ func (r *rootArgs) getRGBMatrix(logger *zap.Logger) (matrix.Matrix, error) {
 		zap.String("Mapping", r.config.SportsMatrixConfig.HardwareConfig.HardwareMapping),
 	)
 
-	// If we have configured the http server to listen on a privileged port (like 80),
-	// we need to maintain root permissions
-	if r.config.SportsMatrixConfig.HTTPListenPort < 1024 {
-		r.config.SportsMatrixConfig.RuntimeOptions.DropPrivileges = -1
-	}
+	keepPrivileges(r.config.SportsMatrixConfig)
 
 	var err error
 	matrix, err = rgb.NewRGBLedMatrix(r.config.SportsMatrixConfig.HardwareConfig, r.config.SportsMatrixConfig.RuntimeOptions, logger)
@@ -524,83 +667,146 @@ func (r *rootArgs) getRGBMatrix(logger *zap.Logger) (matrix.Matrix, error) {
 	return matrix, err
 }
 
-func (r *rootArgs) getTestMatrix(logger *zap.Logger) matrix.Matrix {
-	logger.Info("initializing console matrix",
+// keepPrivileges keeps root permissions if the http server is configured to
+// listen on a privileged port (like 80)
+func keepPrivileges(cfg *sportsmatrix.Config) {
+	if cfg.HTTPListenPort < 1024 {
+		cfg.RuntimeOptions.DropPrivileges = -1
+	}
+}
+
+// getStockAPI returns the configured quote provider of the stock board
+func getStockAPI(cfg *stockboard.Config, logger *zap.Logger) (stockboard.API, error) {
+	switch strings.ToLower(cfg.Provider) {
+	case "yahoo":
+		return yahoo.New(logger)
+	case "finnhub":
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("missing apiKey for the finnhub stock provider")
+		}
+		return finnhub.New(cfg.APIKey, logger)
+	case "fixture":
+		return stockboard.NewFixtureAPI(cfg.FixtureFile)
+	default:
+		return nil, fmt.Errorf("unsupported stock provider '%s'", cfg.Provider)
+	}
+}
+
+func (r *rootArgs) getTestMatrix(logger *zap.Logger) (matrix.Matrix, error) {
+	logger.Info("initializing test matrix",
+		zap.String("Type", r.testMatrix),
 		zap.Int("Cols", r.config.SportsMatrixConfig.HardwareConfig.Cols),
 		zap.Int("Rows", r.config.SportsMatrixConfig.HardwareConfig.Rows),
 	)
-	return matrix.NewConsoleMatrix(r.config.SportsMatrixConfig.HardwareConfig.Cols, r.config.SportsMatrixConfig.HardwareConfig.Rows, os.Stdout, logger)
+	return output.New(&output.Config{
+		Name:   "test",
+		Type:   r.testMatrix,
+		Width:  r.config.SportsMatrixConfig.HardwareConfig.Cols,
+		Height: r.config.SportsMatrixConfig.HardwareConfig.Rows,
+	}, logger)
 }
 
 func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.Board, error) {
-	bounds := image.Rect(0, 0, r.config.SportsMatrixConfig.HardwareConfig.Cols, r.config.SportsMatrixConfig.HardwareConfig.Rows)
+	sections, err := r.getSectionBoards(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
 
-	var boards []board.Board
+	boards := sections.boards()
 
-	nhlAPI, err := nhl.New(ctx, logger)
+	layouts, err := r.getLayouts(boards, logger)
 	if err != nil {
-		logger.Error("nhl setup failed", zap.Error(err))
+		return nil, err
 	}
-	mlbAPI, err := mlb.New(ctx, logger)
-	if err != nil {
-		logger.Error("mlb setup failed", zap.Error(err))
+
+	return append(boards, layouts...), nil
+}
+
+// getSectionBoards builds the boards of each section of the config, except for layouts
+func (r *rootArgs) getSectionBoards(ctx context.Context, logger *zap.Logger) (sectionBoards, error) {
+	bounds := image.Rect(0, 0, r.config.SportsMatrixConfig.HardwareConfig.Cols, r.config.SportsMatrixConfig.HardwareConfig.Rows)
+
+	boards := sectionBoards{}
+
+	if r.eventBus == nil {
+		r.eventBus = sportsmatrix.NewEventBus(logger)
+	}
+	if r.apis == nil {
+		r.apis = &apiCache{}
 	}
 
-	if r.config.NHLConfig != nil && nhlAPI != nil {
+	if r.config.NHLConfig != nil {
 		var api sportboard.API
+		var err error
 		if r.alternateAPI {
-			api, err = nhl.New(ctx, logger)
-			if err != nil {
-				return nil, err
-			}
+			api, err = r.nhlAPI(ctx, logger)
 		} else {
-			api, err = espnboard.NewNHL(ctx, logger)
-			if err != nil {
-				return boards, err
-			}
+			api, err = r.espnAPI(ctx, logger, "nhl", espnboard.NewNHL)
+		}
+		if err != nil {
+			return nil, err
 		}
 		l, err := espnboard.GetLeaguer("nhl")
 		if err != nil {
 			return nil, err
 		}
 		headlineAPI := espnboard.NewHeadlines(l, logger)
-		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NHLConfig,
+		opts := []sportboard.OptionFunc{
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
-		)
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+		}
+		if !r.alternateAPI {
+			opts = append(opts, sportboard.WithDetailedLiveRenderer(hockeyLiveRenderer(logger)))
+		}
+		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NHLConfig, opts...)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("nhlConfig", b)
 		if r.config.NHLConfig.Stats != nil {
+			nhlAPI, err := r.nhlAPI(ctx, logger)
+			if err != nil {
+				return nil, err
+			}
 			b, err := statboard.New(ctx, nhlAPI, r.config.NHLConfig.Stats, logger)
 			if err != nil {
 				return nil, err
 			}
 
-			boards = append(boards, b)
+			boards.add("nhlConfig", b)
 		}
 		if r.config.NHLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NHLConfig.Headlines, logger, textboard.WithHalfSizeLogo())
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("nhlConfig", b)
+		}
+		if r.config.NHLConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.NHLConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("nhlConfig", b)
+			}
 		}
 	}
 
 	if r.config.MLBConfig != nil {
 		var api sportboard.API
 		var opts []sportboard.OptionFunc
+		var err error
 		if r.alternateAPI {
-			api, err = mlb.New(ctx, logger)
+			api, err = r.mlbAPI(ctx, logger)
 			if err != nil {
 				return nil, err
 			}
 		} else {
-			api, err = espnboard.NewMLB(ctx, logger)
+			api, err = r.espnAPI(ctx, logger, "mlb", espnboard.NewMLB)
 			if err != nil {
-				return boards, err
+				return nil, err
 			}
 
 			m := &mlblive.MlbLive{
@@ -624,33 +830,49 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 			return nil, err
 		}
 		headlineAPI := espnboard.NewHeadlines(l, logger)
-		opts = append(opts, sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo))
+		opts = append(opts,
+			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+		)
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.MLBConfig, opts...)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("mlbConfig", b)
 		if r.config.MLBConfig.Stats != nil {
+			mlbAPI, err := r.mlbAPI(ctx, logger)
+			if err != nil {
+				return nil, err
+			}
 			b, err := statboard.New(ctx, mlbAPI, r.config.MLBConfig.Stats, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("mlbConfig", b)
 		}
 		if r.config.MLBConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.MLBConfig.Headlines, logger, textboard.WithHalfSizeLogo())
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("mlbConfig", b)
+		}
+		if r.config.MLBConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.MLBConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("mlbConfig", b)
+			}
 		}
 	}
 	if r.config.NCAAMConfig != nil {
-		api, err := espnboard.NewNCAAMensBasketball(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "ncaam", espnboard.NewNCAAMensBasketball)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
 		l, err := espnboard.GetLeaguer("ncaam")
 		if err != nil {
@@ -660,24 +882,35 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NCAAMConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+			sportboard.WithDetailedLiveRenderer(basketballLiveRenderer(logger)),
 		)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("ncaamConfig", b)
 		if r.config.NCAAMConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NCAAMConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("ncaamConfig", b)
+		}
+		if r.config.NCAAMConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.NCAAMConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("ncaamConfig", b)
+			}
 		}
 	}
 	if r.config.NCAAFConfig != nil {
-		api, err := espnboard.NewNCAAF(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "ncaaf", espnboard.NewNCAAF)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
 
 		l, err := espnboard.GetLeaguer("ncaaf")
@@ -688,22 +921,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NCAAFConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+			sportboard.WithDetailedLiveRenderer(footballLiveRenderer(logger)),
 		)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("ncaafConfig", b)
 		if r.config.NCAAFConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NCAAFConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("ncaafConfig", b)
+		}
+		if r.config.NCAAFConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.NCAAFConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("ncaafConfig", b)
+			}
 		}
 	}
 	if r.config.NBAConfig != nil {
-		api, err := espnboard.NewNBA(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "nba", espnboard.NewNBA)
 		if err != nil {
 			return nil, err
 		}
@@ -715,22 +959,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NBAConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+			sportboard.WithDetailedLiveRenderer(basketballLiveRenderer(logger)),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("nbaConfig", b)
 		if r.config.NBAConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NBAConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("nbaConfig", b)
+		}
+		if r.config.NBAConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.NBAConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("nbaConfig", b)
+			}
 		}
 	}
 	if r.config.NFLConfig != nil {
-		api, err := espnboard.NewNFL(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "nfl", espnboard.NewNFL)
 		if err != nil {
 			return nil, err
 		}
@@ -742,22 +997,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NFLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+			sportboard.WithDetailedLiveRenderer(footballLiveRenderer(logger)),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("nflConfig", b)
 		if r.config.NFLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NFLConfig.Headlines, logger, textboard.WithHalfSizeLogo())
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("nflConfig", b)
+		}
+		if r.config.NFLConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.NFLConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("nflConfig", b)
+			}
 		}
 	}
 	if r.config.MLSConfig != nil {
-		api, err := espnboard.NewMLS(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "mls", espnboard.NewMLS)
 		if err != nil {
 			return nil, err
 		}
@@ -769,22 +1035,32 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.MLSConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("mlsConfig", b)
 		if r.config.MLSConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.MLSConfig.Headlines, logger, textboard.WithHalfSizeLogo())
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("mlsConfig", b)
+		}
+		if r.config.MLSConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.MLSConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("mlsConfig", b)
+			}
 		}
 	}
 	if r.config.EPLConfig != nil {
-		api, err := espnboard.NewEPL(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "epl", espnboard.NewEPL)
 		if err != nil {
 			return nil, err
 		}
@@ -796,23 +1072,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.EPLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("eplConfig", b)
 		if r.config.EPLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.EPLConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("eplConfig", b)
+		}
+		if r.config.EPLConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.EPLConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("eplConfig", b)
+			}
 		}
 	}
 
 	if r.config.DFLConfig != nil {
-		api, err := espnboard.NewDFL(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "dfl", espnboard.NewDFL)
 		if err != nil {
 			return nil, err
 		}
@@ -824,23 +1110,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.DFLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("dflConfig", b)
 		if r.config.DFLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.DFLConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("dflConfig", b)
+		}
+		if r.config.DFLConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.DFLConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("dflConfig", b)
+			}
 		}
 	}
 
 	if r.config.DFBConfig != nil {
-		api, err := espnboard.NewDFB(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "dfb", espnboard.NewDFB)
 		if err != nil {
 			return nil, err
 		}
@@ -852,23 +1148,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.DFBConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("dfbConfig", b)
 		if r.config.DFBConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.DFBConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("dfbConfig", b)
+		}
+		if r.config.DFBConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.DFBConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("dfbConfig", b)
+			}
 		}
 	}
 
 	if r.config.UEFAConfig != nil {
-		api, err := espnboard.NewUEFA(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "uefa", espnboard.NewUEFA)
 		if err != nil {
 			return nil, err
 		}
@@ -880,23 +1186,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.UEFAConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("uefaConfig", b)
 		if r.config.UEFAConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.UEFAConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("uefaConfig", b)
+		}
+		if r.config.UEFAConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.UEFAConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("uefaConfig", b)
+			}
 		}
 	}
 
 	if r.config.FIFAConfig != nil {
-		api, err := espnboard.NewFIFA(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "fifa", espnboard.NewFIFA)
 		if err != nil {
 			return nil, err
 		}
@@ -908,43 +1224,53 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.FIFAConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("fifaConfig", b)
 		if r.config.FIFAConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.FIFAConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("fifaConfig", b)
+		}
+		if r.config.FIFAConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.FIFAConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("fifaConfig", b)
+			}
 		}
 	}
 
 	if r.config.ImageConfig != nil {
 		b, err := imageboard.New(r.config.ImageConfig, logger)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
-		boards = append(boards, b)
+		boards.add("imageConfig", b)
 	}
 
 	if r.config.ClockConfig != nil {
 		b, err := clock.New(r.config.ClockConfig, logger)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
-		boards = append(boards, b)
+		boards.add("clockConfig", b)
 	}
 
 	if r.config.SysConfig != nil {
 		b, err := sysboard.New(logger, r.config.SysConfig)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
-		boards = append(boards, b)
+		boards.add("sysConfig", b)
 	}
 
 	if r.config.PGA != nil {
@@ -955,7 +1281,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 				update = d
 			}
 		}
-		api, err := pga.New(logger, update)
+		api, err := cachedAPI(r, fmt.Sprintf("pga_%s", update), func() (*pga.PGA, error) {
+			return pga.New(logger, update)
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -967,20 +1295,71 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
-		boards = append(boards, b)
+		boards.add("pga", b)
 	}
 
-	if r.config.StocksConfig != nil {
-		api, err := yahoo.New(logger)
+	if r.config.PGAConfig != nil {
+		api, err := espngolf.New(&espngolf.PGA{}, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := golfboard.New(api, r.config.PGAConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+		boards.add("pgaConfig", b)
+	}
+
+	if r.config.LPGAConfig != nil {
+		api, err := espngolf.New(&espngolf.LPGA{}, logger)
 		if err != nil {
 			return nil, err
 		}
-		b, err := stockboard.New(api, r.config.StocksConfig, logger)
+		b, err := golfboard.New(api, r.config.LPGAConfig, logger)
 		if err != nil {
 			return nil, err
 		}
+		boards.add("lpgaConfig", b)
+	}
 
-		boards = append(boards, b)
+	if r.config.DPWorldConfig != nil {
+		api, err := espngolf.New(&espngolf.DPWorld{}, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := golfboard.New(api, r.config.DPWorldConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+		boards.add("dpWorldConfig", b)
+	}
+
+	if r.config.ChampionsConfig != nil {
+		api, err := espngolf.New(&espngolf.Champions{}, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := golfboard.New(api, r.config.ChampionsConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+		boards.add("championsConfig", b)
+	}
+
+	if r.config.StocksConfig != nil {
+		api, err := r.stockAPI(logger)
+		if err != nil {
+			logger.Warn("Stocks Board will not be enabled",
+				zap.Error(err),
+			)
+		} else {
+			b, err := stockboard.New(api, r.config.StocksConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+
+			boards.add("stocksConfig", b)
+		}
 	}
 
 	if r.config.WeatherConfig != nil {
@@ -995,10 +1374,55 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("weatherConfig", b)
+		}
+	}
+
+	if r.config.FantasyConfig != nil {
+		switch {
+		case r.config.FantasyConfig.LeagueID == "":
+			logger.Warn("Missing fantasy league ID. Fantasy Board will not be enabled")
+		case r.config.FantasyConfig.Provider == "sleeper":
+			api, err := sleeper.New(r.config.FantasyConfig.LeagueID, logger)
+			if err != nil {
+				return nil, err
+			}
+			b, err := fantasyboard.New(api, r.config.FantasyConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards.add("fantasyConfig", b)
+		default:
+			logger.Warn("unsupported fantasy provider. Fantasy Board will not be enabled",
+				zap.String("provider", r.config.FantasyConfig.Provider),
+			)
 		}
 	}
 
+	if r.config.ATPConfig != nil {
+		api, err := espntennis.New(&espntennis.ATP{}, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := tennisboard.New(api, r.config.ATPConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+		boards.add("atpConfig", b)
+	}
+
+	if r.config.WTAConfig != nil {
+		api, err := espntennis.New(&espntennis.WTA{}, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := tennisboard.New(api, r.config.WTAConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+		boards.add("wtaConfig", b)
+	}
+
 	if r.config.F1Config != nil {
 		api, err := espnracing.New(&espnracing.F1{}, logger)
 		if err != nil {
@@ -1008,7 +1432,7 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
-		boards = append(boards, b)
+		boards.add("f1Config", b)
 	}
 
 	if r.config.IRLConfig != nil {
@@ -1020,7 +1444,43 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
-		boards = append(boards, b)
+		boards.add("irlConfig", b)
+	}
+
+	if r.config.NASCARConfig != nil {
+		api, err := espnracing.New(&espnracing.NASCAR{}, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := racingboard.New(api, logger, r.config.NASCARConfig)
+		if err != nil {
+			return nil, err
+		}
+		boards.add("nascarConfig", b)
+	}
+
+	if r.config.XfinityConfig != nil {
+		api, err := espnracing.New(&espnracing.Xfinity{}, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := racingboard.New(api, logger, r.config.XfinityConfig)
+		if err != nil {
+			return nil, err
+		}
+		boards.add("xfinityConfig", b)
+	}
+
+	if r.config.MotoGPConfig != nil {
+		api, err := espnracing.New(&espnracing.MotoGP{}, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := racingboard.New(api, logger, r.config.MotoGPConfig)
+		if err != nil {
+			return nil, err
+		}
+		boards.add("motoGPConfig", b)
 	}
 
 	if r.config.CalenderConfig != nil {
@@ -1032,13 +1492,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
-		boards = append(boards, b)
+		boards.add("calendarConfig", b)
 	}
 
 	if r.config.NCAAWConfig != nil {
-		api, err := espnboard.NewNCAAWomensBasketball(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "ncaaw", espnboard.NewNCAAWomensBasketball)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
 		l, err := espnboard.GetLeaguer("ncaaw")
 		if err != nil {
@@ -1048,23 +1508,34 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NCAAWConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+			sportboard.WithDetailedLiveRenderer(basketballLiveRenderer(logger)),
 		)
 		if err != nil {
-			return boards, err
+			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("ncaawConfig", b)
 		if r.config.NCAAWConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NCAAWConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("ncaawConfig", b)
+		}
+		if r.config.NCAAWConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.NCAAWConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("ncaawConfig", b)
+			}
 		}
 	}
 
 	if r.config.WNBAConfig != nil {
-		api, err := espnboard.NewWNBA(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "wnba", espnboard.NewWNBA)
 		if err != nil {
 			return nil, err
 		}
@@ -1076,23 +1547,34 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.WNBAConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+			sportboard.WithDetailedLiveRenderer(basketballLiveRenderer(logger)),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("wnbaConfig", b)
 		if r.config.WNBAConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.WNBAConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("wnbaConfig", b)
+		}
+		if r.config.WNBAConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.WNBAConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("wnbaConfig", b)
+			}
 		}
 	}
 
 	if r.config.LigueConfig != nil {
-		api, err := espnboard.NewLigue(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "ligue", espnboard.NewLigue)
 		if err != nil {
 			return nil, err
 		}
@@ -1104,23 +1586,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.LigueConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("ligueConfig", b)
 		if r.config.LigueConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.LigueConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("ligueConfig", b)
+		}
+		if r.config.LigueConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.LigueConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("ligueConfig", b)
+			}
 		}
 	}
 
 	if r.config.SerieaConfig != nil {
-		api, err := espnboard.NewSerieA(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "seriea", espnboard.NewSerieA)
 		if err != nil {
 			return nil, err
 		}
@@ -1132,23 +1624,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.SerieaConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("serieaConfig", b)
 		if r.config.SerieaConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.SerieaConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("serieaConfig", b)
+		}
+		if r.config.SerieaConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.SerieaConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("serieaConfig", b)
+			}
 		}
 	}
 
 	if r.config.LaligaConfig != nil {
-		api, err := espnboard.NewLaLiga(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "laliga", espnboard.NewLaLiga)
 		if err != nil {
 			return nil, err
 		}
@@ -1160,23 +1662,33 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		headlineAPI := espnboard.NewHeadlines(l, logger)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.LaligaConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("laligaConfig", b)
 		if r.config.LaligaConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.LaligaConfig.Headlines, logger)
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("laligaConfig", b)
+		}
+		if r.config.LaligaConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.LaligaConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("laligaConfig", b)
+			}
 		}
 	}
 
 	if r.config.XFLConfig != nil {
-		api, err := espnboard.NewXFL(ctx, logger)
+		api, err := r.espnAPI(ctx, logger, "xfl", espnboard.NewXFL)
 		if err != nil {
 			return nil, err
 		}
@@ -1188,20 +1700,178 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.XFLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+			sportboard.WithDetailedLiveRenderer(footballLiveRenderer(logger)),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		boards = append(boards, b)
+		boards.add("xflConfig", b)
 		if r.config.XFLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.XFLConfig.Headlines, logger, textboard.WithHalfSizeLogo())
 			if err != nil {
 				return nil, err
 			}
-			boards = append(boards, b)
+			boards.add("xflConfig", b)
+		}
+		if r.config.XFLConfig.Standings != nil {
+			b, err := getStandingsBoard(api, r.config.XFLConfig, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("xflConfig", b)
+			}
+		}
+	}
+
+	for _, league := range r.config.ESPNLeagues {
+		l, err := espnboard.RegisterLeague(&league.LeagueConfig)
+		if err != nil {
+			return nil, err
+		}
+		api, err := r.espnLeagueAPI(ctx, logger, l, &league.LeagueConfig)
+		if err != nil {
+			return nil, err
+		}
+		headlineAPI := espnboard.NewHeadlines(l, logger)
+
+		opts := []sportboard.OptionFunc{
+			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+			sportboard.WithGameUpdateNotifier(r.eventBus.GameUpdated),
+		}
+		if renderer := espnLiveRenderer(l.APIPath(), logger); renderer != nil {
+			opts = append(opts, renderer)
+		}
+
+		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, league.Board, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		boards.add("espnLeagues", b)
+		if league.Board.Headlines != nil {
+			b, err := textboard.New(headlineAPI, league.Board.Headlines, logger, textboard.WithHalfSizeLogo())
+			if err != nil {
+				return nil, err
+			}
+			boards.add("espnLeagues", b)
+		}
+		if league.Board.Standings != nil {
+			b, err := getStandingsBoard(api, league.Board, logger)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				boards.add("espnLeagues", b)
+			}
 		}
 	}
 
 	return boards, nil
 }
+
+// espnLiveRenderer returns the detailed live renderer for the sport of an ESPN API path, or nil
+// for sports without one
+func espnLiveRenderer(apiPath string, logger *zap.Logger) sportboard.OptionFunc {
+	switch strings.Split(apiPath, "/")[0] {
+	case "football":
+		return sportboard.WithDetailedLiveRenderer(footballLiveRenderer(logger))
+	case "hockey":
+		return sportboard.WithDetailedLiveRenderer(hockeyLiveRenderer(logger))
+	case "basketball":
+		return sportboard.WithDetailedLiveRenderer(basketballLiveRenderer(logger))
+	}
+
+	return nil
+}
+
+// getStandingsBoard returns the standings board for a sport, or nil when the sport's API doesn't have standings
+func getStandingsBoard(api sportboard.API, cfg *sportboard.Config, logger *zap.Logger) (board.Board, error) {
+	standingsAPI, ok := api.(standingsboard.API)
+	if !ok {
+		if cfg.Standings.StartEnabled.Load() {
+			logger.Warn("standings are not available for this API",
+				zap.String("league", api.League()),
+			)
+		}
+		return nil, nil
+	}
+
+	b, err := standingsboard.New(standingsAPI, cfg.Standings, logger,
+		standingsboard.WithFavoriteTeams(cfg.FavoriteTeams),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// footballLiveRenderer renders live ESPN football games with the down and distance
+func footballLiveRenderer(logger *zap.Logger) sportboard.DetailedLiveRender {
+	f := sportlive.NewFootballLive(logger)
+	return func(ctx context.Context, canvas board.Canvas, game sportboard.Game, hLogo *logo.Logo, aLogo *logo.Logo) error {
+		g, ok := game.(*espnboard.Game)
+		if !ok {
+			return fmt.Errorf("unsupported sport for detailed renderer")
+		}
+		return f.RenderLive(ctx, canvas, g, hLogo, aLogo)
+	}
+}
+
+// hockeyLiveRenderer renders live ESPN hockey games with shots and power plays
+func hockeyLiveRenderer(logger *zap.Logger) sportboard.DetailedLiveRender {
+	h := sportlive.NewHockeyLive(logger)
+	return func(ctx context.Context, canvas board.Canvas, game sportboard.Game, hLogo *logo.Logo, aLogo *logo.Logo) error {
+		g, ok := game.(*espnboard.Game)
+		if !ok {
+			return fmt.Errorf("unsupported sport for detailed renderer")
+		}
+		return h.RenderLive(ctx, canvas, g, hLogo, aLogo)
+	}
+}
+
+// basketballLiveRenderer renders live ESPN basketball games with timeouts and fouls
+func basketballLiveRenderer(logger *zap.Logger) sportboard.DetailedLiveRender {
+	b := sportlive.NewBasketballLive(logger)
+	return func(ctx context.Context, canvas board.Canvas, game sportboard.Game, hLogo *logo.Logo, aLogo *logo.Logo) error {
+		g, ok := game.(*espnboard.Game)
+		if !ok {
+			return fmt.Errorf("unsupported sport for detailed renderer")
+		}
+		return b.RenderLive(ctx, canvas, g, hLogo, aLogo)
+	}
+}
+
+// getLayouts builds the layouts, whose regions render the given boards
+func (r *rootArgs) getLayouts(boards []board.Board, logger *zap.Logger) ([]board.Board, error) {
+	layouts := []board.Board{}
+	for _, cfg := range r.config.Layouts {
+		b, err := layoutboard.New(cfg, boards, logger)
+		if err != nil {
+			return nil, err
+		}
+		layouts = append(layouts, b)
+	}
+
+	return layouts, nil
+}
+
+// sectionBoards holds the boards built from each section of the config, keyed by the section's json name
+type sectionBoards map[string][]board.Board
+
+func (s sectionBoards) add(section string, b board.Board) {
+	s[section] = append(s[section], b)
+}
+
+// boards returns every board, ordered by the sections of the config
+func (s sectionBoards) boards() []board.Board {
+	boards := []board.Board{}
+	for _, section := range config.Sections() {
+		boards = append(boards, s[section]...)
+	}
+
+	return boards
+}
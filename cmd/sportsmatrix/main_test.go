@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"path/filepath"
+	"testing"
+
+	"github.com/disintegration/imaging"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	"github.com/robbydyer/sports/internal/espnboard"
+	"github.com/robbydyer/sports/internal/imgcanvas"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+// otherGame is a game from an API other than ESPN
+type otherGame struct {
+	sportboard.Game
+}
+
+func TestLiveRenderers(t *testing.T) {
+	t.Parallel()
+
+	getLogo := func(t *testing.T, key string) *logo.Logo {
+		t.Helper()
+		dir := t.TempDir()
+		thumb := imaging.New(32, 32, color.RGBA{255, 0, 0, 255})
+		require.NoError(t, imaging.Save(thumb, filepath.Join(dir, key+".tiff")))
+		return logo.New(key, nil, dir, image.Rect(0, 0, 128, 64), &logo.Config{Abbrev: key})
+	}
+
+	getGame := func(t *testing.T, situation string) *espnboard.Game {
+		t.Helper()
+		schedule := `{"events": [{"id": "1", "date": "2023-01-08T18:00Z", "status": {"period": 2, "displayClock": "5:32", "type": {"name": "STATUS_IN_PROGRESS"}}, "competitions": [{"competitors": [
+			{"homeAway": "home", "score": "14", "team": {"id": "2", "abbreviation": "BUF"}},
+			{"homeAway": "away", "score": "10", "team": {"id": "15", "abbreviation": "MIA"}}
+		], "situation": ` + situation + `}]}]}`
+		api, err := espnboard.NewNFL(context.Background(), zap.NewNop(), espnboard.WithMockData([]byte(schedule), nil))
+		require.NoError(t, err)
+		games, err := api.GetGames(context.Background(), "20230108")
+		require.NoError(t, err)
+		require.Len(t, games, 1)
+		return games[0]
+	}
+
+	renderers := map[string]func(*zap.Logger) sportboard.DetailedLiveRender{
+		"football":   footballLiveRenderer,
+		"hockey":     hockeyLiveRenderer,
+		"basketball": basketballLiveRenderer,
+	}
+
+	tests := []struct {
+		name      string
+		situation string
+		other     bool
+	}{
+		{
+			name:      "situation",
+			situation: `{"shortDownDistanceText": "2nd & 7", "possession": "2", "isPowerPlay": true, "powerPlayTeamId": "15", "homeFouls": {"teamFouls": 5, "bonusState": "BONUS"}, "awayFouls": {"teamFouls": 3}}`,
+		},
+		{
+			name:      "no situation",
+			situation: `null`,
+		},
+		{
+			name:      "unsupported game",
+			situation: `null`,
+			other:     true,
+		},
+	}
+
+	for sport, renderer := range renderers {
+		for _, test := range tests {
+			sport := sport
+			renderer := renderer
+			test := test
+			t.Run(sport+" "+test.name, func(t *testing.T) {
+				t.Parallel()
+				var game sportboard.Game = getGame(t, test.situation)
+				if test.other {
+					game = &otherGame{}
+				}
+
+				canvas := imgcanvas.New(128, 64, zap.NewNop())
+				err := renderer(zap.NewNop())(context.Background(), canvas, game, getLogo(t, "BUF"), getLogo(t, "MIA"))
+				if test.other {
+					require.ErrorContains(t, err, "unsupported sport")
+					return
+				}
+				require.NoError(t, err)
+				r, _, _, _ := canvas.At(5, 5).RGBA()
+				require.NotZero(t, r)
+			})
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/netmatrix"
+)
+
+type receiveCmd struct {
+	rArgs  *rootArgs
+	listen string
+}
+
+func newReceiveCmd(args *rootArgs) *cobra.Command {
+	c := receiveCmd{
+		rArgs: args,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "receive",
+		Short: "Drives the matrix with frames rendered by another sportsmatrix's network output",
+		RunE:  c.run,
+	}
+
+	f := cmd.Flags()
+
+	f.StringVar(&c.listen, "listen", ":9191", "Address to listen for a network output on")
+
+	return cmd
+}
+
+func (c *receiveCmd) run(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-ch
+		fmt.Println("Got OS interrupt signal, Shutting down")
+		cancel()
+	}()
+
+	c.rArgs.setConfigDefaults()
+
+	logger, err := c.rArgs.getLogger(c.rArgs.logLevel)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if c.rArgs.writer != nil {
+			c.rArgs.writer.Close()
+		}
+	}()
+
+	var m matrix.Matrix
+	if c.rArgs.test {
+		m, err = c.rArgs.getTestMatrix(logger)
+	} else {
+		m, err = c.rArgs.getRGBMatrix(logger)
+	}
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	l, err := net.Listen("tcp", c.listen)
+	if err != nil {
+		return err
+	}
+
+	if err := netmatrix.NewReceiver(m, logger).Serve(ctx, l); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return nil
+}
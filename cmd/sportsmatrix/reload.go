@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/config"
+	"github.com/robbydyer/sports/internal/sportsmatrix"
+)
+
+const configReloadInterval = 5 * time.Second
+
+// restartSections are config sections that aren't applied until the service is restarted
+var restartSections = []string{"debug", "enableNHL", "notifierConfig"}
+
+// configReloader watches the config file and applies changes to the running matrix.
+// Only the boards whose config section changed are rebuilt.
+type configReloader struct {
+	args          *rootArgs
+	log           *zap.Logger
+	matrix        *sportsmatrix.SportsMatrix
+	sections      sectionBoards
+	setup         func([]board.Board)
+	running       *config.Config
+	loaded        *config.Config
+	contents      []byte
+	matrixRestart []string
+}
+
+func newConfigReloader(args *rootArgs, logger *zap.Logger, mtrx *sportsmatrix.SportsMatrix, sections sectionBoards, setup func([]board.Board)) (*configReloader, error) {
+	c := &configReloader{
+		args:     args,
+		log:      logger,
+		matrix:   mtrx,
+		sections: sections,
+		setup:    setup,
+	}
+
+	// The running config may have been modified since it was loaded, so
+	// compare changes against a fresh copy
+	contents, err := os.ReadFile(args.configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	c.contents = contents
+	c.running, err = c.load(contents)
+	if err != nil {
+		return nil, err
+	}
+	c.loaded = c.running
+
+	return c, nil
+}
+
+// watch polls the config file for changes until the context is canceled
+func (c *configReloader) watch(ctx context.Context) {
+	c.log.Info("watching config file for changes",
+		zap.String("file", c.args.configFile),
+	)
+
+	ticker := time.NewTicker(configReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := c.reload(ctx); err != nil {
+			c.log.Error("failed to reload config file",
+				zap.String("file", c.args.configFile),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// reload applies the config file if it has changed since it was last loaded
+func (c *configReloader) reload(ctx context.Context) error {
+	contents, err := os.ReadFile(c.args.configFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if bytes.Equal(contents, c.contents) {
+		return nil
+	}
+	// Don't retry a bad config until the file changes again
+	c.contents = contents
+
+	cfg, err := c.load(contents)
+	if err != nil {
+		return err
+	}
+
+	changed, err := config.Diff(c.loaded, cfg)
+	if err != nil {
+		return err
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	c.log.Info("config file changed",
+		zap.Strings("sections", changed),
+	)
+
+	var rebuild []string
+	boardsChanged := false
+	updateMatrix := false
+SECTIONS:
+	for _, section := range changed {
+		for _, s := range restartSections {
+			if s == section {
+				continue SECTIONS
+			}
+		}
+		switch section {
+		case "sportsMatrixConfig":
+			updateMatrix = true
+		case "layouts":
+			boardsChanged = true
+		default:
+			rebuild = append(rebuild, section)
+		}
+	}
+
+	sections := sectionBoards{}
+	for section, boards := range c.sections {
+		sections[section] = boards
+	}
+
+	if len(rebuild) > 0 {
+		c.log.Info("rebuilding boards",
+			zap.Strings("sections", rebuild),
+		)
+		args := *c.args
+		args.config = cfg.Only(append(rebuild, "sportsMatrixConfig")...)
+		built, err := args.getSectionBoards(ctx, c.log)
+		if err != nil {
+			return fmt.Errorf("failed to rebuild boards: %w", err)
+		}
+		for _, section := range rebuild {
+			sections[section] = built[section]
+		}
+		boardsChanged = true
+	}
+
+	var boards []board.Board
+	if boardsChanged {
+		boards = sections.boards()
+		args := *c.args
+		args.config = cfg
+		layouts, err := args.getLayouts(boards, c.log)
+		if err != nil {
+			return fmt.Errorf("failed to rebuild layouts: %w", err)
+		}
+		boards = append(boards, layouts...)
+	}
+
+	if updateMatrix {
+		c.matrixRestart, err = c.matrix.UpdateConfig(cfg.SportsMatrixConfig)
+		if err != nil {
+			return fmt.Errorf("failed to update matrix config: %w", err)
+		}
+	}
+
+	if boardsChanged {
+		c.setup(boards)
+		if err := c.matrix.SetBoards(boards); err != nil {
+			return err
+		}
+		c.sections = sections
+	}
+
+	restart, err := config.Diff(c.running.Only(restartSections...), cfg.Only(restartSections...))
+	if err != nil {
+		return err
+	}
+	c.matrix.SetRestartRequired(append(restart, c.matrixRestart...))
+
+	c.loaded = cfg
+
+	return nil
+}
+
+// load parses the config and sets its defaults, the same way the running config was loaded
+func (c *configReloader) load(contents []byte) (*config.Config, error) {
+	cfg := &config.Config{}
+	if len(contents) > 0 {
+		var err error
+		cfg, err = parseConfig(contents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	args := *c.args
+	args.config = cfg
+	args.setConfigDefaults()
+
+	if !c.args.test {
+		keepPrivileges(cfg.SportsMatrixConfig)
+	}
+
+	return cfg, nil
+}
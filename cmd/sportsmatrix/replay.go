@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/robbydyer/sports/internal/espnboard"
+)
+
+type replayCmd struct {
+	rArgs   *rootArgs
+	archive string
+	speed   string
+}
+
+func newReplayCmd(args *rootArgs) *cobra.Command {
+	c := replayCmd{
+		rArgs: args,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Runs the matrix using ESPN data from an archive recorded with 'run --record'",
+		RunE:  c.run,
+	}
+
+	f := cmd.Flags()
+
+	f.StringVar(&c.archive, "archive", "", "Archive file to replay")
+	f.StringVar(&c.speed, "speed", "1x", "Replay speed. ex. '10x' replays 10 times faster than real time")
+
+	_ = cmd.MarkFlagRequired("archive")
+
+	return cmd
+}
+
+func (c *replayCmd) run(cmd *cobra.Command, args []string) error {
+	speed, err := parseReplaySpeed(c.speed)
+	if err != nil {
+		return err
+	}
+
+	replayer, err := espnboard.LoadReplayer(c.archive, speed)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Replaying %s at %.1fx: leagues %s from %s to %s\n",
+		c.archive,
+		speed,
+		strings.Join(replayer.Leagues(), ","),
+		replayer.Start().Local().Format(time.RFC3339),
+		replayer.End().Local().Format(time.RFC3339),
+	)
+
+	if c.rArgs.todayT == nil {
+		start := replayer.Start()
+		c.rArgs.todayT = &start
+	}
+
+	c.rArgs.espnOpts = append(c.rArgs.espnOpts, espnboard.WithReplay(replayer))
+
+	r := &runCmd{
+		rArgs: c.rArgs,
+	}
+
+	return r.run(cmd, args)
+}
+
+func parseReplaySpeed(s string) (float64, error) {
+	speed, err := strconv.ParseFloat(strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), "x"), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid replay speed '%s': %w", s, err)
+	}
+	if speed <= 0 {
+		return 0, fmt.Errorf("replay speed must be greater than 0")
+	}
+
+	return speed, nil
+}
@@ -13,14 +13,20 @@ import (
 
 	"github.com/robbydyer/sports/internal/board"
 	imageboard "github.com/robbydyer/sports/internal/board/image"
+	"github.com/robbydyer/sports/internal/brightness"
 	cnvs "github.com/robbydyer/sports/internal/canvas"
+	"github.com/robbydyer/sports/internal/espnboard"
 	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/notifier"
+	"github.com/robbydyer/sports/internal/output"
+	"github.com/robbydyer/sports/internal/recorder"
 	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 	"github.com/robbydyer/sports/internal/sportsmatrix"
 )
 
 type runCmd struct {
-	rArgs *rootArgs
+	rArgs  *rootArgs
+	record string
 }
 
 func newRunCmd(args *rootArgs) *cobra.Command {
@@ -34,6 +40,10 @@ func newRunCmd(args *rootArgs) *cobra.Command {
 		RunE:  c.run,
 	}
 
+	f := cmd.Flags()
+
+	f.StringVar(&c.record, "record", "", "Record all ESPN scoreboard, live game, team and logo data to the given archive file for replay")
+
 	return cmd
 }
 
@@ -59,15 +69,38 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
-	boards, err := s.rArgs.getBoards(ctx, logger)
+	if s.record != "" {
+		recorder, err := espnboard.NewRecorder(s.record)
+		if err != nil {
+			return err
+		}
+		defer recorder.Close()
+
+		logger.Info("recording ESPN data",
+			zap.String("archive", s.record),
+		)
+		s.rArgs.espnOpts = append(s.rArgs.espnOpts, espnboard.WithRecorder(recorder))
+	}
+
+	sections, err := s.rArgs.getSectionBoards(ctx, logger)
+	if err != nil {
+		return err
+	}
+	boards := sections.boards()
+	layouts, err := s.rArgs.getLayouts(boards, logger)
 	if err != nil {
 		return err
 	}
+	boards = append(boards, layouts...)
 
 	var canvases []board.Canvas
 	var matrix matrix.Matrix
 	if s.rArgs.test {
-		matrix = s.rArgs.getTestMatrix(logger)
+		var err error
+		matrix, err = s.rArgs.getTestMatrix(logger)
+		if err != nil {
+			return err
+		}
 	} else {
 		var err error
 		matrix, err = s.rArgs.getRGBMatrix(logger)
@@ -76,6 +109,12 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Wrap the matrix so that its output can be recorded from the web API
+	matrix, err = recorder.New(matrix, logger)
+	if err != nil {
+		return err
+	}
+
 	scroll, err := scrcnvs.NewScrollCanvas(matrix, logger)
 	if err != nil {
 		return err
@@ -105,13 +144,48 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 	}
 	defer mtrx.Close()
 
-	for _, b := range boards {
-		if strings.EqualFold(b.Name(), imageboard.Name) {
-			if i, ok := b.(*imageboard.ImageBoard); ok {
-				i.SetJumper(mtrx.JumpTo)
+	mtrx.SetEventBus(s.rArgs.eventBus)
+
+	brightnessSetters := brightness.Setters{matrix}
+	for _, cfg := range s.rArgs.config.SportsMatrixConfig.Outputs {
+		m, err := output.New(cfg, logger)
+		if err != nil {
+			return err
+		}
+		brightnessSetters = append(brightnessSetters, m)
+		outputScroll, err := scrcnvs.NewScrollCanvas(m, logger)
+		if err != nil {
+			return err
+		}
+		mtrx.AddOutput(&sportsmatrix.Output{
+			Name:     cfg.Name,
+			Boards:   cfg.Boards,
+			Canvases: []board.Canvas{cnvs.NewCanvas(m), outputScroll},
+		})
+	}
+
+	if err := mtrx.SetBrightnessControl(ctx, brightnessSetters); err != nil {
+		return err
+	}
+
+	if s.rArgs.config.NotifierConfig != nil {
+		n, err := notifier.New(s.rArgs.config.NotifierConfig, logger)
+		if err != nil {
+			return err
+		}
+		go n.Run(ctx, mtrx.EventBus())
+	}
+
+	setup := func(boards []board.Board) {
+		for _, b := range boards {
+			if strings.EqualFold(b.Name(), imageboard.Name) {
+				if i, ok := b.(*imageboard.ImageBoard); ok {
+					i.SetJumper(mtrx.JumpTo)
+				}
 			}
 		}
 	}
+	setup(boards)
 
 	for _, brd := range inBetweenBoards {
 		logger.Info("Registering in-between board",
@@ -120,6 +194,14 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 		mtrx.AddBetweenBoard(brd)
 	}
 
+	if s.rArgs.configFile != "" {
+		reloader, err := newConfigReloader(s.rArgs, logger, mtrx, sections, setup)
+		if err != nil {
+			return err
+		}
+		go reloader.watch(ctx)
+	}
+
 	logger.Info("Starting matrix service")
 	if err := mtrx.Serve(ctx); err != nil {
 		logger.Error("Matrix returned an error",
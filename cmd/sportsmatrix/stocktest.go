@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/internal/board"
 	stockboard "github.com/robbydyer/sports/internal/board/stocks"
@@ -17,7 +20,9 @@ import (
 )
 
 type stockCmd struct {
-	rArgs *rootArgs
+	rArgs   *rootArgs
+	record  string
+	fixture bool
 }
 
 func newStockCmd(args *rootArgs) *cobra.Command {
@@ -31,6 +36,11 @@ func newStockCmd(args *rootArgs) *cobra.Command {
 		RunE:  s.run,
 	}
 
+	f := cmd.Flags()
+
+	f.StringVar(&s.record, "record", "", "Record the quotes of the configured provider to the given fixture file, then exit")
+	f.BoolVar(&s.fixture, "fixture", false, "Show the quotes of the configured fixtureFile, or the bundled fixture, instead of fake data")
+
 	return cmd
 }
 
@@ -50,7 +60,17 @@ func (s *stockCmd) run(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
-	api := &fakeStocks{}
+	if s.record != "" {
+		return s.recordFixture(ctx, logger)
+	}
+
+	var api stockboard.API = &fakeStocks{}
+	if s.fixture {
+		api, err = stockboard.NewFixtureAPI(s.rArgs.config.StocksConfig.FixtureFile)
+		if err != nil {
+			return err
+		}
+	}
 
 	b, err := stockboard.New(api, s.rArgs.config.StocksConfig, logger)
 	if err != nil {
@@ -60,7 +80,11 @@ func (s *stockCmd) run(cmd *cobra.Command, args []string) error {
 	var canvases []board.Canvas
 	var matrix matrix.Matrix
 	if s.rArgs.test {
-		matrix = s.rArgs.getTestMatrix(logger)
+		var err error
+		matrix, err = s.rArgs.getTestMatrix(logger)
+		if err != nil {
+			return err
+		}
 	} else {
 		var err error
 		matrix, err = s.rArgs.getRGBMatrix(logger)
@@ -91,6 +115,32 @@ func (s *stockCmd) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func (s *stockCmd) recordFixture(ctx context.Context, logger *zap.Logger) error {
+	cfg := s.rArgs.config.StocksConfig
+	api, err := getStockAPI(cfg, logger)
+	if err != nil {
+		return err
+	}
+
+	fixture, err := stockboard.RecordFixture(ctx, api, cfg.AllSymbols(), time.Minute)
+	if err != nil {
+		return err
+	}
+
+	dat, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(s.record, dat, 0o644); err != nil {
+		return fmt.Errorf("failed to write stock fixture: %w", err)
+	}
+
+	fmt.Printf("Recorded %d stocks from %s to %s\n", len(fixture.Stocks), fixture.Provider, s.record)
+
+	return nil
+}
+
 type fakeStocks struct {
 	num int
 }
@@ -133,6 +183,10 @@ func (f *fakeStocks) Get(ctx context.Context, symbols []string, cacheExpire time
 	return []*stockboard.Stock{s}, nil
 }
 
+func (f *fakeStocks) Provider() string {
+	return "fake"
+}
+
 func (f *fakeStocks) CacheClear() {
 }
 
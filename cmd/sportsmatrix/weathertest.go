@@ -62,7 +62,11 @@ func (s *weatherCmd) run(cmd *cobra.Command, args []string) error {
 	var canvases []board.Canvas
 	var matrix matrix.Matrix
 	if s.rArgs.test {
-		matrix = s.rArgs.getTestMatrix(logger)
+		var err error
+		matrix, err = s.rArgs.getTestMatrix(logger)
+		if err != nil {
+			return err
+		}
 	} else {
 		var err error
 		matrix, err = s.rArgs.getRGBMatrix(logger)
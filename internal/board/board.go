@@ -5,6 +5,7 @@ import (
 	"image"
 	"image/draw"
 	"net/http"
+	"time"
 )
 
 // HTTPHandler is the type returned to the sportsmatrix for HTTP endpoints
@@ -53,3 +54,57 @@ type Canvas interface {
 // StateChangeNotifier is a func that an Enabler uses to notify when its
 // enabled/disabled state changes
 type StateChangeNotifier func()
+
+// Interrupt is a request from a board to preempt whatever is on the screen
+type Interrupt struct {
+	// Board is the name of the board requesting the screen
+	Board string
+	// Priority determines whether the Interrupt can preempt another active Interrupt.
+	// Only Interrupts with a higher priority preempt an active one.
+	Priority int
+	// Duration is how long the Interrupt holds the screen
+	Duration time.Duration
+	// Render draws the Interrupt to a canvas. The matrix calls Render on the canvas afterwards.
+	Render func(ctx context.Context, canvas Canvas) error
+}
+
+// Interrupter is a func that boards call to request the screen
+type Interrupter func(interrupt *Interrupt)
+
+// InterruptRequester is implemented by boards that can request an Interrupt
+type InterruptRequester interface {
+	SetInterrupter(Interrupter)
+}
+
+// Scheduled is implemented by boards that are turned on and off on a cron schedule
+type Scheduled interface {
+	// EnableTimes returns the cron specs for when the board is enabled and disabled
+	EnableTimes() (onTimes []string, offTimes []string)
+}
+
+// Closer is implemented by boards with background jobs to stop when the board is replaced
+type Closer interface {
+	Close() error
+}
+
+// Facts are values a board exposes about its data, keyed by name, ie. "liveGames" or "precipChance"
+type Facts map[string]interface{}
+
+// FactProvider is implemented by boards that expose their data for evaluating rules
+type FactProvider interface {
+	Facts(ctx context.Context) (Facts, error)
+}
+
+type resumeKey struct{}
+
+// WithResume marks the context of a render that resumes a board after an Interrupt
+func WithResume(ctx context.Context) context.Context {
+	return context.WithValue(ctx, resumeKey{}, true)
+}
+
+// Resuming returns true if the render resumes a board after an Interrupt. Boards that show
+// several pages should continue from the page that was interrupted.
+func Resuming(ctx context.Context) bool {
+	resume, _ := ctx.Value(resumeKey{}).(bool)
+	return resume
+}
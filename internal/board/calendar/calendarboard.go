@@ -43,7 +43,7 @@ type Todayer func() []time.Time
 
 // Config ...
 type Config struct {
-	TodayFunc          Todayer
+	TodayFunc          Todayer `json:"-"`
 	boardDelay         time.Duration
 	scrollDelay        time.Duration
 	StartEnabled       *atomic.Bool `json:"enabled"`
@@ -119,19 +119,6 @@ func New(api API, logger *zap.Logger, config *Config) (*CalendarBoard, error) {
 		s.config.TodayFunc = util.TodayFunc()
 	}
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		s.log.Info("calendarboard turning on")
-		s.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		s.log.Info("calendarboard turning off")
-		s.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-
 	svr := &Server{
 		board: s,
 	}
@@ -154,6 +141,11 @@ func (s *CalendarBoard) Name() string {
 	return s.api.HTTPPathPrefix()
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *CalendarBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
 func (s *CalendarBoard) Enabler() board.Enabler {
 	return s.enabler
 }
@@ -21,7 +21,6 @@ import (
 	"github.com/robbydyer/sports/internal/rgbrender"
 	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 	"github.com/robbydyer/sports/internal/twirphelpers"
-	"github.com/robbydyer/sports/internal/util"
 )
 
 // Name is the default board name for this Clock
@@ -116,19 +115,6 @@ func New(config *Config, logger *zap.Logger) (*Clock, error) {
 		zap.String("prefix", c.rpcServer.PathPrefix()),
 	)
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		c.log.Info("clock turning on")
-		c.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		c.log.Info("clock turning off")
-		c.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-
 	return c, nil
 }
 
@@ -142,6 +128,11 @@ func (c *Clock) Name() string {
 	return Name
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (c *Clock) EnableTimes() ([]string, []string) {
+	return c.config.OnTimes, c.config.OffTimes
+}
+
 func (c *Clock) Enabler() board.Enabler {
 	return c.enabler
 }
@@ -0,0 +1,284 @@
+package fantasyboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/logo"
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+)
+
+var (
+	defaultUpdateInterval     = 30 * time.Minute
+	defaultLiveUpdateInterval = 1 * time.Minute
+	defaultBoardDelay         = 10 * time.Second
+	defaultScrollDelay        = 15 * time.Millisecond
+)
+
+// FantasyBoard displays the matchups of a fantasy league
+type FantasyBoard struct {
+	config      *Config
+	log         *zap.Logger
+	api         API
+	writers     *rgbrender.TextWriters
+	matchups    []*Matchup
+	lastUpdate  time.Time
+	cancelBoard chan struct{}
+	rpcServer   pb.TwirpServer
+	enabler     board.Enabler
+	sync.Mutex
+}
+
+// Config ...
+type Config struct {
+	boardDelay         time.Duration
+	updateInterval     time.Duration
+	liveUpdateInterval time.Duration
+	scrollDelay        time.Duration
+	StartEnabled       *atomic.Bool `json:"enabled"`
+	BoardDelay         string       `json:"boardDelay"`
+	UpdateInterval     string       `json:"updateInterval"`
+	LiveUpdateInterval string       `json:"liveUpdateInterval"`
+	Provider           string       `json:"provider"`
+	LeagueID           string       `json:"leagueID"`
+	FavoriteTeams      []string     `json:"favoriteTeams"`
+	FavoriteOnly       *atomic.Bool `json:"favoriteOnly"`
+	OnTimes            []string     `json:"onTimes"`
+	OffTimes           []string     `json:"offTimes"`
+	ScrollMode         *atomic.Bool `json:"scrollMode"`
+	TightScrollPadding int          `json:"tightScrollPadding"`
+	ScrollDelay        string       `json:"scrollDelay"`
+}
+
+// API ...
+type API interface {
+	// Provider is the name of the fantasy site, ie. "sleeper"
+	Provider() string
+	HTTPPathPrefix() string
+	// GetMatchups returns the matchups of the league's current week, with team avatars
+	// sized for the given bounds
+	GetMatchups(ctx context.Context, avatarBounds image.Rectangle) ([]*Matchup, error)
+}
+
+// Matchup is a head to head matchup between two fantasy teams
+type Matchup struct {
+	Week int
+	// Live is true while the week's games are being played
+	Live bool
+	Home *Team
+	Away *Team
+}
+
+// Team is a fantasy team and its points in a Matchup
+type Team struct {
+	ID        string
+	Name      string
+	Owner     string
+	Points    float64
+	Projected float64
+	// Avatar is nil for teams without one
+	Avatar *logo.Logo
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.ScrollMode == nil {
+		c.ScrollMode = atomic.NewBool(false)
+	}
+	if c.FavoriteOnly == nil {
+		c.FavoriteOnly = atomic.NewBool(false)
+	}
+	if c.Provider == "" {
+		c.Provider = "sleeper"
+	}
+
+	c.boardDelay = defaultBoardDelay
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err == nil {
+			c.boardDelay = d
+		}
+	}
+
+	c.updateInterval = defaultUpdateInterval
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err == nil {
+			c.updateInterval = d
+		}
+	}
+
+	c.liveUpdateInterval = defaultLiveUpdateInterval
+	if c.LiveUpdateInterval != "" {
+		d, err := time.ParseDuration(c.LiveUpdateInterval)
+		if err == nil {
+			c.liveUpdateInterval = d
+		}
+	}
+
+	c.scrollDelay = defaultScrollDelay
+	if c.ScrollDelay != "" {
+		d, err := time.ParseDuration(c.ScrollDelay)
+		if err == nil {
+			c.scrollDelay = d
+		}
+	}
+}
+
+// New ...
+func New(api API, config *Config, logger *zap.Logger) (*FantasyBoard, error) {
+	s := &FantasyBoard{
+		config:      config,
+		log:         logger,
+		api:         api,
+		cancelBoard: make(chan struct{}),
+		enabler:     enabler.New(),
+	}
+
+	s.writers = rgbrender.NewTextWriters(s.setupWriter)
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	prfx = fmt.Sprintf("/fantasy%s", prfx)
+
+	s.rpcServer = twirphelpers.NewBasicBoardServer(s, s.config.ScrollMode, s.cancelBoard, prfx, s.log)
+	s.log.Info("registering RPC server for fantasy board",
+		zap.String("provider", s.api.Provider()),
+		zap.String("prefix", s.rpcServer.PathPrefix()),
+	)
+
+	return s, nil
+}
+
+// Enabler ...
+func (s *FantasyBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *FantasyBoard) InBetween() bool {
+	return false
+}
+
+// Name ...
+func (s *FantasyBoard) Name() string {
+	return "Fantasy"
+}
+
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *FantasyBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
+// Clear ...
+func (s *FantasyBoard) Clear() error {
+	return nil
+}
+
+// Close ...
+func (s *FantasyBoard) Close() error {
+	return nil
+}
+
+// ScrollMode ...
+func (s *FantasyBoard) ScrollMode() bool {
+	return s.config.ScrollMode.Load()
+}
+
+// GetHTTPHandlers ...
+func (s *FantasyBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return []*board.HTTPHandler{}, nil
+}
+
+func (s *FantasyBoard) isFavorite(team *Team) bool {
+	for _, t := range s.config.FavoriteTeams {
+		if strings.EqualFold(t, team.Name) || strings.EqualFold(t, team.Owner) {
+			return true
+		}
+	}
+	return false
+}
+
+// getMatchups returns the cached matchups, updating them once the update interval has passed.
+// Live matchups are updated on the shorter live update interval.
+func (s *FantasyBoard) getMatchups(ctx context.Context, avatarBounds image.Rectangle) ([]*Matchup, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	interval := s.config.updateInterval
+	if anyLive(s.matchups) {
+		interval = s.config.liveUpdateInterval
+	}
+	if len(s.matchups) > 0 && time.Since(s.lastUpdate) < interval {
+		return s.matchups, nil
+	}
+
+	s.log.Info("updating fantasy matchups",
+		zap.String("provider", s.api.Provider()),
+	)
+	matchups, err := s.api.GetMatchups(ctx, avatarBounds)
+	if err != nil {
+		if len(s.matchups) > 0 {
+			s.log.Error("failed to update fantasy matchups, using previous matchups",
+				zap.String("provider", s.api.Provider()),
+				zap.Error(err),
+			)
+			return s.matchups, nil
+		}
+		return nil, err
+	}
+
+	s.matchups = matchups
+	s.lastUpdate = time.Now()
+
+	return s.matchups, nil
+}
+
+// sortMatchups puts matchups with a favorite team first. When only favorites are shown,
+// the others are dropped.
+func (s *FantasyBoard) sortMatchups(matchups []*Matchup) []*Matchup {
+	var favorites []*Matchup
+	var others []*Matchup
+	for _, m := range matchups {
+		if s.isFavorite(m.Home) || s.isFavorite(m.Away) {
+			favorites = append(favorites, m)
+			continue
+		}
+		others = append(others, m)
+	}
+
+	if s.config.FavoriteOnly.Load() {
+		return favorites
+	}
+
+	return append(favorites, others...)
+}
+
+func anyLive(matchups []*Matchup) bool {
+	for _, m := range matchups {
+		if m.Live {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,244 @@
+package fantasyboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	favoriteColor  = color.RGBA{R: 255, G: 215, B: 0, A: 255}
+	projectedColor = color.RGBA{R: 150, G: 150, B: 150, A: 255}
+	liveColor      = color.RGBA{R: 255, G: 0, B: 0, A: 255}
+	avatarColor    = color.RGBA{R: 0, G: 150, B: 255, A: 255}
+)
+
+func (s *FantasyBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.cancelBoard:
+			cancel()
+			return
+		case <-ticker.C:
+			if !s.Enabler().Enabled() {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// Render ...
+func (s *FantasyBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	c, err := s.render(ctx, canvas)
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		defer func() {
+			if scr, ok := c.(*scrcnvs.ScrollCanvas); ok {
+				s.config.scrollDelay = scr.GetScrollSpeed()
+			}
+		}()
+		return c.Render(ctx)
+	}
+
+	return nil
+}
+
+// ScrollRender ...
+func (s *FantasyBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	origScrollMode := s.config.ScrollMode.Load()
+	origPad := s.config.TightScrollPadding
+	defer func() {
+		s.config.ScrollMode.Store(origScrollMode)
+		s.config.TightScrollPadding = origPad
+	}()
+
+	s.config.ScrollMode.Store(true)
+	s.config.TightScrollPadding = padding
+
+	return s.render(ctx, canvas)
+}
+
+func (s *FantasyBoard) render(ctx context.Context, canvas board.Canvas) (board.Canvas, error) {
+	boardCtx, boardCancel := context.WithCancel(ctx)
+	defer boardCancel()
+
+	go s.enablerCancel(boardCtx, boardCancel)
+
+	matchups, err := s.getMatchups(boardCtx, avatarBounds(rgbrender.ZeroedBounds(canvas.Bounds())))
+	if err != nil {
+		return nil, err
+	}
+
+	matchups = s.sortMatchups(matchups)
+	if len(matchups) < 1 {
+		s.log.Warn("no fantasy matchups found",
+			zap.String("provider", s.api.Provider()),
+		)
+		return nil, nil
+	}
+
+	var scrollCanvas *scrcnvs.ScrollCanvas
+	if canvas.Scrollable() && s.config.ScrollMode.Load() {
+		base, ok := canvas.(*scrcnvs.ScrollCanvas)
+		if !ok {
+			return nil, fmt.Errorf("unexpected canvas type for fantasy board")
+		}
+
+		scrollCanvas, err = scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+			scrcnvs.WithMergePadding(s.config.TightScrollPadding),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tight scroll canvas: %w", err)
+		}
+		scrollCanvas.SetScrollDirection(scrcnvs.RightToLeft)
+		scrollCanvas.SetScrollSpeed(s.config.scrollDelay)
+		base.SetScrollSpeed(s.config.scrollDelay)
+
+		go scrollCanvas.MatchScroll(ctx, base)
+	}
+
+MATCHUP:
+	for _, matchup := range matchups {
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+		if err := s.drawMatchup(boardCtx, canvas, matchup); err != nil {
+			s.log.Error("failed to render fantasy matchup",
+				zap.Error(err),
+			)
+			continue MATCHUP
+		}
+
+		if scrollCanvas != nil {
+			scrollCanvas.AddCanvas(canvas)
+			continue MATCHUP
+		}
+
+		if err := canvas.Render(boardCtx); err != nil {
+			s.log.Error("failed to render fantasy board",
+				zap.Error(err),
+			)
+			continue MATCHUP
+		}
+
+		select {
+		case <-boardCtx.Done():
+			return nil, context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	if scrollCanvas != nil {
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+		return scrollCanvas, nil
+	}
+
+	return nil, nil
+}
+
+// drawMatchup draws the away team on the top half of the canvas and the home team on
+// the bottom half. Each half has the team's avatar, name, points and projected points.
+func (s *FantasyBoard) drawMatchup(ctx context.Context, canvas board.Canvas, matchup *Matchup) error {
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+
+	writer, err := s.writers.Get(zeroed)
+	if err != nil {
+		return err
+	}
+
+	half := avatarBounds(zeroed).Dy()
+	for i, team := range []*Team{matchup.Away, matchup.Home} {
+		if team == nil {
+			continue
+		}
+		y := zeroed.Min.Y + (i * half)
+		avatar := avatarBounds(zeroed).Add(image.Pt(zeroed.Min.X, y))
+		textBounds := image.Rect(avatar.Max.X+1, y, zeroed.Max.X, y+half)
+
+		if err := s.drawAvatar(ctx, canvas, writer, avatar, team); err != nil {
+			s.log.Error("failed to draw fantasy team avatar",
+				zap.String("team", team.Name),
+				zap.Error(err),
+			)
+		}
+
+		nameClr := color.Color(color.White)
+		if s.isFavorite(team) {
+			nameClr = favoriteColor
+		}
+		nameBounds := image.Rect(textBounds.Min.X, y, textBounds.Max.X, y+(half/2))
+		if err := writer.WriteAligned(rgbrender.LeftCenter, canvas, nameBounds, []string{team.Name}, nameClr); err != nil {
+			return err
+		}
+
+		pointsBounds := image.Rect(textBounds.Min.X, nameBounds.Max.Y, textBounds.Max.X, y+half)
+		pointsClr := color.Color(color.White)
+		if matchup.Live {
+			pointsClr = liveColor
+		}
+		if err := writer.WriteAligned(rgbrender.LeftCenter, canvas, pointsBounds, []string{formatPoints(team.Points)}, pointsClr); err != nil {
+			return err
+		}
+		if team.Projected > 0 {
+			if err := writer.WriteAligned(rgbrender.RightCenter, canvas, pointsBounds, []string{formatPoints(team.Projected)}, projectedColor); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// drawAvatar draws the team's avatar, or the team's initials for teams without one
+func (s *FantasyBoard) drawAvatar(ctx context.Context, canvas board.Canvas, writer *rgbrender.TextWriter, bounds image.Rectangle, team *Team) error {
+	if team.Avatar != nil {
+		img, err := team.Avatar.GetThumbnail(ctx, bounds)
+		if err == nil {
+			draw.Draw(canvas, bounds, img, image.Point{}, draw.Over)
+			return nil
+		}
+		s.log.Error("failed to get fantasy avatar, using initials",
+			zap.String("team", team.Name),
+			zap.Error(err),
+		)
+	}
+
+	return writer.WriteAligned(rgbrender.CenterCenter, canvas, bounds, []string{initials(team.Name)}, avatarColor)
+}
+
+// avatarBounds is the size of a team avatar, a square half the height of the canvas
+func avatarBounds(zeroed image.Rectangle) image.Rectangle {
+	half := zeroed.Dy() / 2
+	return image.Rect(0, 0, half, half)
+}
+
+func formatPoints(p float64) string {
+	return fmt.Sprintf("%.1f", p)
+}
+
+func initials(name string) string {
+	var i []rune
+	for _, word := range strings.Fields(name) {
+		i = append(i, unicode.ToUpper([]rune(word)[0]))
+		if len(i) == 2 {
+			break
+		}
+	}
+	return string(i)
+}
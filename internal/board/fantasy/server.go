@@ -0,0 +1,10 @@
+package fantasyboard
+
+import (
+	"net/http"
+)
+
+// GetRPCHandler ...
+func (s *FantasyBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
@@ -0,0 +1,24 @@
+package fantasyboard
+
+import (
+	"fmt"
+	"image"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// setupWriter scales the font of large canvases
+func (s *FantasyBoard) setupWriter(writer *rgbrender.TextWriter, bounds image.Rectangle) {
+	// Each team has a name and a points line
+	if bounds.Dy() > 128 {
+		writer.FontSize = 0.2 * float64(bounds.Dy())
+		writer.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
+	}
+
+	s.log.Debug("fantasy board writer font",
+		zap.Float64("size", writer.FontSize),
+		zap.String("canvas", fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())),
+	)
+}
@@ -0,0 +1,310 @@
+package golfboard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+)
+
+var (
+	defaultUpdateInterval = 2 * time.Minute
+	defaultBoardDelay     = 10 * time.Second
+	defaultScrollDelay    = 15 * time.Millisecond
+)
+
+// GolfBoard displays the leaderboard of a golf tournament
+type GolfBoard struct {
+	config      *Config
+	log         *zap.Logger
+	api         API
+	writers     *rgbrender.TextWriters
+	leaderboard *Leaderboard
+	lastUpdate  time.Time
+	cancelBoard chan struct{}
+	rpcServer   pb.TwirpServer
+	enabler     board.Enabler
+	sync.Mutex
+}
+
+// Config ...
+type Config struct {
+	boardDelay         time.Duration
+	updateInterval     time.Duration
+	scrollDelay        time.Duration
+	StartEnabled       *atomic.Bool `json:"enabled"`
+	BoardDelay         string       `json:"boardDelay"`
+	UpdateInterval     string       `json:"updateInterval"`
+	LimitPlayers       int          `json:"limitPlayers"`
+	FavoritePlayers    []string     `json:"favoritePlayers"`
+	FollowMode         *atomic.Bool `json:"followMode"`
+	OnTimes            []string     `json:"onTimes"`
+	OffTimes           []string     `json:"offTimes"`
+	ScrollMode         *atomic.Bool `json:"scrollMode"`
+	TightScrollPadding int          `json:"tightScrollPadding"`
+	ScrollDelay        string       `json:"scrollDelay"`
+}
+
+// API ...
+type API interface {
+	// League is the name of the tour, ie. "PGA"
+	League() string
+	HTTPPathPrefix() string
+	// GetLeaderboard returns the leaderboard of the tour's current tournament
+	GetLeaderboard(ctx context.Context) (*Leaderboard, error)
+}
+
+// Leaderboard is the state of a tournament and its players, ordered by position
+type Leaderboard struct {
+	Tournament string
+	Round      int
+	// RoundDetail describes the state of the round, ie. "Round 2 - In Progress"
+	RoundDetail string
+	// CutScore is the projected or actual cut, relative to par. It is nil once
+	// the cut round has passed, or for tournaments without a cut.
+	CutScore *int
+	Players  []*Player
+}
+
+// Player is a player's place in a Leaderboard
+type Player struct {
+	ID       string
+	Name     string
+	Position string
+	// Score is the player's tournament score relative to par, ie. "-8"
+	Score string
+	// Today is the player's score relative to par in the current round
+	Today string
+	// Thru is the number of holes completed in the current round, "F" when the round
+	// is finished, or the player's tee time
+	Thru string
+	// Cut is true for players that missed the cut or withdrew
+	Cut bool
+	// Holes is the player's scorecard for the current round
+	Holes []*Hole
+}
+
+// Hole is a player's score on a hole
+type Hole struct {
+	Number  int
+	Strokes int
+	// ToPar is the player's strokes relative to par on the hole
+	ToPar int
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.ScrollMode == nil {
+		c.ScrollMode = atomic.NewBool(false)
+	}
+	if c.FollowMode == nil {
+		c.FollowMode = atomic.NewBool(false)
+	}
+
+	c.boardDelay = defaultBoardDelay
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err == nil {
+			c.boardDelay = d
+		}
+	}
+
+	c.updateInterval = defaultUpdateInterval
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err == nil {
+			c.updateInterval = d
+		}
+	}
+
+	c.scrollDelay = defaultScrollDelay
+	if c.ScrollDelay != "" {
+		d, err := time.ParseDuration(c.ScrollDelay)
+		if err == nil {
+			c.scrollDelay = d
+		}
+	}
+}
+
+// New ...
+func New(api API, config *Config, logger *zap.Logger) (*GolfBoard, error) {
+	s := &GolfBoard{
+		config:      config,
+		log:         logger,
+		api:         api,
+		cancelBoard: make(chan struct{}),
+		enabler:     enabler.New(),
+	}
+
+	s.writers = rgbrender.NewTextWriters(s.setupWriter)
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	prfx = fmt.Sprintf("/golf%s", prfx)
+
+	s.rpcServer = twirphelpers.NewBasicBoardServer(s, s.config.ScrollMode, s.cancelBoard, prfx, s.log)
+	s.log.Info("registering RPC server for golf board",
+		zap.String("league", s.api.League()),
+		zap.String("prefix", s.rpcServer.PathPrefix()),
+	)
+
+	return s, nil
+}
+
+// Enabler ...
+func (s *GolfBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *GolfBoard) InBetween() bool {
+	return false
+}
+
+// Name ...
+func (s *GolfBoard) Name() string {
+	return s.api.League()
+}
+
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *GolfBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
+// Clear ...
+func (s *GolfBoard) Clear() error {
+	return nil
+}
+
+// Close ...
+func (s *GolfBoard) Close() error {
+	return nil
+}
+
+// ScrollMode ...
+func (s *GolfBoard) ScrollMode() bool {
+	return s.config.ScrollMode.Load()
+}
+
+// GetHTTPHandlers ...
+func (s *GolfBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return []*board.HTTPHandler{}, nil
+}
+
+func (s *GolfBoard) isFavorite(p *Player) bool {
+	for _, f := range s.config.FavoritePlayers {
+		if strings.Contains(strings.ToLower(p.Name), strings.ToLower(f)) {
+			return true
+		}
+	}
+	return false
+}
+
+// getLeaderboard returns the cached leaderboard, updating it once the update interval has passed
+func (s *GolfBoard) getLeaderboard(ctx context.Context) (*Leaderboard, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.leaderboard != nil && time.Since(s.lastUpdate) < s.config.updateInterval {
+		return s.leaderboard, nil
+	}
+
+	s.log.Info("updating golf leaderboard",
+		zap.String("league", s.api.League()),
+	)
+	leaderboard, err := s.api.GetLeaderboard(ctx)
+	if err != nil {
+		if s.leaderboard != nil {
+			s.log.Error("failed to update golf leaderboard, using previous leaderboard",
+				zap.String("league", s.api.League()),
+				zap.Error(err),
+			)
+			return s.leaderboard, nil
+		}
+		return nil, err
+	}
+
+	s.leaderboard = leaderboard
+	s.lastUpdate = time.Now()
+
+	return s.leaderboard, nil
+}
+
+// favorites returns the favorite players on the leaderboard
+func (s *GolfBoard) favorites(leaderboard *Leaderboard) []*Player {
+	var players []*Player
+	for _, p := range leaderboard.Players {
+		if s.isFavorite(p) {
+			players = append(players, p)
+		}
+	}
+	return players
+}
+
+// rows returns the leaderboard's players, limited to the configured number of players,
+// with a nil entry where the cut line falls
+func (s *GolfBoard) rows(leaderboard *Leaderboard) []*Player {
+	players := leaderboard.Players
+	if s.config.LimitPlayers > 0 && len(players) > s.config.LimitPlayers {
+		players = players[0:s.config.LimitPlayers]
+	}
+
+	if leaderboard.CutScore == nil {
+		return players
+	}
+
+	rows := make([]*Player, 0, len(players)+1)
+	cutAdded := false
+	for _, p := range players {
+		if !cutAdded {
+			score, err := ScoreToInt(p.Score)
+			if p.Cut || (err == nil && score > *leaderboard.CutScore) {
+				rows = append(rows, nil)
+				cutAdded = true
+			}
+		}
+		rows = append(rows, p)
+	}
+
+	return rows
+}
+
+// ScoreToInt converts a score relative to par, ie. "-4", "E" or "+2", to an int
+func ScoreToInt(score string) (int, error) {
+	score = strings.TrimSpace(score)
+	if strings.EqualFold(score, "E") {
+		return 0, nil
+	}
+	return strconv.Atoi(strings.TrimPrefix(score, "+"))
+}
+
+// FormatScore formats a score relative to par, ie. -4 as "-4", 0 as "E" and 2 as "+2"
+func FormatScore(score int) string {
+	switch {
+	case score == 0:
+		return "E"
+	case score > 0:
+		return fmt.Sprintf("+%d", score)
+	}
+	return fmt.Sprint(score)
+}
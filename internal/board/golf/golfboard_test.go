@@ -0,0 +1,78 @@
+package golfboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRows(t *testing.T) {
+	t.Parallel()
+
+	cut := 1
+	players := []*Player{
+		{Name: "A", Score: "-3"},
+		{Name: "B", Score: "E"},
+		{Name: "C", Score: "+1"},
+		{Name: "D", Score: "+2"},
+		{Name: "E", Score: "+5", Cut: true},
+	}
+
+	tests := []struct {
+		name     string
+		cut      *int
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "no cut",
+			expected: []string{"A", "B", "C", "D", "E"},
+		},
+		{
+			name:     "cut line",
+			cut:      &cut,
+			expected: []string{"A", "B", "C", "", "D", "E"},
+		},
+		{
+			name:     "limited",
+			cut:      &cut,
+			limit:    3,
+			expected: []string{"A", "B", "C"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := &Config{LimitPlayers: test.limit}
+			cfg.SetDefaults()
+			s := &GolfBoard{config: cfg, log: zap.NewNop()}
+
+			var names []string
+			for _, p := range s.rows(&Leaderboard{CutScore: test.cut, Players: players}) {
+				if p == nil {
+					names = append(names, "")
+					continue
+				}
+				names = append(names, p.Name)
+			}
+			require.Equal(t, test.expected, names)
+		})
+	}
+}
+
+func TestScoreToInt(t *testing.T) {
+	t.Parallel()
+
+	for score, expected := range map[string]int{"-4": -4, "E": 0, "+2": 2} {
+		i, err := ScoreToInt(score)
+		require.NoError(t, err)
+		require.Equal(t, expected, i)
+		require.Equal(t, score, FormatScore(i))
+	}
+
+	_, err := ScoreToInt("F")
+	require.Error(t, err)
+}
@@ -0,0 +1,408 @@
+package golfboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	favoriteColor = color.RGBA{R: 255, G: 215, B: 0, A: 255}
+	dimColor      = color.RGBA{R: 150, G: 150, B: 150, A: 255}
+	underColor    = color.RGBA{R: 255, G: 0, B: 0, A: 255}
+	overColor     = color.RGBA{R: 0, G: 255, B: 0, A: 255}
+	eagleColor    = color.RGBA{R: 255, G: 215, B: 0, A: 255}
+	bogeyColor    = color.RGBA{R: 0, G: 150, B: 255, A: 255}
+	doubleColor   = color.RGBA{R: 0, G: 0, B: 255, A: 255}
+)
+
+// page draws one screen of the board
+type page func(ctx context.Context, canvas board.Canvas) error
+
+func (s *GolfBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.cancelBoard:
+			cancel()
+			return
+		case <-ticker.C:
+			if !s.Enabler().Enabled() {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// Render ...
+func (s *GolfBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	c, err := s.render(ctx, canvas)
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		defer func() {
+			if scr, ok := c.(*scrcnvs.ScrollCanvas); ok {
+				s.config.scrollDelay = scr.GetScrollSpeed()
+			}
+		}()
+		return c.Render(ctx)
+	}
+
+	return nil
+}
+
+// ScrollRender ...
+func (s *GolfBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	origScrollMode := s.config.ScrollMode.Load()
+	origPad := s.config.TightScrollPadding
+	defer func() {
+		s.config.ScrollMode.Store(origScrollMode)
+		s.config.TightScrollPadding = origPad
+	}()
+
+	s.config.ScrollMode.Store(true)
+	s.config.TightScrollPadding = padding
+
+	return s.render(ctx, canvas)
+}
+
+func (s *GolfBoard) render(ctx context.Context, canvas board.Canvas) (board.Canvas, error) {
+	boardCtx, boardCancel := context.WithCancel(ctx)
+	defer boardCancel()
+
+	go s.enablerCancel(boardCtx, boardCancel)
+
+	leaderboard, err := s.getLeaderboard(boardCtx)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaderboard.Players) < 1 {
+		s.log.Warn("no players on golf leaderboard",
+			zap.String("league", s.api.League()),
+		)
+		return nil, nil
+	}
+
+	pages, err := s.pages(canvas, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+
+	var scrollCanvas *scrcnvs.ScrollCanvas
+	if canvas.Scrollable() && s.config.ScrollMode.Load() {
+		base, ok := canvas.(*scrcnvs.ScrollCanvas)
+		if !ok {
+			return nil, fmt.Errorf("unexpected canvas type for golf board")
+		}
+
+		scrollCanvas, err = scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+			scrcnvs.WithMergePadding(s.config.TightScrollPadding),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tight scroll canvas: %w", err)
+		}
+		scrollCanvas.SetScrollDirection(scrcnvs.RightToLeft)
+		scrollCanvas.SetScrollSpeed(s.config.scrollDelay)
+		base.SetScrollSpeed(s.config.scrollDelay)
+
+		go scrollCanvas.MatchScroll(ctx, base)
+	}
+
+PAGE:
+	for _, p := range pages {
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+		if err := p(boardCtx, canvas); err != nil {
+			s.log.Error("failed to render golf leaderboard page",
+				zap.Error(err),
+			)
+			continue PAGE
+		}
+
+		if scrollCanvas != nil {
+			scrollCanvas.AddCanvas(canvas)
+			continue PAGE
+		}
+
+		if err := canvas.Render(boardCtx); err != nil {
+			s.log.Error("failed to render golf board",
+				zap.Error(err),
+			)
+			continue PAGE
+		}
+
+		select {
+		case <-boardCtx.Done():
+			return nil, context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	if scrollCanvas != nil {
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+		return scrollCanvas, nil
+	}
+
+	return nil, nil
+}
+
+// pages returns the leaderboard pages, followed by the scorecards of favorite players in follow mode
+func (s *GolfBoard) pages(canvas board.Canvas, leaderboard *Leaderboard) ([]page, error) {
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+	writer, err := s.writers.Get(zeroed)
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []page
+
+	rows := s.rows(leaderboard)
+	perPage := rowsPerPage(zeroed) - 1
+	for start := 0; start < len(rows); start += perPage {
+		end := start + perPage
+		if end > len(rows) {
+			end = len(rows)
+		}
+		pageRows := rows[start:end]
+		pages = append(pages, func(ctx context.Context, canvas board.Canvas) error {
+			return s.drawLeaderboard(canvas, writer, leaderboard, pageRows)
+		})
+	}
+
+	if !s.config.FollowMode.Load() {
+		return pages, nil
+	}
+
+	for _, player := range s.favorites(leaderboard) {
+		player := player
+		pages = append(pages, func(ctx context.Context, canvas board.Canvas) error {
+			return s.drawScorecard(canvas, writer, player)
+		})
+	}
+
+	return pages, nil
+}
+
+// drawLeaderboard draws the tournament and round on the top row, then a row for each player
+// with their position, name, score, today's score and thru hole. A nil player is the cut line.
+func (s *GolfBoard) drawLeaderboard(canvas board.Canvas, writer *rgbrender.TextWriter, leaderboard *Leaderboard, players []*Player) error {
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+	rowHeight := zeroed.Dy() / rowsPerPage(zeroed)
+
+	widths, err := writer.MeasureStrings(canvas, []string{"T99", "-10", "18", "R4"})
+	if err != nil {
+		return err
+	}
+	posWidth, scoreWidth, thruWidth, roundWidth := widths[0]+1, widths[1]+1, widths[2], widths[3]
+
+	// Thru is a tee time for players that haven't started
+	for _, p := range players {
+		if p == nil {
+			continue
+		}
+		w, err := writer.MeasureStrings(canvas, []string{p.Thru})
+		if err != nil {
+			return err
+		}
+		if w[0] > thruWidth {
+			thruWidth = w[0]
+		}
+	}
+	todayWidth := 0
+	if zeroed.Dx() >= 128 {
+		todayWidth = scoreWidth
+	}
+
+	header := image.Rect(zeroed.Min.X, zeroed.Min.Y, zeroed.Max.X, zeroed.Min.Y+rowHeight)
+	tournament, err := truncate(canvas, writer, leaderboard.Tournament, header.Dx()-roundWidth-1)
+	if err != nil {
+		return err
+	}
+	if err := writer.WriteAligned(rgbrender.LeftCenter, canvas, header, []string{tournament}, color.White); err != nil {
+		return err
+	}
+	if leaderboard.Round > 0 {
+		if err := writer.WriteAligned(rgbrender.RightCenter, canvas, header, []string{fmt.Sprintf("R%d", leaderboard.Round)}, dimColor); err != nil {
+			return err
+		}
+	}
+
+	for i, player := range players {
+		y := header.Max.Y + (i * rowHeight)
+		row := image.Rect(zeroed.Min.X, y, zeroed.Max.X, y+rowHeight)
+
+		if player == nil {
+			if err := drawCutLine(canvas, writer, row, *leaderboard.CutScore); err != nil {
+				return err
+			}
+			continue
+		}
+
+		thru := image.Rect(row.Max.X-thruWidth, row.Min.Y, row.Max.X, row.Max.Y)
+		today := image.Rect(thru.Min.X-todayWidth, row.Min.Y, thru.Min.X, row.Max.Y)
+		score := image.Rect(today.Min.X-scoreWidth, row.Min.Y, today.Min.X, row.Max.Y)
+		pos := image.Rect(row.Min.X, row.Min.Y, row.Min.X+posWidth, row.Max.Y)
+		name := image.Rect(pos.Max.X, row.Min.Y, score.Min.X, row.Max.Y)
+
+		nameClr := color.Color(color.White)
+		if s.isFavorite(player) {
+			nameClr = favoriteColor
+		}
+		if player.Cut {
+			nameClr = dimColor
+		}
+
+		shortName, err := truncate(canvas, writer, lastName(player.Name), name.Dx()-1)
+		if err != nil {
+			return err
+		}
+
+		if err := writer.WriteAligned(rgbrender.LeftCenter, canvas, pos, []string{player.Position}, dimColor); err != nil {
+			return err
+		}
+		if err := writer.WriteAligned(rgbrender.LeftCenter, canvas, name, []string{shortName}, nameClr); err != nil {
+			return err
+		}
+		if err := writer.WriteAligned(rgbrender.RightCenter, canvas, score, []string{player.Score}, scoreColor(player.Score)); err != nil {
+			return err
+		}
+		if todayWidth > 0 && player.Today != "" {
+			if err := writer.WriteAligned(rgbrender.RightCenter, canvas, today, []string{player.Today}, scoreColor(player.Today)); err != nil {
+				return err
+			}
+		}
+		if err := writer.WriteAligned(rgbrender.RightCenter, canvas, thru, []string{player.Thru}, dimColor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// drawScorecard draws the player's name and score on the top row, then their strokes on
+// the front nine and back nine holes of the current round, colored by score on the hole
+func (s *GolfBoard) drawScorecard(canvas board.Canvas, writer *rgbrender.TextWriter, player *Player) error {
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+	rowHeight := zeroed.Dy() / 3
+
+	header := image.Rect(zeroed.Min.X, zeroed.Min.Y, zeroed.Max.X, zeroed.Min.Y+rowHeight)
+	widths, err := writer.MeasureStrings(canvas, []string{player.Score})
+	if err != nil {
+		return err
+	}
+	name, err := truncate(canvas, writer, player.Name, header.Dx()-widths[0]-2)
+	if err != nil {
+		return err
+	}
+	if err := writer.WriteAligned(rgbrender.LeftCenter, canvas, header, []string{name}, favoriteColor); err != nil {
+		return err
+	}
+	if err := writer.WriteAligned(rgbrender.RightCenter, canvas, header, []string{player.Score}, scoreColor(player.Score)); err != nil {
+		return err
+	}
+
+	strokes := make(map[int]*Hole, len(player.Holes))
+	for _, h := range player.Holes {
+		strokes[h.Number] = h
+	}
+
+	cellWidth := zeroed.Dx() / 9
+	for hole := 1; hole <= 18; hole++ {
+		row := (hole - 1) / 9
+		col := (hole - 1) % 9
+		x := zeroed.Min.X + (col * cellWidth)
+		y := header.Max.Y + (row * rowHeight)
+		cell := image.Rect(x, y, x+cellWidth, y+rowHeight)
+
+		text := "-"
+		clr := color.Color(dimColor)
+		if h, ok := strokes[hole]; ok && h.Strokes > 0 {
+			text = fmt.Sprint(h.Strokes)
+			clr = holeColor(h.ToPar)
+		}
+		if err := writer.WriteAligned(rgbrender.CenterCenter, canvas, cell, []string{text}, clr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func drawCutLine(canvas board.Canvas, writer *rgbrender.TextWriter, row image.Rectangle, cut int) error {
+	text := fmt.Sprintf("CUT %s", FormatScore(cut))
+	widths, err := writer.MeasureStrings(canvas, []string{text})
+	if err != nil {
+		return err
+	}
+
+	lineY := row.Min.Y + (row.Dy() / 2)
+	lineWidth := (row.Dx() - widths[0] - 4) / 2
+	if lineWidth > 0 {
+		_ = rgbrender.DrawRectangle(canvas, row.Min.X, lineY, lineWidth, 1, underColor)
+		_ = rgbrender.DrawRectangle(canvas, row.Max.X-lineWidth, lineY, lineWidth, 1, underColor)
+	}
+
+	return writer.WriteAligned(rgbrender.CenterCenter, canvas, row, []string{text}, underColor)
+}
+
+// truncate shortens the text to fit the given pixel width
+func truncate(canvas board.Canvas, writer *rgbrender.TextWriter, text string, width int) (string, error) {
+	max, err := writer.MaxChars(canvas, width)
+	if err != nil {
+		return "", err
+	}
+	if max < 0 {
+		max = 0
+	}
+	if r := []rune(text); len(r) > max {
+		return string(r[0:max]), nil
+	}
+	return text, nil
+}
+
+func lastName(name string) string {
+	parts := strings.Fields(name)
+	if len(parts) < 2 {
+		return name
+	}
+	return strings.Join(parts[1:], " ")
+}
+
+func scoreColor(score string) color.Color {
+	i, err := ScoreToInt(score)
+	switch {
+	case err != nil || i == 0:
+		return color.White
+	case i < 0:
+		return underColor
+	}
+	return overColor
+}
+
+func holeColor(toPar int) color.Color {
+	switch {
+	case toPar <= -2:
+		return eagleColor
+	case toPar == -1:
+		return underColor
+	case toPar == 1:
+		return bogeyColor
+	case toPar >= 2:
+		return doubleColor
+	}
+	return color.White
+}
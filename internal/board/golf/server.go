@@ -0,0 +1,10 @@
+package golfboard
+
+import (
+	"net/http"
+)
+
+// GetRPCHandler ...
+func (s *GolfBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
@@ -0,0 +1,32 @@
+package golfboard
+
+import (
+	"fmt"
+	"image"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// setupWriter scales the font of large canvases
+func (s *GolfBoard) setupWriter(writer *rgbrender.TextWriter, bounds image.Rectangle) {
+	if bounds.Dy() > 128 {
+		writer.FontSize = 0.8 * float64(bounds.Dy()/rowsPerPage(bounds))
+		writer.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
+	}
+
+	s.log.Debug("golf board writer font",
+		zap.Float64("size", writer.FontSize),
+		zap.String("canvas", fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())),
+	)
+}
+
+// rowsPerPage is the number of leaderboard rows, including the header, that fit the canvas
+func rowsPerPage(bounds image.Rectangle) int {
+	rows := bounds.Dy() / 8
+	if rows < 3 {
+		return 3
+	}
+	return rows
+}
@@ -160,19 +160,6 @@ func New(config *Config, logger *zap.Logger) (*ImageBoard, error) {
 		),
 	)
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		i.log.Info("imageboard turning on")
-		i.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		i.log.Info("imageboard turning off")
-		i.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-
 	return i, nil
 }
 
@@ -190,6 +177,11 @@ func (i *ImageBoard) Name() string {
 	return Name
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (i *ImageBoard) EnableTimes() ([]string, []string) {
+	return i.config.OnTimes, i.config.OffTimes
+}
+
 func (i *ImageBoard) Enabler() board.Enabler {
 	return i.enabler
 }
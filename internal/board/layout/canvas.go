@@ -0,0 +1,154 @@
+package layoutboard
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+// compositor draws every region onto the parent canvas whenever a region renders
+type compositor struct {
+	parent  board.Canvas
+	regions []*regionCanvas
+	sync.Mutex
+}
+
+// regionCanvas is a board.Canvas for one region of a layout. Boards draw into
+// it with zeroed coordinates.
+type regionCanvas struct {
+	comp    *compositor
+	bounds  image.Rectangle
+	img     *image.RGBA
+	enabled *atomic.Bool
+	sync.Mutex
+}
+
+func newCompositor(parent board.Canvas) *compositor {
+	return &compositor{
+		parent: parent,
+	}
+}
+
+func (c *compositor) addRegion(bounds image.Rectangle) {
+	c.regions = append(c.regions, &regionCanvas{
+		comp:    c,
+		bounds:  bounds,
+		img:     image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy())),
+		enabled: atomic.NewBool(true),
+	})
+}
+
+func (c *compositor) render(ctx context.Context) error {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, r := range c.regions {
+		r.Lock()
+		draw.Draw(c.parent, r.bounds, r.img, image.Point{}, draw.Src)
+		r.Unlock()
+	}
+
+	return c.parent.Render(ctx)
+}
+
+// Name ...
+func (r *regionCanvas) Name() string {
+	return "Layout Region"
+}
+
+// Scrollable ...
+func (r *regionCanvas) Scrollable() bool {
+	return false
+}
+
+// AlwaysRender ...
+func (r *regionCanvas) AlwaysRender() bool {
+	return r.comp.parent.AlwaysRender()
+}
+
+// Close ...
+func (r *regionCanvas) Close() error {
+	return nil
+}
+
+// SetWidth ...
+func (r *regionCanvas) SetWidth(x int) {}
+
+// GetWidth ...
+func (r *regionCanvas) GetWidth() int {
+	return r.bounds.Dx()
+}
+
+// Clear sets the region to all black
+func (r *regionCanvas) Clear() error {
+	r.Lock()
+	draw.Draw(r.img, r.img.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Src)
+	r.Unlock()
+
+	return r.Render(context.Background())
+}
+
+// Render draws the region onto the layout's canvas
+func (r *regionCanvas) Render(ctx context.Context) error {
+	return r.comp.render(ctx)
+}
+
+// ColorModel ...
+func (r *regionCanvas) ColorModel() color.Model {
+	return color.RGBAModel
+}
+
+// Bounds ...
+func (r *regionCanvas) Bounds() image.Rectangle {
+	return r.img.Bounds()
+}
+
+// At ...
+func (r *regionCanvas) At(x, y int) color.Color {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.img.At(x, y)
+}
+
+// Set ...
+func (r *regionCanvas) Set(x, y int, clr color.Color) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.img.Set(x, y, clr)
+}
+
+// Enabled ...
+func (r *regionCanvas) Enabled() bool {
+	return r.enabled.Load()
+}
+
+// Enable ...
+func (r *regionCanvas) Enable() bool {
+	return r.enabled.CompareAndSwap(false, true)
+}
+
+// Disable ...
+func (r *regionCanvas) Disable() bool {
+	return r.enabled.CompareAndSwap(true, false)
+}
+
+// Store ...
+func (r *regionCanvas) Store(s bool) bool {
+	return r.enabled.CompareAndSwap(!s, s)
+}
+
+// SetStateChangeCallback ...
+func (r *regionCanvas) SetStateChangeCallback(s func()) {}
+
+// GetHTTPHandlers ...
+func (r *regionCanvas) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
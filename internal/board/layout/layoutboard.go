@@ -0,0 +1,279 @@
+package layoutboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+)
+
+// minRegionRender is the shortest time between renders of a region's board
+const minRegionRender = time.Second
+
+// LayoutBoard renders several boards at once, each in its own region of the canvas
+type LayoutBoard struct {
+	config    *Config
+	log       *zap.Logger
+	boards    []board.Board
+	rpcServer pb.TwirpServer
+	enabler   board.Enabler
+}
+
+// Config defines a layout. The canvas is split into a grid of Cols x Rows cells,
+// and each region spans one or more cells.
+type Config struct {
+	duration     time.Duration
+	Name         string       `json:"name"`
+	StartEnabled *atomic.Bool `json:"enabled"`
+	OnTimes      []string     `json:"onTimes"`
+	OffTimes     []string     `json:"offTimes"`
+	Cols         int          `json:"cols"`
+	Rows         int          `json:"rows"`
+	// ColRatios and RowRatios size the grid's columns and rows as a fraction of the
+	// canvas, ie. [0.25, 0.75]. Defaults to uniform cells.
+	ColRatios []float64 `json:"colRatios"`
+	RowRatios []float64 `json:"rowRatios"`
+	// Duration is how long the layout stays on screen. Boards that finish sooner are
+	// rendered again. If empty, the layout ends when every region's board finishes once.
+	Duration string    `json:"duration"`
+	Regions  []*Region `json:"regions"`
+}
+
+// Region is a board placed in the layout's grid
+type Region struct {
+	Board   string `json:"board"`
+	Col     int    `json:"col"`
+	Row     int    `json:"row"`
+	ColSpan int    `json:"colSpan"`
+	RowSpan int    `json:"rowSpan"`
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.Cols < 1 {
+		c.Cols = 1
+	}
+	if c.Rows < 1 {
+		c.Rows = 1
+	}
+	if c.Duration != "" {
+		d, err := time.ParseDuration(c.Duration)
+		if err == nil {
+			c.duration = d
+		}
+	}
+	for _, r := range c.Regions {
+		if r.ColSpan < 1 {
+			r.ColSpan = 1
+		}
+		if r.RowSpan < 1 {
+			r.RowSpan = 1
+		}
+	}
+}
+
+// New returns a new LayoutBoard. Regions reference boards by name from the given list of boards.
+func New(config *Config, boards []board.Board, logger *zap.Logger) (*LayoutBoard, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("layout is missing a name")
+	}
+	if len(config.ColRatios) > 0 && len(config.ColRatios) != config.Cols {
+		return nil, fmt.Errorf("layout %s has %d colRatios for %d cols", config.Name, len(config.ColRatios), config.Cols)
+	}
+	if len(config.RowRatios) > 0 && len(config.RowRatios) != config.Rows {
+		return nil, fmt.Errorf("layout %s has %d rowRatios for %d rows", config.Name, len(config.RowRatios), config.Rows)
+	}
+
+	l := &LayoutBoard{
+		config:  config,
+		log:     logger,
+		enabler: enabler.New(),
+	}
+
+REGIONS:
+	for _, r := range config.Regions {
+		if r.Col < 0 || r.Row < 0 || r.Col+r.ColSpan > config.Cols || r.Row+r.RowSpan > config.Rows {
+			return nil, fmt.Errorf("region for %s is outside of layout %s's %dx%d grid", r.Board, config.Name, config.Cols, config.Rows)
+		}
+		for _, b := range boards {
+			if strings.EqualFold(b.Name(), r.Board) {
+				l.boards = append(l.boards, b)
+				continue REGIONS
+			}
+		}
+		return nil, fmt.Errorf("layout %s region board %s does not exist", config.Name, r.Board)
+	}
+
+	if config.StartEnabled.Load() {
+		l.enabler.Enable()
+	}
+
+	svr := &Server{
+		board: l,
+	}
+	l.rpcServer = pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(fmt.Sprintf("/layout/%s", url.PathEscape(strings.ToLower(config.Name)))),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(l, l.log),
+		),
+	)
+
+	return l, nil
+}
+
+// Name ...
+func (l *LayoutBoard) Name() string {
+	return l.config.Name
+}
+
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (l *LayoutBoard) EnableTimes() ([]string, []string) {
+	return l.config.OnTimes, l.config.OffTimes
+}
+
+// Enabler ...
+func (l *LayoutBoard) Enabler() board.Enabler {
+	return l.enabler
+}
+
+// InBetween ...
+func (l *LayoutBoard) InBetween() bool {
+	return false
+}
+
+// ScrollMode ...
+func (l *LayoutBoard) ScrollMode() bool {
+	return false
+}
+
+// GetHTTPHandlers ...
+func (l *LayoutBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+// ScrollRender is not supported for layouts
+func (l *LayoutBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	return nil, nil
+}
+
+// Render renders each region's board concurrently into its own region of the canvas
+func (l *LayoutBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	if !l.Enabler().Enabled() {
+		return nil
+	}
+
+	bounds, err := l.regionBounds(canvas)
+	if err != nil {
+		return err
+	}
+
+	if l.config.duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.config.duration)
+		defer cancel()
+	}
+
+	comp := newCompositor(canvas)
+	for _, b := range bounds {
+		comp.addRegion(b)
+	}
+
+	if err := canvas.Clear(); err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	for i, b := range l.boards {
+		wg.Add(1)
+		go func(b board.Board, region *regionCanvas) {
+			defer wg.Done()
+			l.renderRegion(ctx, b, region)
+		}(b, comp.regions[i])
+	}
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// renderRegion renders a board into its region, repeating until the layout's duration is up
+func (l *LayoutBoard) renderRegion(ctx context.Context, b board.Board, region *regionCanvas) {
+	for {
+		start := time.Now()
+		if err := b.Render(ctx, region); err != nil && ctx.Err() == nil {
+			l.log.Error("failed to render layout region",
+				zap.String("layout", l.Name()),
+				zap.String("board", b.Name()),
+				zap.Error(err),
+			)
+		}
+
+		if l.config.duration == 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(minRegionRender - time.Since(start)):
+		}
+	}
+}
+
+// regionBounds returns the bounds of each region within the canvas
+func (l *LayoutBoard) regionBounds(canvas board.Canvas) ([]image.Rectangle, error) {
+	var opts []rgbrender.GridOption
+	if len(l.config.ColRatios) > 0 || len(l.config.RowRatios) > 0 {
+		cols := l.config.ColRatios
+		if len(cols) == 0 {
+			cols = uniformRatios(l.config.Cols)
+		}
+		rows := l.config.RowRatios
+		if len(rows) == 0 {
+			rows = uniformRatios(l.config.Rows)
+		}
+		opts = append(opts, rgbrender.WithCellRatios(cols, rows))
+	}
+
+	grid, err := rgbrender.NewGrid(canvas, l.config.Cols, l.config.Rows, l.log, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var bounds []image.Rectangle
+	for _, r := range l.config.Regions {
+		var rect image.Rectangle
+		for _, cell := range grid.Cells() {
+			if cell.Col >= r.Col && cell.Col < r.Col+r.ColSpan && cell.Row >= r.Row && cell.Row < r.Row+r.RowSpan {
+				rect = rect.Union(cell.Bounds)
+			}
+		}
+		bounds = append(bounds, rect.Add(canvas.Bounds().Min))
+	}
+
+	return bounds, nil
+}
+
+func uniformRatios(n int) []float64 {
+	ratios := make([]float64, n)
+	for i := range ratios {
+		ratios[i] = 1 / float64(n)
+	}
+
+	return ratios
+}
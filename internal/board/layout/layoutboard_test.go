@@ -0,0 +1,99 @@
+package layoutboard
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+)
+
+// fillBoard fills its canvas with a color
+type fillBoard struct {
+	name    string
+	clr     color.Color
+	enabler board.Enabler
+}
+
+func (b *fillBoard) Name() string {
+	return b.name
+}
+
+func (b *fillBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	draw.Draw(canvas, canvas.Bounds(), &image.Uniform{b.clr}, image.Point{}, draw.Src)
+	return canvas.Render(ctx)
+}
+
+func (b *fillBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	return nil, nil
+}
+
+func (b *fillBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+func (b *fillBoard) ScrollMode() bool {
+	return false
+}
+
+func (b *fillBoard) GetRPCHandler() (string, http.Handler) {
+	return "", nil
+}
+
+func (b *fillBoard) InBetween() bool {
+	return false
+}
+
+func (b *fillBoard) Enabler() board.Enabler {
+	return b.enabler
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	boards := []board.Board{
+		&fillBoard{name: "Clock", clr: red, enabler: enabler.New()},
+		&fillBoard{name: "NHL", clr: blue, enabler: enabler.New()},
+	}
+	for _, b := range boards {
+		b.Enabler().Enable()
+	}
+
+	cfg := &Config{
+		Name:         "split",
+		StartEnabled: atomic.NewBool(true),
+		Cols:         1,
+		Rows:         2,
+		RowRatios:    []float64{0.25, 0.75},
+		Regions: []*Region{
+			{Board: "clock", Row: 0},
+			{Board: "NHL", Row: 1},
+		},
+	}
+	cfg.SetDefaults()
+
+	l, err := New(cfg, boards, zap.NewNop())
+	require.NoError(t, err)
+
+	canvas := board.NewBlankCanvas(64, 32, zap.NewNop())
+	require.NoError(t, l.Render(context.Background(), canvas))
+
+	require.Equal(t, red, canvas.At(10, 2))
+	require.Equal(t, red, canvas.At(63, 7))
+	require.Equal(t, blue, canvas.At(10, 8))
+	require.Equal(t, blue, canvas.At(63, 31))
+
+	cfg.Regions = append(cfg.Regions, &Region{Board: "nope"})
+	_, err = New(cfg, boards, zap.NewNop())
+	require.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package layoutboard
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+)
+
+// Server ...
+type Server struct {
+	board *LayoutBoard
+}
+
+// GetRPCHandler ...
+func (l *LayoutBoard) GetRPCHandler() (string, http.Handler) {
+	return l.rpcServer.PathPrefix(), l.rpcServer
+}
+
+// SetStatus ...
+func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.Empty, error) {
+	if req.Status == nil {
+		return &emptypb.Empty{}, twirp.NewError(twirp.InvalidArgument, "nil status sent")
+	}
+
+	_ = s.board.Enabler().Store(req.Status.Enabled)
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetStatus ...
+func (s *Server) GetStatus(ctx context.Context, req *emptypb.Empty) (*pb.StatusResp, error) {
+	return &pb.StatusResp{
+		Status: &pb.Status{
+			Enabled: s.board.Enabler().Enabled(),
+		},
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	"github.com/twitchtv/twirp"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -23,18 +24,27 @@ import (
 	pb "github.com/robbydyer/sports/internal/proto/racingboard"
 )
 
+var (
+	defaultLiveUpdateInterval = 30 * time.Second
+	defaultResultsDuration    = 12 * time.Hour
+)
+
 // RacingBoard implements board.Board
 type RacingBoard struct {
 	config         *Config
 	api            API
 	log            *zap.Logger
 	scheduleWriter *rgbrender.TextWriter
+	sessionWriter  *rgbrender.TextWriter
 	leagueLogo     *logo.Logo
 	events         []*Event
+	session        *Session
+	sessionUpdate  time.Time
 	rpcServer      pb.TwirpServer
 	boardCtx       context.Context
 	boardCancel    context.CancelFunc
 	enabler        board.Enabler
+	cacheCron      *cron.Cron
 }
 
 // Todayer is a func that returns a string representing a date
@@ -44,9 +54,11 @@ type Todayer func() []time.Time
 
 // Config ...
 type Config struct {
-	TodayFunc          Todayer
+	TodayFunc          Todayer `json:"-"`
 	boardDelay         time.Duration
 	scrollDelay        time.Duration
+	liveUpdateInterval time.Duration
+	resultsDuration    time.Duration
 	StartEnabled       *atomic.Bool `json:"enabled"`
 	BoardDelay         string       `json:"boardDelay"`
 	ScrollMode         *atomic.Bool `json:"scrollMode"`
@@ -54,6 +66,9 @@ type Config struct {
 	OnTimes            []string     `json:"onTimes"`
 	OffTimes           []string     `json:"offTimes"`
 	TightScrollPadding int          `json:"tightScrollPadding"`
+	ShowLive           *atomic.Bool `json:"showLive"`
+	LiveUpdateInterval string       `json:"liveUpdateInterval"`
+	ResultsDuration    string       `json:"resultsDuration"`
 }
 
 // API ...
@@ -61,6 +76,9 @@ type API interface {
 	LeagueShortName() string
 	GetLogo(ctx context.Context, bounds image.Rectangle) (*logo.Logo, error)
 	GetScheduledEvents(ctx context.Context) ([]*Event, error)
+	// GetSession returns the session in progress, or else the most recently completed
+	// session. It returns nil when there are neither.
+	GetSession(ctx context.Context) (*Session, error)
 	HTTPPathPrefix() string
 }
 
@@ -97,6 +115,18 @@ func (c *Config) SetDefaults() {
 	} else {
 		c.scrollDelay = scrcnvs.DefaultScrollDelay
 	}
+
+	if c.ShowLive == nil {
+		c.ShowLive = atomic.NewBool(true)
+	}
+	c.liveUpdateInterval = defaultLiveUpdateInterval
+	if d, err := time.ParseDuration(c.LiveUpdateInterval); err == nil {
+		c.liveUpdateInterval = d
+	}
+	c.resultsDuration = defaultResultsDuration
+	if d, err := time.ParseDuration(c.ResultsDuration); err == nil {
+		c.resultsDuration = d
+	}
 }
 
 // New ...
@@ -126,19 +156,9 @@ func New(api API, logger *zap.Logger, config *Config) (*RacingBoard, error) {
 		s.config.TodayFunc = util.TodayFunc()
 	}
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		s.log.Info("sportboard turning on")
-		s.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		s.log.Info("racingboard turning off")
-		s.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons([]string{"0 4 * * *"}, s.cacheClear); err != nil {
+	var err error
+	s.cacheCron, err = util.SetCrons([]string{"0 4 * * *"}, s.cacheClear)
+	if err != nil {
 		return nil, err
 	}
 
@@ -162,6 +182,16 @@ func New(api API, logger *zap.Logger, config *Config) (*RacingBoard, error) {
 func (s *RacingBoard) cacheClear() {
 	s.events = []*Event{}
 	s.leagueLogo = nil
+	s.session = nil
+	s.sessionUpdate = time.Time{}
+}
+
+// Close removes the board's cache clearing cron
+func (s *RacingBoard) Close() error {
+	if s.cacheCron != nil {
+		s.cacheCron.Stop()
+	}
+	return nil
 }
 
 // Name ...
@@ -169,6 +199,11 @@ func (s *RacingBoard) Name() string {
 	return s.api.HTTPPathPrefix()
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *RacingBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
 func (s *RacingBoard) Enabler() board.Enabler {
 	return s.enabler
 }
@@ -102,19 +102,31 @@ func (s *RacingBoard) render(ctx context.Context, canvas board.Canvas) (board.Ca
 		zap.Int("number", len(s.events)),
 	)
 
-EVENTS:
+	imgs, err := s.sessionImages(s.boardCtx, canvas.Bounds())
+	if err != nil {
+		s.log.Error("failed to render racing session",
+			zap.String("league", s.api.LeagueShortName()),
+			zap.Error(err),
+		)
+	}
+
 	for _, event := range s.events {
-		select {
-		case <-s.boardCtx.Done():
-			return nil, context.Canceled
-		default:
-		}
 		img, err := s.renderEvent(s.boardCtx, canvas.Bounds(), event, s.leagueLogo, scheduleWriter)
 		if err != nil {
 			s.log.Error("failed to render racing event",
 				zap.Error(err),
 			)
-			continue EVENTS
+			continue
+		}
+		imgs = append(imgs, img)
+	}
+
+EVENTS:
+	for _, img := range imgs {
+		select {
+		case <-s.boardCtx.Done():
+			return nil, context.Canceled
+		default:
 		}
 
 		if scrollCanvas != nil && s.config.ScrollMode.Load() {
@@ -0,0 +1,341 @@
+package racingboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// SessionState is the state of a racing session
+type SessionState int
+
+const (
+	// Upcoming sessions haven't started
+	Upcoming SessionState = iota
+	// Live sessions are in progress
+	Live
+	// Complete sessions are finished
+	Complete
+)
+
+// idleSessionUpdateInterval is how often sessions are checked for when none is live
+const idleSessionUpdateInterval = 5 * time.Minute
+
+var (
+	dimColor  = color.RGBA{R: 150, G: 150, B: 150, A: 255}
+	liveColor = color.RGBA{R: 255, G: 0, B: 0, A: 255}
+)
+
+// Session is a practice, qualifying or race session of an Event
+type Session struct {
+	Event string
+	// Name is the short name of the session, ie. "Race" or "FP1"
+	Name      string
+	Date      time.Time
+	State     SessionState
+	Lap       int
+	TotalLaps int
+	// Drivers are in running order, or finishing order once the session is complete
+	Drivers []*Driver
+	// Stages are set for leagues that split races into stages, such as NASCAR
+	Stages []*Stage
+}
+
+// Stage is a segment of a race that is scored on its own
+type Stage struct {
+	Number int
+	EndLap int
+	// Complete stages have their finishing order in Drivers
+	Complete bool
+	Drivers  []*Driver
+}
+
+// Driver is a driver's place in a Session
+type Driver struct {
+	Position int
+	Name     string
+	// Abbreviation is the driver's three letter abbreviation, ie. "VER"
+	Abbreviation string
+	Team         string
+	TeamColor    color.Color
+	// Gap is the driver's gap to the leader, or the leader's time
+	Gap  string
+	Laps int
+}
+
+// CurrentStage returns the stage in progress of a live session, or nil if the session doesn't
+// have stages
+func (s *Session) CurrentStage() *Stage {
+	if s.State != Live {
+		return nil
+	}
+	for _, stage := range s.Stages {
+		if !stage.Complete {
+			return stage
+		}
+	}
+	return nil
+}
+
+// getSession returns the cached session, updating it on the live update interval while the
+// session is live
+func (s *RacingBoard) getSession(ctx context.Context) (*Session, error) {
+	interval := idleSessionUpdateInterval
+	if s.session != nil && s.session.State == Live {
+		interval = s.config.liveUpdateInterval
+	}
+	if !s.sessionUpdate.IsZero() && time.Since(s.sessionUpdate) < interval {
+		return s.session, nil
+	}
+
+	session, err := s.api.GetSession(ctx)
+	if err != nil {
+		if s.session != nil {
+			s.log.Error("failed to update racing session, using previous session",
+				zap.String("league", s.api.LeagueShortName()),
+				zap.Error(err),
+			)
+			return s.session, nil
+		}
+		return nil, err
+	}
+	s.session = session
+	s.sessionUpdate = time.Now()
+
+	return s.session, nil
+}
+
+// sessionImages returns the running order of a live session, or the podium and results of a
+// recently completed session
+func (s *RacingBoard) sessionImages(ctx context.Context, bounds image.Rectangle) ([]draw.Image, error) {
+	if !s.config.ShowLive.Load() {
+		return nil, nil
+	}
+
+	session, err := s.getSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil || len(session.Drivers) < 1 {
+		return nil, nil
+	}
+
+	switch session.State {
+	case Live:
+	case Complete:
+		if time.Since(session.Date) > s.config.resultsDuration {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	writer, err := s.getSessionWriter(rgbrender.ZeroedBounds(bounds))
+	if err != nil {
+		return nil, err
+	}
+
+	var imgs []draw.Image
+
+	if session.State == Complete {
+		img, err := s.renderPodium(bounds, session, writer)
+		if err != nil {
+			return nil, err
+		}
+		imgs = append(imgs, img)
+	}
+
+	title := session.Name
+	if stage := session.CurrentStage(); stage != nil {
+		// Abbreviated to leave room for the lap count on small canvases
+		title = fmt.Sprintf("Stg %d", stage.Number)
+	}
+	status, statusClr := sessionStatus(session)
+
+	perPage := sessionRows(rgbrender.ZeroedBounds(bounds)) - 1
+	for start := 0; start < len(session.Drivers); start += perPage {
+		end := start + perPage
+		if end > len(session.Drivers) {
+			end = len(session.Drivers)
+		}
+		img, err := s.renderRunningOrder(bounds, title, status, statusClr, session.Drivers[start:end], writer)
+		if err != nil {
+			return nil, err
+		}
+		imgs = append(imgs, img)
+	}
+
+	// Stage results only get a single page of their top finishers
+	for _, stage := range session.Stages {
+		if !stage.Complete || len(stage.Drivers) < 1 {
+			continue
+		}
+		drivers := stage.Drivers
+		if len(drivers) > perPage {
+			drivers = drivers[0:perPage]
+		}
+		img, err := s.renderRunningOrder(bounds, fmt.Sprintf("Stage %d", stage.Number), "Final", color.White, drivers, writer)
+		if err != nil {
+			return nil, err
+		}
+		imgs = append(imgs, img)
+	}
+
+	s.log.Debug("racing session pages",
+		zap.String("league", s.api.LeagueShortName()),
+		zap.String("session", session.Name),
+		zap.Int("pages", len(imgs)),
+	)
+
+	return imgs, nil
+}
+
+// renderRunningOrder draws the title and status on the top row, then a row for each driver with
+// their position, team color, abbreviation and gap. Wide canvases also show the driver's laps.
+func (s *RacingBoard) renderRunningOrder(bounds image.Rectangle, title string, status string, statusClr color.Color, drivers []*Driver, writer *rgbrender.TextWriter) (draw.Image, error) {
+	img := image.NewRGBA(bounds)
+	zeroed := rgbrender.ZeroedBounds(bounds)
+	rowHeight := zeroed.Dy() / sessionRows(zeroed)
+
+	header := image.Rect(zeroed.Min.X, zeroed.Min.Y, zeroed.Max.X, zeroed.Min.Y+rowHeight)
+	if err := writer.WriteAligned(rgbrender.LeftCenter, img, header, []string{title}, color.White); err != nil {
+		return nil, err
+	}
+	if err := writer.WriteAligned(rgbrender.RightCenter, img, header, []string{status}, statusClr); err != nil {
+		return nil, err
+	}
+
+	widths, err := writer.MeasureStrings(img, []string{"20", "999"})
+	if err != nil {
+		return nil, err
+	}
+	posWidth := widths[0] + 1
+	lapsWidth := 0
+	if zeroed.Dx() >= 128 {
+		lapsWidth = widths[1] + 2
+	}
+	colorWidth := zeroed.Dx() / 32
+	if colorWidth < 1 {
+		colorWidth = 1
+	}
+
+	for i, d := range drivers {
+		y := header.Max.Y + (i * rowHeight)
+		row := image.Rect(zeroed.Min.X, y, zeroed.Max.X, y+rowHeight)
+
+		pos := image.Rect(row.Min.X, row.Min.Y, row.Min.X+posWidth, row.Max.Y)
+		if err := writer.WriteAligned(rgbrender.RightCenter, img, pos, []string{fmt.Sprint(d.Position)}, dimColor); err != nil {
+			return nil, err
+		}
+
+		_ = rgbrender.DrawRectangle(img, pos.Max.X+1, row.Min.Y+1, colorWidth, row.Dy()-2, teamColor(d))
+
+		name := image.Rect(pos.Max.X+colorWidth+2, row.Min.Y, row.Max.X-lapsWidth, row.Max.Y)
+		if err := writer.WriteAligned(rgbrender.LeftCenter, img, name, []string{d.Abbreviation}, color.White); err != nil {
+			return nil, err
+		}
+		if err := writer.WriteAligned(rgbrender.RightCenter, img, name, []string{d.Gap}, dimColor); err != nil {
+			return nil, err
+		}
+
+		if lapsWidth > 0 && d.Laps > 0 {
+			laps := image.Rect(row.Max.X-lapsWidth, row.Min.Y, row.Max.X, row.Max.Y)
+			if err := writer.WriteAligned(rgbrender.RightCenter, img, laps, []string{fmt.Sprint(d.Laps)}, dimColor); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return img, nil
+}
+
+// renderPodium draws the top three finishers on a podium of their team colors, with the
+// winner in the middle
+func (s *RacingBoard) renderPodium(bounds image.Rectangle, session *Session, writer *rgbrender.TextWriter) (draw.Image, error) {
+	img := image.NewRGBA(bounds)
+	zeroed := rgbrender.ZeroedBounds(bounds)
+	rowHeight := zeroed.Dy() / sessionRows(zeroed)
+
+	header := image.Rect(zeroed.Min.X, zeroed.Min.Y, zeroed.Max.X, zeroed.Min.Y+rowHeight)
+	if err := writer.WriteAligned(rgbrender.CenterCenter, img, header, []string{fmt.Sprintf("%s %s", session.Event, session.Name)}, color.White); err != nil {
+		return nil, err
+	}
+
+	colWidth := zeroed.Dx() / 3
+	available := zeroed.Max.Y - header.Max.Y - rowHeight
+
+	// Columns from left to right are 2nd, 1st and 3rd
+	for col, place := range []int{1, 0, 2} {
+		if place >= len(session.Drivers) {
+			continue
+		}
+		d := session.Drivers[place]
+
+		height := available * (3 - place) / 3
+		x := zeroed.Min.X + (col * colWidth)
+		step := image.Rect(x+1, zeroed.Max.Y-height, x+colWidth-1, zeroed.Max.Y)
+		_ = rgbrender.DrawRectangle(img, step.Min.X, step.Min.Y, step.Dx(), step.Dy(), teamColor(d))
+
+		name := image.Rect(x, step.Min.Y-rowHeight, x+colWidth, step.Min.Y)
+		if err := writer.WriteAligned(rgbrender.CenterCenter, img, name, []string{d.Abbreviation}, color.White); err != nil {
+			return nil, err
+		}
+		if err := writer.WriteAligned(rgbrender.CenterCenter, img, step, []string{fmt.Sprint(place + 1)}, color.Black); err != nil {
+			return nil, err
+		}
+	}
+
+	return img, nil
+}
+
+func (s *RacingBoard) getSessionWriter(bounds image.Rectangle) (*rgbrender.TextWriter, error) {
+	if s.sessionWriter != nil {
+		return s.sessionWriter, nil
+	}
+
+	var err error
+	s.sessionWriter, err = rgbrender.DefaultTextWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	if bounds.Dy() > 128 {
+		s.sessionWriter.FontSize = 0.8 * float64(bounds.Dy()/sessionRows(bounds))
+		s.sessionWriter.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
+	}
+
+	return s.sessionWriter, nil
+}
+
+// sessionRows is the number of rows, including the header, that fit the canvas
+func sessionRows(bounds image.Rectangle) int {
+	rows := bounds.Dy() / 8
+	if rows < 3 {
+		return 3
+	}
+	return rows
+}
+
+func sessionStatus(session *Session) (string, color.Color) {
+	if session.State == Complete {
+		return "Final", color.White
+	}
+	if session.TotalLaps > 0 {
+		return fmt.Sprintf("L%d/%d", session.Lap, session.TotalLaps), liveColor
+	}
+	return "LIVE", liveColor
+}
+
+func teamColor(d *Driver) color.Color {
+	if d.TeamColor == nil {
+		return dimColor
+	}
+	return d.TeamColor
+}
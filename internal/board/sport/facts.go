@@ -0,0 +1,68 @@
+package sportboard
+
+import (
+	"context"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+// Facts returns today's game data for evaluating rules:
+//   - games: number of games scheduled today
+//   - watchedGames: number of games today involving a watched team
+//   - liveGames: number of watched games in progress
+//   - favoriteGames: number of games today involving a favorite team
+//   - watchedTeamPlaysToday: true if a watched team has a game today
+//   - watchedTeamLive: true if a watched team's game is in progress
+func (s *SportBoard) Facts(ctx context.Context) (board.Facts, error) {
+	allGames, err := s.api.GetScheduledGames(ctx, s.config.TodayFunc())
+	if err != nil {
+		return nil, err
+	}
+
+	watchTeams := s.watchTeams
+	if len(watchTeams) < 1 {
+		watchTeams = s.api.GetWatchTeams(s.config.WatchTeams, s.season())
+	}
+	watched := make(map[string]struct{}, len(watchTeams))
+	for _, t := range watchTeams {
+		watched[t] = struct{}{}
+	}
+
+	watchedGames := 0
+	liveGames := 0
+	favoriteGames := 0
+	for _, game := range allGames {
+		home, err := game.HomeTeam()
+		if err != nil {
+			return nil, err
+		}
+		away, err := game.AwayTeam()
+		if err != nil {
+			return nil, err
+		}
+
+		_, homeWatched := watched[home.GetID()]
+		_, awayWatched := watched[away.GetID()]
+		if !homeWatched && !awayWatched {
+			continue
+		}
+		watchedGames++
+
+		if isFav, err := s.isFavoriteGame(game); err == nil && isFav {
+			favoriteGames++
+		}
+
+		if isLive, err := game.IsLive(); err == nil && isLive {
+			liveGames++
+		}
+	}
+
+	return board.Facts{
+		"games":                 len(allGames),
+		"watchedGames":          watchedGames,
+		"liveGames":             liveGames,
+		"favoriteGames":         favoriteGames,
+		"watchedTeamPlaysToday": watchedGames > 0,
+		"watchedTeamLive":       liveGames > 0,
+	}, nil
+}
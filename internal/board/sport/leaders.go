@@ -0,0 +1,215 @@
+package sportboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	defaultLeadersDelay = 5 * time.Second
+	leaderCategoryColor = color.RGBA{R: 255, G: 215, B: 0, A: 255}
+)
+
+// teamLeaders are the leaders of one team of a game
+type teamLeaders struct {
+	team    Team
+	leaders []*Leader
+}
+
+// filterLeaders returns the leaders in the given categories, or all of them if no categories are given.
+// Categories match either a leader's category or its abbreviation.
+func filterLeaders(leaders []*Leader, categories []string) []*Leader {
+	if len(categories) < 1 {
+		return leaders
+	}
+
+	var filtered []*Leader
+	for _, l := range leaders {
+		for _, c := range categories {
+			if strings.EqualFold(c, l.Category) || strings.EqualFold(c, l.Abbreviation) {
+				filtered = append(filtered, l)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
+
+// getLeaders returns the configured leaders of each team, away team first
+func (s *SportBoard) getLeaders(ctx context.Context, game Game) ([]*teamLeaders, error) {
+	if !s.config.Leaders.Load() {
+		return nil, nil
+	}
+
+	leaderer, ok := game.(Leaderer)
+	if !ok {
+		return nil, nil
+	}
+
+	isLive, err := game.IsLive()
+	if err != nil {
+		return nil, err
+	}
+	isOver, err := game.IsComplete()
+	if err != nil {
+		return nil, err
+	}
+	if !isLive && !isOver {
+		return nil, nil
+	}
+
+	home, away, err := leaderer.GetLeaders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaders for game %d: %w", game.GetID(), err)
+	}
+
+	homeTeam, err := game.HomeTeam()
+	if err != nil {
+		return nil, err
+	}
+	awayTeam, err := game.AwayTeam()
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*teamLeaders
+	for _, t := range []*teamLeaders{
+		{team: awayTeam, leaders: filterLeaders(away, s.config.LeaderCategories)},
+		{team: homeTeam, leaders: filterLeaders(home, s.config.LeaderCategories)},
+	} {
+		if len(t.leaders) > 0 {
+			teams = append(teams, t)
+		}
+	}
+
+	return teams, nil
+}
+
+// renderLeaders pages through the leaders of each team of a game
+func (s *SportBoard) renderLeaders(ctx context.Context, canvas board.Canvas, game Game) error {
+	teams, err := s.getLeaders(ctx, game)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range teams {
+		for _, leader := range t.leaders {
+			draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+			if err := s.drawLeader(ctx, canvas, t.team, leader); err != nil {
+				return err
+			}
+
+			if err := canvas.Render(ctx); err != nil {
+				return err
+			}
+
+			if !s.config.ScrollMode.Load() {
+				select {
+				case <-ctx.Done():
+					return context.Canceled
+				case <-time.After(s.config.leadersDelay):
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// addLeaders draws each leader of a game and adds them to a scroll canvas.
+// It returns the number of leaders added.
+func (s *SportBoard) addLeaders(ctx context.Context, canvas *scrcnvs.ScrollCanvas, scroller *scrcnvs.ScrollCanvas, game Game) (int, error) {
+	teams, err := s.getLeaders(ctx, game)
+	if err != nil {
+		return 0, err
+	}
+
+	num := 0
+	for _, t := range teams {
+		for _, leader := range t.leaders {
+			draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+			if err := s.drawLeader(ctx, canvas, t.team, leader); err != nil {
+				return 0, err
+			}
+
+			scroller.AddCanvas(canvas)
+			num++
+		}
+	}
+
+	draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+	return num, nil
+}
+
+// drawLeader draws the team logo on the left and the leader's stat category, name and value on the right
+func (s *SportBoard) drawLeader(ctx context.Context, canvas board.Canvas, team Team, leader *Leader) error {
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+
+	logoSize := zeroed.Dy()
+	if logoSize > zeroed.Dx()/2 {
+		logoSize = zeroed.Dx() / 2
+	}
+	logoBounds := image.Rect(zeroed.Min.X, zeroed.Min.Y, zeroed.Min.X+logoSize, zeroed.Min.Y+logoSize)
+	textBounds := image.Rect(logoBounds.Max.X+1, zeroed.Min.Y, zeroed.Max.X, zeroed.Max.Y)
+
+	l, err := s.getLogo(ctx, team.GetID())
+	if err != nil {
+		s.log.Error("failed to get logo for leaders",
+			zap.String("league", s.api.League()),
+			zap.String("team", team.GetAbbreviation()),
+			zap.Error(err),
+		)
+		textBounds.Min.X = zeroed.Min.X
+	} else {
+		img, err := l.GetThumbnail(ctx, logoBounds)
+		if err != nil {
+			return err
+		}
+		draw.Draw(canvas, logoBounds, img, image.Point{}, draw.Over)
+	}
+
+	writer, err := s.getPlayWriter(zeroed)
+	if err != nil {
+		return err
+	}
+
+	category := leader.Abbreviation
+	if category == "" {
+		category = leader.Category
+	}
+
+	lineHeight := textBounds.Dy() / 3
+	for i, line := range []string{category, leader.Name, leader.Value} {
+		clr := color.Color(color.White)
+		if i == 0 {
+			clr = leaderCategoryColor
+		}
+		y := textBounds.Min.Y + (i * lineHeight)
+		if err := writer.WriteAligned(
+			rgbrender.CenterCenter,
+			canvas,
+			image.Rect(textBounds.Min.X, y, textBounds.Max.X, y+lineHeight),
+			[]string{line},
+			clr,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
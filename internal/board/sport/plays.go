@@ -0,0 +1,201 @@
+package sportboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	defaultPlayByPlayDelay = 15 * time.Millisecond
+	scoringPlayColor       = color.RGBA{R: 255, G: 215, B: 0, A: 255}
+)
+
+// String formats the play for the ticker, ie. "2nd 12:34 NYI Goal..."
+func (p *Play) String() string {
+	var parts []string
+	for _, s := range []string{p.Period, p.Clock, p.Team, p.Text} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// latestPlays returns the most recent plays, oldest first
+func latestPlays(plays []*Play, max int, scoringOnly bool) []*Play {
+	var latest []*Play
+	for _, p := range plays {
+		if scoringOnly && !p.Scoring {
+			continue
+		}
+		latest = append(latest, p)
+	}
+
+	if max > 0 && len(latest) > max {
+		latest = latest[len(latest)-max:]
+	}
+
+	return latest
+}
+
+// matrixCanvas is a canvas that draws directly to a matrix
+type matrixCanvas interface {
+	Matrix() matrix.Matrix
+}
+
+// renderPlays scrolls a ticker of the latest plays of a live game. Canvases that don't scroll
+// have the ticker scrolled across the matrix they draw to.
+func (s *SportBoard) renderPlays(ctx context.Context, canvas board.Canvas, game Game) error {
+	if !s.config.PlayByPlay.Load() {
+		return nil
+	}
+
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok {
+		m, ok := canvas.(matrixCanvas)
+		if !ok {
+			return nil
+		}
+		var err error
+		base, err = scrcnvs.NewScrollCanvas(m.Matrix(), s.log)
+		if err != nil {
+			return fmt.Errorf("failed to get play-by-play canvas: %w", err)
+		}
+	}
+
+	ticker, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(s.config.TightScrollPadding),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get play-by-play scroll canvas: %w", err)
+	}
+	ticker.SetScrollDirection(scrcnvs.RightToLeft)
+	ticker.SetScrollSpeed(s.config.playByPlayDelay)
+
+	num, err := s.addPlays(ctx, base, ticker, game)
+	if err != nil {
+		return err
+	}
+	if num < 1 {
+		return nil
+	}
+
+	defer func() {
+		s.config.playByPlayDelay = ticker.GetScrollSpeed()
+	}()
+
+	return ticker.Render(ctx)
+}
+
+// addPlays draws each of the latest plays of a live game and adds them to a scroll canvas.
+// It returns the number of plays added.
+func (s *SportBoard) addPlays(ctx context.Context, canvas *scrcnvs.ScrollCanvas, scroller *scrcnvs.ScrollCanvas, game Game) (int, error) {
+	if !s.config.PlayByPlay.Load() {
+		return 0, nil
+	}
+
+	pbp, ok := game.(PlayByPlayer)
+	if !ok {
+		return 0, nil
+	}
+
+	if live, err := game.IsLive(); err != nil || !live {
+		return 0, nil
+	}
+
+	plays, err := pbp.GetPlays(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get plays for game %d: %w", game.GetID(), err)
+	}
+
+	plays = latestPlays(plays, s.config.PlayByPlayMax, s.config.ScoringPlaysOnly.Load())
+	if len(plays) < 1 {
+		return 0, nil
+	}
+
+	s.log.Debug("rendering play-by-play",
+		zap.String("league", s.api.League()),
+		zap.Int("game ID", game.GetID()),
+		zap.Int("num plays", len(plays)),
+	)
+
+	origWidth := canvas.GetWidth()
+	origPadding := canvas.GetPadding()
+	defer func() {
+		canvas.SetWidth(origWidth)
+		canvas.SetPadding(origPadding)
+	}()
+
+	// The canvas bounds include its padding
+	zeroed := image.Rect(0, 0, origWidth, canvas.Bounds().Dy()-(2*origPadding))
+
+	writer, err := s.getPlayWriter(zeroed)
+	if err != nil {
+		return 0, err
+	}
+	for _, play := range plays {
+		select {
+		case <-ctx.Done():
+			return 0, context.Canceled
+		default:
+		}
+
+		text := play.String()
+		lengths, err := writer.MeasureStrings(canvas, []string{text})
+		if err != nil {
+			return 0, err
+		}
+		bounds := image.Rect(zeroed.Min.X, zeroed.Min.Y, zeroed.Min.X+lengths[0], zeroed.Max.Y)
+
+		canvas.SetWidth(bounds.Dx())
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+		clr := color.Color(color.White)
+		if play.Scoring {
+			clr = scoringPlayColor
+		}
+		if err := writer.WriteAligned(rgbrender.CenterCenter, canvas, bounds, []string{text}, clr); err != nil {
+			return 0, err
+		}
+
+		scroller.AddCanvas(canvas)
+	}
+
+	return len(plays), nil
+}
+
+func (s *SportBoard) getPlayWriter(bounds image.Rectangle) (*rgbrender.TextWriter, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	k := fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())
+	if w, ok := s.playWriters[k]; ok {
+		return w, nil
+	}
+
+	writer, err := rgbrender.DefaultTextWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	if bounds.Dy() > 256 {
+		writer.FontSize = 0.25 * float64(bounds.Dy())
+		writer.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
+	}
+
+	s.playWriters[k] = writer
+
+	return writer, nil
+}
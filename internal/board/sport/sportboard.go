@@ -11,11 +11,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	"github.com/twitchtv/twirp"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/internal/board"
+	standingsboard "github.com/robbydyer/sports/internal/board/standings"
 	statboard "github.com/robbydyer/sports/internal/board/stat"
 	textboard "github.com/robbydyer/sports/internal/board/text"
 	"github.com/robbydyer/sports/internal/enabler"
@@ -37,6 +39,10 @@ const (
 
 type DetailedLiveRender func(ctx context.Context, canvas board.Canvas, game Game, homeLogo *logo.Logo, awayLogo *logo.Logo) error
 
+// GameUpdateNotifier is called each time live game data is fetched, with the
+// previously known state of the game. favorite is true if either team is a favorite.
+type GameUpdateNotifier func(league string, favorite bool, previous Game, current Game)
+
 type OptionFunc func(s *SportBoard) error
 
 // SportBoard implements board.Board
@@ -49,6 +55,7 @@ type SportBoard struct {
 	logoDrawCache        map[string]image.Image
 	scoreWriters         map[string]*rgbrender.TextWriter
 	timeWriters          map[string]*rgbrender.TextWriter
+	playWriters          map[string]*rgbrender.TextWriter
 	teamInfoWidths       map[string]map[string]int
 	watchTeams           []string
 	teamInfoLock         sync.RWMutex
@@ -63,6 +70,12 @@ type SportBoard struct {
 	enabler              board.Enabler
 	detailedLiveRenderer DetailedLiveRender
 	leagueLogoGetter     logo.SourceGetter
+	gameUpdateNotifier   GameUpdateNotifier
+	interrupter          board.Interrupter
+	stopScoreWatch       context.CancelFunc
+	cacheCron            *cron.Cron
+	// resumeGameID is the game on the screen, so a board that is interrupted resumes from it
+	resumeGameID *atomic.Int64
 	sync.Mutex
 }
 
@@ -73,46 +86,62 @@ type Todayer func() []time.Time
 
 // Config ...
 type Config struct {
-	TodayFunc            Todayer
-	boardDelay           time.Duration
-	scrollDelay          time.Duration
-	stickyDelay          *time.Duration
-	TimeColor            color.Color
-	ScoreColor           color.Color
-	StartEnabled         *atomic.Bool      `json:"enabled"`
-	BoardDelay           string            `json:"boardDelay"`
-	FavoriteSticky       *atomic.Bool      `json:"favoriteSticky"`
-	StickyDelay          string            `json:"stickyDelay"`
-	ScoreFont            *FontConfig       `json:"scoreFont"`
-	TimeFont             *FontConfig       `json:"timeFont"`
-	LogoConfigs          []*logo.Config    `json:"logoConfigs"`
-	WatchTeams           []string          `json:"watchTeams"`
-	FavoriteTeams        []string          `json:"favoriteTeams"`
-	HideFavoriteScore    *atomic.Bool      `json:"hideFavoriteScore"`
-	ShowRecord           *atomic.Bool      `json:"showRecord"`
-	GridCols             int               `json:"gridCols"`
-	GridRows             int               `json:"gridRows"`
-	GridPadRatio         float64           `json:"gridPadRatio"`
-	MinimumGridWidth     int               `json:"minimumGridWidth"`
-	MinimumGridHeight    int               `json:"minimumGridHeight"`
-	Stats                *statboard.Config `json:"stats"`
-	Headlines            *textboard.Config `json:"headlines"`
-	ScrollMode           *atomic.Bool      `json:"scrollMode"`
-	TightScroll          *atomic.Bool      `json:"tightScroll"`
-	TightScrollPadding   int               `json:"tightScrollPadding"`
-	ScrollDelay          string            `json:"scrollDelay"`
-	GamblingSpread       *atomic.Bool      `json:"showOdds"`
-	ShowNoScheduledLogo  *atomic.Bool      `json:"showNotScheduled"`
-	ScoreHighlightRepeat *int              `json:"scoreHighlightRepeat"`
-	OnTimes              []string          `json:"onTimes"`
-	OffTimes             []string          `json:"offTimes"`
-	UseGradient          *atomic.Bool      `json:"useGradient"`
-	LiveOnly             *atomic.Bool      `json:"liveOnly"`
-	DetailedLive         *atomic.Bool      `json:"detailedLive"`
-	ShowLeagueLogo       *atomic.Bool      `json:"showLeagueLogo"`
-	Enable24Hour         *atomic.Bool      `json:"enable24Hour"`
-	AdvanceDays          int               `json:"advanceDays"`
-	PreviousDays         int               `json:"previousDays"`
+	TodayFunc              Todayer `json:"-"`
+	boardDelay             time.Duration
+	scrollDelay            time.Duration
+	stickyDelay            *time.Duration
+	interruptDuration      time.Duration
+	interruptCheckInterval time.Duration
+	playByPlayDelay        time.Duration
+	leadersDelay           time.Duration
+	TimeColor              color.Color
+	ScoreColor             color.Color
+	StartEnabled           *atomic.Bool           `json:"enabled"`
+	BoardDelay             string                 `json:"boardDelay"`
+	FavoriteSticky         *atomic.Bool           `json:"favoriteSticky"`
+	StickyDelay            string                 `json:"stickyDelay"`
+	ScoreFont              *FontConfig            `json:"scoreFont"`
+	TimeFont               *FontConfig            `json:"timeFont"`
+	LogoConfigs            []*logo.Config         `json:"logoConfigs"`
+	WatchTeams             []string               `json:"watchTeams"`
+	FavoriteTeams          []string               `json:"favoriteTeams"`
+	HideFavoriteScore      *atomic.Bool           `json:"hideFavoriteScore"`
+	ShowRecord             *atomic.Bool           `json:"showRecord"`
+	GridCols               int                    `json:"gridCols"`
+	GridRows               int                    `json:"gridRows"`
+	GridPadRatio           float64                `json:"gridPadRatio"`
+	MinimumGridWidth       int                    `json:"minimumGridWidth"`
+	MinimumGridHeight      int                    `json:"minimumGridHeight"`
+	Stats                  *statboard.Config      `json:"stats"`
+	Headlines              *textboard.Config      `json:"headlines"`
+	Standings              *standingsboard.Config `json:"standings"`
+	ScrollMode             *atomic.Bool           `json:"scrollMode"`
+	TightScroll            *atomic.Bool           `json:"tightScroll"`
+	TightScrollPadding     int                    `json:"tightScrollPadding"`
+	ScrollDelay            string                 `json:"scrollDelay"`
+	GamblingSpread         *atomic.Bool           `json:"showOdds"`
+	ShowNoScheduledLogo    *atomic.Bool           `json:"showNotScheduled"`
+	ScoreHighlightRepeat   *int                   `json:"scoreHighlightRepeat"`
+	OnTimes                []string               `json:"onTimes"`
+	OffTimes               []string               `json:"offTimes"`
+	UseGradient            *atomic.Bool           `json:"useGradient"`
+	LiveOnly               *atomic.Bool           `json:"liveOnly"`
+	DetailedLive           *atomic.Bool           `json:"detailedLive"`
+	ShowLeagueLogo         *atomic.Bool           `json:"showLeagueLogo"`
+	Enable24Hour           *atomic.Bool           `json:"enable24Hour"`
+	AdvanceDays            int                    `json:"advanceDays"`
+	PreviousDays           int                    `json:"previousDays"`
+	InterruptOnScore       *atomic.Bool           `json:"interruptOnScore"`
+	InterruptDuration      string                 `json:"interruptDuration"`
+	InterruptPriority      int                    `json:"interruptPriority"`
+	InterruptCheckInterval string                 `json:"interruptCheckInterval"`
+	PlayByPlay             *atomic.Bool           `json:"playByPlay"`
+	PlayByPlayMax          int                    `json:"playByPlayMax"`
+	ScoringPlaysOnly       *atomic.Bool           `json:"scoringPlaysOnly"`
+	PlayByPlayScrollDelay  string                 `json:"playByPlayScrollDelay"`
+	Leaders                *atomic.Bool           `json:"leaders"`
+	LeaderCategories       []string               `json:"leaderCategories"`
+	LeadersDelay           string                 `json:"leadersDelay"`
 }
 
 // FontConfig ...
@@ -165,6 +194,38 @@ type Game interface {
 	GetOdds() (string, string, error)
 }
 
+// Play is a single play of a live game
+type Play struct {
+	// Team is the abbreviation of the team that made the play
+	Team    string
+	Period  string
+	Clock   string
+	Text    string
+	Scoring bool
+}
+
+// PlayByPlayer is implemented by Games that have play-by-play details while they are live
+type PlayByPlayer interface {
+	// GetPlays returns the plays of the game in the order they happened
+	GetPlays(ctx context.Context) ([]*Play, error)
+}
+
+// Leader is a team's top performer in a stat category
+type Leader struct {
+	// Category is the stat category, ie. "passingYards"
+	Category string
+	// Abbreviation is the short name of the stat category, ie. "PYDS"
+	Abbreviation string
+	Name         string
+	Value        string
+}
+
+// Leaderer is implemented by Games that have box score leaders
+type Leaderer interface {
+	// GetLeaders returns the home and away team leaders
+	GetLeaders(ctx context.Context) ([]*Leader, []*Leader, error)
+}
+
 // SetDefaults sets config defaults
 func (c *Config) SetDefaults() {
 	if c.BoardDelay != "" {
@@ -243,6 +304,55 @@ func (c *Config) SetDefaults() {
 	if c.Enable24Hour == nil {
 		c.Enable24Hour = atomic.NewBool(false)
 	}
+
+	if c.InterruptOnScore == nil {
+		c.InterruptOnScore = atomic.NewBool(false)
+	}
+	c.interruptDuration = 20 * time.Second
+	if c.InterruptDuration != "" {
+		d, err := time.ParseDuration(c.InterruptDuration)
+		if err == nil {
+			c.interruptDuration = d
+		}
+	}
+	if c.InterruptPriority == 0 {
+		c.InterruptPriority = 1
+	}
+	c.interruptCheckInterval = 30 * time.Second
+	if c.InterruptCheckInterval != "" {
+		d, err := time.ParseDuration(c.InterruptCheckInterval)
+		if err == nil && d > 0 {
+			c.interruptCheckInterval = d
+		}
+	}
+
+	if c.PlayByPlay == nil {
+		c.PlayByPlay = atomic.NewBool(false)
+	}
+	if c.ScoringPlaysOnly == nil {
+		c.ScoringPlaysOnly = atomic.NewBool(false)
+	}
+	if c.PlayByPlayMax == 0 {
+		c.PlayByPlayMax = 5
+	}
+	c.playByPlayDelay = defaultPlayByPlayDelay
+	if c.PlayByPlayScrollDelay != "" {
+		d, err := time.ParseDuration(c.PlayByPlayScrollDelay)
+		if err == nil {
+			c.playByPlayDelay = d
+		}
+	}
+
+	if c.Leaders == nil {
+		c.Leaders = atomic.NewBool(false)
+	}
+	c.leadersDelay = defaultLeadersDelay
+	if c.LeadersDelay != "" {
+		d, err := time.ParseDuration(c.LeadersDelay)
+		if err == nil {
+			c.leadersDelay = d
+		}
+	}
 }
 
 // New ...
@@ -255,10 +365,12 @@ func New(ctx context.Context, api API, bounds image.Rectangle, today *time.Time,
 		logoDrawCache:   make(map[string]image.Image),
 		cachedLiveGames: make(map[int]Game),
 		timeWriters:     make(map[string]*rgbrender.TextWriter),
+		playWriters:     make(map[string]*rgbrender.TextWriter),
 		scoreWriters:    make(map[string]*rgbrender.TextWriter),
 		cancelBoard:     make(chan struct{}),
 		teamInfoWidths:  make(map[string]map[string]int),
 		enabler:         enabler.New(),
+		resumeGameID:    atomic.NewInt64(0),
 	}
 
 	if config.StartEnabled.Load() {
@@ -331,25 +443,11 @@ func New(ctx context.Context, api API, bounds image.Rectangle, today *time.Time,
 		),
 	)
 
-	if err := util.SetCrons([]string{"0 4 * * *"}, s.cacheClear); err != nil {
+	var err error
+	s.cacheCron, err = util.SetCrons([]string{"0 4 * * *"}, s.cacheClear)
+	if err != nil {
 		return nil, fmt.Errorf("failed to set cron for cacheClear: %w", err)
 	}
-	if err := util.SetCrons(config.OnTimes, func() {
-		s.log.Info("sportboard turning on",
-			zap.String("league", s.api.League()),
-		)
-		s.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		s.log.Info("sportboard turning off",
-			zap.String("league", s.api.League()),
-		)
-		s.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
 
 	for _, o := range opts {
 		if err := o(s); err != nil {
@@ -396,6 +494,27 @@ func (s *SportBoard) Name() string {
 	return "SportBoard"
 }
 
+// Close stops watching favorite team scores and removes the board's cache clearing cron
+func (s *SportBoard) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.stopScoreWatch != nil {
+		s.stopScoreWatch()
+		s.stopScoreWatch = nil
+	}
+	if s.cacheCron != nil {
+		s.cacheCron.Stop()
+	}
+
+	return nil
+}
+
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *SportBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
 func (s *SportBoard) Enabler() board.Enabler {
 	return s.enabler
 }
@@ -641,10 +760,24 @@ OUTER:
 		return nil, s.renderNoScheduled(s.renderCtx, canvas)
 	}
 
+	startIndex := 0
+	if board.Resuming(ctx) {
+		for i, game := range games {
+			if int64(game.GetID()) == s.resumeGameID.Load() {
+				startIndex = i
+				s.log.Debug("resuming interrupted board",
+					zap.String("league", s.api.League()),
+					zap.Int("game ID", game.GetID()),
+				)
+				break
+			}
+		}
+	}
+
 	preloader := make(map[int]chan struct{})
-	preloader[games[0].GetID()] = make(chan struct{}, 1)
+	preloader[games[startIndex].GetID()] = make(chan struct{}, 1)
 
-	if err := s.preloadLiveGame(ctx, games[0], preloader[games[0].GetID()]); err != nil {
+	if err := s.preloadLiveGame(ctx, games[startIndex], preloader[games[startIndex].GetID()]); err != nil {
 		s.log.Error("error while loading live game data for first game", zap.Error(err))
 	}
 
@@ -682,7 +815,7 @@ OUTER:
 		}()
 	}
 
-	if s.config.ShowLeagueLogo.Load() {
+	if s.config.ShowLeagueLogo.Load() && startIndex == 0 {
 		if err := s.renderLeagueLogo(ctx, canvas); err != nil {
 			return nil, err
 		}
@@ -702,13 +835,16 @@ OUTER:
 	}
 
 GAMES:
-	for gameIndex, game := range games {
+	for gameIndex := startIndex; gameIndex < len(games); gameIndex++ {
+		game := games[gameIndex]
 		select {
 		case <-s.renderCtx.Done():
 			return nil, context.Canceled
 		default:
 		}
 
+		s.resumeGameID.Store(int64(game.GetID()))
+
 		if !s.Enabler().Enabled() {
 			s.log.Warn("skipping disabled board", zap.String("board", s.api.League()))
 			return nil, nil
@@ -771,6 +907,13 @@ GAMES:
 			tightCanvas.AddCanvas(canvas)
 
 			draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+			if _, err := s.addLeaders(s.renderCtx, base, tightCanvas, cachedGame); err != nil {
+				s.log.Error("failed to add leaders", zap.Error(err))
+			}
+			if _, err := s.addPlays(s.renderCtx, base, tightCanvas, cachedGame); err != nil {
+				s.log.Error("failed to add play-by-play", zap.Error(err))
+			}
 			continue GAMES
 		}
 
@@ -786,8 +929,19 @@ GAMES:
 			case <-time.After(s.config.boardDelay):
 			}
 		}
+
+		if err := s.renderLeaders(s.renderCtx, canvas, cachedGame); err != nil {
+			s.log.Error("failed to render leaders", zap.Error(err))
+		}
+
+		if err := s.renderPlays(s.renderCtx, canvas, cachedGame); err != nil {
+			s.log.Error("failed to render play-by-play", zap.Error(err))
+		}
 	}
 
+	// The board finished, so the next render starts from the first game
+	s.resumeGameID.Store(0)
+
 	if canvas.Scrollable() && tightCanvas != nil {
 		return tightCanvas, nil
 	}
@@ -977,32 +1131,8 @@ func (s *SportBoard) renderGame(ctx context.Context, canvas board.Canvas, liveGa
 
 	FAV:
 		for {
-			if s.config.DetailedLive.Load() && s.detailedLiveRenderer != nil {
-				h, err := liveGame.HomeTeam()
-				if err != nil {
-					return err
-				}
-				a, err := liveGame.AwayTeam()
-				if err != nil {
-					return err
-				}
-				hLogo, err := s.getLogo(ctx, h.GetID())
-				if err != nil {
-					return err
-				}
-				aLogo, err := s.getLogo(ctx, a.GetID())
-				if err != nil {
-					return err
-				}
-
-				if err := s.detailedLiveRenderer(ctx, canvas, liveGame, hLogo, aLogo); err != nil {
-					return err
-				}
-				draw.Draw(canvas, counter.Bounds(), counter, image.Point{}, draw.Over)
-			} else {
-				if err := s.renderLiveGame(ctx, canvas, liveGame, counter); err != nil {
-					return fmt.Errorf("failed to render live game: %w", err)
-				}
+			if err := s.renderLive(ctx, canvas, liveGame, counter); err != nil {
+				return err
 			}
 			if !(isFavorite && s.config.FavoriteSticky.Load()) {
 				break FAV
@@ -1033,6 +1163,42 @@ func (s *SportBoard) renderGame(ctx context.Context, canvas board.Canvas, liveGa
 	return nil
 }
 
+// renderLive draws a live game with the detailed renderer if it is enabled
+func (s *SportBoard) renderLive(ctx context.Context, canvas board.Canvas, liveGame Game, counter image.Image) error {
+	if !s.config.DetailedLive.Load() || s.detailedLiveRenderer == nil {
+		if err := s.renderLiveGame(ctx, canvas, liveGame, counter); err != nil {
+			return fmt.Errorf("failed to render live game: %w", err)
+		}
+		return nil
+	}
+
+	h, err := liveGame.HomeTeam()
+	if err != nil {
+		return err
+	}
+	a, err := liveGame.AwayTeam()
+	if err != nil {
+		return err
+	}
+	hLogo, err := s.getLogo(ctx, h.GetID())
+	if err != nil {
+		return err
+	}
+	aLogo, err := s.getLogo(ctx, a.GetID())
+	if err != nil {
+		return err
+	}
+
+	if err := s.detailedLiveRenderer(ctx, canvas, liveGame, hLogo, aLogo); err != nil {
+		return err
+	}
+	if counter != nil {
+		draw.Draw(canvas, counter.Bounds(), counter, image.Point{}, draw.Over)
+	}
+
+	return nil
+}
+
 // HasPriority ...
 func (s *SportBoard) HasPriority() bool {
 	return false
@@ -1044,6 +1210,17 @@ func (s *SportBoard) setCachedGame(key int, game Game) {
 	s.cachedLiveGames[key] = game
 }
 
+// swapCachedGame caches a game and returns the game it replaced, or nil. The render loop
+// and the score watcher both update live games, so swapping under the lock makes sure
+// each change is compared against the previous state exactly once.
+func (s *SportBoard) swapCachedGame(key int, game Game) Game {
+	s.Lock()
+	defer s.Unlock()
+	previous := s.cachedLiveGames[key]
+	s.cachedLiveGames[key] = game
+	return previous
+}
+
 func (s *SportBoard) getCachedGame(key int) (Game, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -1122,7 +1299,23 @@ func (s *SportBoard) preloadLiveGame(ctx context.Context, game Game, preload cha
 			continue
 		}
 
-		s.setCachedGame(game.GetID(), g)
+		previous := s.swapCachedGame(game.GetID(), g)
+
+		if s.gameUpdateNotifier != nil {
+			p := previous
+			if p == nil {
+				p = game
+			}
+			isFavorite, err := s.isFavoriteGame(g)
+			if err != nil {
+				isFavorite = false
+			}
+			s.gameUpdateNotifier(s.api.League(), isFavorite, p, g)
+		}
+
+		if previous != nil {
+			s.interruptOnScore(previous, g)
+		}
 
 		s.log.Debug("successfully set preloader data", zap.Int("game ID", game.GetID()))
 		return nil
@@ -1161,3 +1354,132 @@ func WithLeagueLogoGetter(g logo.SourceGetter) OptionFunc {
 		return nil
 	}
 }
+
+// SetInterrupter sets the func used to interrupt the matrix when a favorite team scores.
+// Favorite team games are watched in the background, so they interrupt whatever board is on screen.
+func (s *SportBoard) SetInterrupter(i board.Interrupter) {
+	s.Lock()
+	defer s.Unlock()
+	s.interrupter = i
+
+	if s.stopScoreWatch != nil {
+		s.stopScoreWatch()
+		s.stopScoreWatch = nil
+	}
+	if i == nil || !s.config.InterruptOnScore.Load() {
+		return
+	}
+	var ctx context.Context
+	ctx, s.stopScoreWatch = context.WithCancel(context.Background())
+	go s.watchScores(ctx)
+}
+
+// watchScores updates live favorite team games every interruptCheckInterval
+func (s *SportBoard) watchScores(ctx context.Context) {
+	ticker := time.NewTicker(s.config.interruptCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.checkScores(ctx); err != nil {
+			s.log.Error("failed to check favorite team scores",
+				zap.String("league", s.api.League()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (s *SportBoard) checkScores(ctx context.Context) error {
+	if !s.config.InterruptOnScore.Load() || !s.enabler.Enabled() {
+		return nil
+	}
+
+	games, err := s.api.GetScheduledGames(ctx, s.config.TodayFunc())
+	if err != nil {
+		return err
+	}
+
+	for _, game := range games {
+		if isFavorite, err := s.isFavoriteGame(game); err != nil || !isFavorite {
+			continue
+		}
+		if err := s.preloadLiveGame(ctx, game, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// interruptOnScore requests the screen when a favorite team's live game has a score change
+func (s *SportBoard) interruptOnScore(previous Game, current Game) {
+	s.Lock()
+	interrupter := s.interrupter
+	s.Unlock()
+
+	if interrupter == nil || !s.config.InterruptOnScore.Load() || !s.enabler.Enabled() {
+		return
+	}
+
+	if live, err := current.IsLive(); err != nil || !live {
+		return
+	}
+
+	if isFavorite, err := s.isFavoriteGame(current); err != nil || !isFavorite {
+		return
+	}
+
+	changed, err := scoreChanged(previous, current)
+	if err != nil || !changed {
+		return
+	}
+
+	s.log.Info("favorite team game score changed, interrupting matrix",
+		zap.String("league", s.api.League()),
+		zap.Int("game ID", current.GetID()),
+	)
+
+	interrupter(&board.Interrupt{
+		Board:    s.Name(),
+		Priority: s.config.InterruptPriority,
+		Duration: s.config.interruptDuration,
+		Render: func(ctx context.Context, canvas board.Canvas) error {
+			return s.renderLive(ctx, canvas, current, nil)
+		},
+	})
+}
+
+func scoreChanged(previous Game, current Game) (bool, error) {
+	prevHome, err := previous.HomeTeam()
+	if err != nil {
+		return false, err
+	}
+	prevAway, err := previous.AwayTeam()
+	if err != nil {
+		return false, err
+	}
+	home, err := current.HomeTeam()
+	if err != nil {
+		return false, err
+	}
+	away, err := current.AwayTeam()
+	if err != nil {
+		return false, err
+	}
+
+	return prevHome.Score() != home.Score() || prevAway.Score() != away.Score(), nil
+}
+
+// WithGameUpdateNotifier sets a func that is called whenever live game data is updated
+func WithGameUpdateNotifier(n GameUpdateNotifier) OptionFunc {
+	return func(s *SportBoard) error {
+		s.gameUpdateNotifier = n
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+package sportboard
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+)
+
+type testAPI struct {
+	API
+}
+
+func (a *testAPI) League() string { return "TEST" }
+
+type testTeam struct {
+	Team
+	abbreviation string
+	score        int
+}
+
+func (t *testTeam) GetAbbreviation() string { return t.abbreviation }
+func (t *testTeam) Score() int              { return t.score }
+
+// testGame is a live game whose updates return the given home score
+type testGame struct {
+	Game
+	home   int
+	update int
+}
+
+func (g *testGame) GetID() int                { return 1 }
+func (g *testGame) IsLive() (bool, error)     { return true, nil }
+func (g *testGame) IsComplete() (bool, error) { return false, nil }
+
+func (g *testGame) HomeTeam() (Team, error) {
+	return &testTeam{abbreviation: "HOME", score: g.home}, nil
+}
+
+func (g *testGame) AwayTeam() (Team, error) {
+	return &testTeam{abbreviation: "AWAY"}, nil
+}
+
+func (g *testGame) GetStartTime(ctx context.Context) (time.Time, error) {
+	return time.Now(), nil
+}
+
+func (g *testGame) GetUpdate(ctx context.Context) (Game, error) {
+	// Slow enough that concurrent updates overlap
+	time.Sleep(10 * time.Millisecond)
+	return &testGame{home: g.update, update: g.update}, nil
+}
+
+func TestPreloadLiveGameReportsOnce(t *testing.T) {
+	t.Parallel()
+
+	interrupts := atomic.NewInt32(0)
+	scoreUpdates := atomic.NewInt32(0)
+
+	s := &SportBoard{
+		api: &testAPI{},
+		log: zap.NewNop(),
+		config: &Config{
+			FavoriteTeams:    []string{"HOME"},
+			InterruptOnScore: atomic.NewBool(true),
+		},
+		cachedLiveGames: make(map[int]Game),
+		enabler:         enabler.New(),
+		interrupter: func(i *board.Interrupt) {
+			interrupts.Inc()
+		},
+		gameUpdateNotifier: func(league string, favorite bool, previous Game, current Game) {
+			if changed, err := scoreChanged(previous, current); err == nil && changed {
+				scoreUpdates.Inc()
+			}
+		},
+	}
+	s.enabler.Enable()
+	s.setCachedGame(1, &testGame{})
+
+	// The render loop and the score watcher update the same game concurrently
+	game := &testGame{update: 1}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, s.preloadLiveGame(context.Background(), game, nil))
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), interrupts.Load())
+	require.Equal(t, int32(1), scoreUpdates.Load())
+}
@@ -0,0 +1,386 @@
+package standingsboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	titleColor    = color.RGBA{R: 0, G: 150, B: 255, A: 255}
+	favoriteColor = color.RGBA{R: 255, G: 215, B: 0, A: 255}
+	columnLabels  = []string{"", "W-L", "PCT", "GB", "STRK"}
+)
+
+// minLabelRows is the number of rows a canvas must fit before the column labels are shown
+const minLabelRows = 5
+
+type row struct {
+	cols []string
+	clr  color.Color
+	// title rows span the width of the canvas
+	title bool
+}
+
+func (s *StandingsBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.cancelBoard:
+			cancel()
+			return
+		case <-ticker.C:
+			if !s.Enabler().Enabled() {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// Render ...
+func (s *StandingsBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	boardCtx, boardCancel := context.WithCancel(ctx)
+	defer boardCancel()
+
+	go s.enablerCancel(boardCtx, boardCancel)
+
+	standings, err := s.getStandings(boardCtx)
+	if err != nil {
+		return err
+	}
+
+	groups := groupStandings(standings)
+	if len(groups) < 1 {
+		s.log.Warn("no standings found",
+			zap.String("league", s.api.League()),
+		)
+		return nil
+	}
+
+	writer, err := s.writers.Get(canvas.Bounds())
+	if err != nil {
+		return err
+	}
+
+	if s.config.ScrollMode.Load() && canvas.Scrollable() {
+		return s.doScroll(boardCtx, canvas, writer, groups)
+	}
+
+	return s.doRender(boardCtx, canvas, writer, groups)
+}
+
+// ScrollRender renders each page of standings side by side for a horizontal scroll
+func (s *StandingsBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok {
+		return nil, fmt.Errorf("unexpected canvas type for standings board")
+	}
+
+	standings, err := s.getStandings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := s.writers.Get(canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+
+	tightCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tight scroll canvas: %w", err)
+	}
+	tightCanvas.SetScrollDirection(scrcnvs.RightToLeft)
+	tightCanvas.SetScrollSpeed(base.GetScrollSpeed())
+
+	bounds := rgbrender.ZeroedBounds(canvas.Bounds())
+	widths, err := s.columnWidths(writer, bounds, standings)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range s.pages(groupStandings(standings), rowsPerPage(bounds, writer)) {
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+		if err := drawRows(canvas, bounds, writer, widths, page, bounds.Dy()/rowsPerPage(bounds, writer)); err != nil {
+			return nil, err
+		}
+		tightCanvas.AddCanvas(canvas)
+	}
+	draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+	go tightCanvas.MatchScroll(ctx, base)
+
+	return tightCanvas, nil
+}
+
+func (s *StandingsBoard) doRender(ctx context.Context, canvas board.Canvas, writer *rgbrender.TextWriter, groups []*Group) error {
+	bounds := canvas.Bounds()
+	widths, err := s.columnWidths(writer, bounds, flatten(groups))
+	if err != nil {
+		return err
+	}
+
+	perPage := rowsPerPage(bounds, writer)
+	rowHeight := bounds.Dy() / perPage
+
+	for _, page := range s.pages(groups, perPage) {
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		default:
+		}
+
+		draw.Draw(canvas, bounds, &image.Uniform{color.Black}, image.Point{}, draw.Over)
+		if err := drawRows(canvas, bounds, writer, widths, page, rowHeight); err != nil {
+			return err
+		}
+
+		if err := canvas.Render(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	return nil
+}
+
+func (s *StandingsBoard) doScroll(ctx context.Context, canvas board.Canvas, writer *rgbrender.TextWriter, groups []*Group) error {
+	scrollCanvas, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok {
+		return fmt.Errorf("incorrect canvas type given for scrolling")
+	}
+
+	origDir := scrollCanvas.GetScrollDirection()
+	defer scrollCanvas.SetScrollDirection(origDir)
+	scrollCanvas.SetScrollDirection(scrcnvs.BottomToTop)
+
+	origPadding := scrollCanvas.GetPadding()
+	defer scrollCanvas.SetPadding(origPadding)
+
+	scrollCanvas.SetScrollSpeed(s.config.scrollDelay)
+	defer func() {
+		s.config.scrollDelay = scrollCanvas.GetScrollSpeed()
+	}()
+
+	scrollCanvas.SetPadding(0)
+	bounds := scrollCanvas.Bounds()
+
+	widths, err := s.columnWidths(writer, bounds, flatten(groups))
+	if err != nil {
+		return err
+	}
+
+	withLabels := rowsPerPage(bounds, writer) >= minLabelRows
+	var rows []*row
+	for _, g := range groups {
+		rows = append(rows, s.headerRows(g, withLabels)...)
+		rows = append(rows, s.teamRows(g)...)
+	}
+
+	rowHeight := int(math.Ceil(writer.FontSize))
+	pad := (len(rows) * rowHeight) - bounds.Dy()
+	if pad > 0 {
+		scrollCanvas.SetPadding(pad)
+	}
+
+	s.log.Debug("standings board scroll",
+		zap.Int("rows", len(rows)),
+		zap.Int("padding", pad),
+	)
+
+	tableBounds := image.Rect(bounds.Min.X, scrollCanvas.Bounds().Min.Y, bounds.Max.X, scrollCanvas.Bounds().Max.Y)
+	if err := drawRows(scrollCanvas, tableBounds, writer, widths, rows, rowHeight); err != nil {
+		return err
+	}
+
+	return scrollCanvas.Render(ctx)
+}
+
+// pages splits the groups into pages of rows, each starting with the group's header rows
+func (s *StandingsBoard) pages(groups []*Group, perPage int) [][]*row {
+	withLabels := perPage >= minLabelRows
+
+	var pages [][]*row
+	for _, g := range groups {
+		header := s.headerRows(g, withLabels)
+		teams := s.teamRows(g)
+
+		numTeams := perPage - len(header)
+		if numTeams < 1 {
+			numTeams = 1
+		}
+
+		for i := 0; i < len(teams); i += numTeams {
+			end := i + numTeams
+			if end > len(teams) {
+				end = len(teams)
+			}
+			page := append([]*row{}, header...)
+			pages = append(pages, append(page, teams[i:end]...))
+		}
+	}
+
+	return pages
+}
+
+func (s *StandingsBoard) headerRows(g *Group, withLabels bool) []*row {
+	rows := []*row{
+		{
+			cols:  []string{g.Name},
+			clr:   titleColor,
+			title: true,
+		},
+	}
+	if withLabels {
+		rows = append(rows, &row{
+			cols: columnLabels,
+			clr:  titleColor,
+		})
+	}
+
+	return rows
+}
+
+func (s *StandingsBoard) teamRows(g *Group) []*row {
+	rows := make([]*row, 0, len(g.Standings))
+	for _, standing := range g.Standings {
+		clr := color.Color(color.White)
+		if s.isFavorite(standing) {
+			clr = favoriteColor
+		}
+		rows = append(rows, &row{
+			cols: standingCols(standing),
+			clr:  clr,
+		})
+	}
+
+	return rows
+}
+
+func standingCols(standing *Standing) []string {
+	return []string{
+		standing.Abbreviation,
+		standing.Record(),
+		standing.Percent(),
+		standing.GamesBehind,
+		standing.Streak,
+	}
+}
+
+// columnWidths measures the widest value of each column. Columns that don't fit
+// the canvas are dropped from the right, and any leftover space is split between
+// the stat columns.
+func (s *StandingsBoard) columnWidths(writer *rgbrender.TextWriter, bounds image.Rectangle, standings []*Standing) ([]int, error) {
+	zeroed := rgbrender.ZeroedBounds(bounds)
+	measureCanvas := image.NewRGBA(zeroed)
+
+	widths, err := writer.MeasureStrings(measureCanvas, columnLabels)
+	if err != nil {
+		return nil, err
+	}
+	for _, standing := range standings {
+		w, err := writer.MeasureStrings(measureCanvas, standingCols(standing))
+		if err != nil {
+			return nil, err
+		}
+		for i := range widths {
+			if w[i] > widths[i] {
+				widths[i] = w[i]
+			}
+		}
+	}
+
+	pad := (zeroed.Dx() / 64) + 1
+	total := 0
+	var cols []int
+	for _, w := range widths {
+		if total+w+pad > zeroed.Dx() {
+			break
+		}
+		total += w + pad
+		cols = append(cols, w+pad)
+	}
+
+	if len(cols) > 1 {
+		leftOver := (zeroed.Dx() - total) / (len(cols) - 1)
+		for i := 1; i < len(cols); i++ {
+			cols[i] += leftOver
+		}
+	}
+
+	return cols, nil
+}
+
+func drawRows(canvas draw.Image, bounds image.Rectangle, writer *rgbrender.TextWriter, widths []int, rows []*row, rowHeight int) error {
+	for i, r := range rows {
+		y := bounds.Min.Y + (i * rowHeight)
+
+		if r.title {
+			if err := writer.WriteAligned(
+				rgbrender.LeftCenter,
+				canvas,
+				image.Rect(bounds.Min.X, y, bounds.Max.X, y+rowHeight),
+				r.cols,
+				r.clr,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		x := bounds.Min.X
+		for j, w := range widths {
+			if j >= len(r.cols) {
+				break
+			}
+			if err := writer.WriteAligned(
+				rgbrender.LeftCenter,
+				canvas,
+				image.Rect(x, y, x+w, y+rowHeight),
+				[]string{r.cols[j]},
+				r.clr,
+			); err != nil {
+				return err
+			}
+			x += w
+		}
+	}
+
+	return nil
+}
+
+func rowsPerPage(bounds image.Rectangle, writer *rgbrender.TextWriter) int {
+	rows := int(math.Floor(float64(bounds.Dy()) / writer.FontSize))
+	if rows < 1 {
+		return 1
+	}
+	return rows
+}
+
+func flatten(groups []*Group) []*Standing {
+	var standings []*Standing
+	for _, g := range groups {
+		standings = append(standings, g.Standings...)
+	}
+	return standings
+}
@@ -0,0 +1,10 @@
+package standingsboard
+
+import (
+	"net/http"
+)
+
+// GetRPCHandler ...
+func (s *StandingsBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
@@ -0,0 +1,294 @@
+package standingsboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+)
+
+var (
+	defaultUpdateInterval = 30 * time.Minute
+	defaultBoardDelay     = 10 * time.Second
+	defaultScrollDelay    = 200 * time.Millisecond
+)
+
+// StandingsBoard displays league standings grouped by conference or division
+type StandingsBoard struct {
+	config        *Config
+	log           *zap.Logger
+	api           API
+	writers       *rgbrender.TextWriters
+	favoriteTeams []string
+	standings     []*Standing
+	lastUpdate    time.Time
+	cancelBoard   chan struct{}
+	rpcServer     pb.TwirpServer
+	enabler       board.Enabler
+	sync.Mutex
+}
+
+// Config ...
+type Config struct {
+	boardDelay     time.Duration
+	updateInterval time.Duration
+	scrollDelay    time.Duration
+	StartEnabled   *atomic.Bool `json:"enabled"`
+	BoardDelay     string       `json:"boardDelay"`
+	UpdateInterval string       `json:"updateInterval"`
+	FavoriteTeams  []string     `json:"favoriteTeams"`
+	OnTimes        []string     `json:"onTimes"`
+	OffTimes       []string     `json:"offTimes"`
+	ScrollMode     *atomic.Bool `json:"scrollMode"`
+	ScrollDelay    string       `json:"scrollDelay"`
+}
+
+// OptionFunc provides options to the StandingsBoard that are not exposed in a Config
+type OptionFunc func(s *StandingsBoard) error
+
+// API ...
+type API interface {
+	League() string
+	HTTPPathPrefix() string
+	GetStandings(ctx context.Context) ([]*Standing, error)
+}
+
+// Standing is a team's record within its group
+type Standing struct {
+	TeamID       string
+	Abbreviation string
+	// Group identifies the conference or division the team is standing in
+	Group string
+	// GroupName is the display name of the Group
+	GroupName   string
+	Wins        int
+	Losses      int
+	Ties        int
+	WinPercent  float64
+	GamesBehind string
+	Streak      string
+}
+
+// Record is the team's W-L, or W-L-T when the team has ties
+func (s *Standing) Record() string {
+	if s.Ties > 0 {
+		return fmt.Sprintf("%d-%d-%d", s.Wins, s.Losses, s.Ties)
+	}
+	return fmt.Sprintf("%d-%d", s.Wins, s.Losses)
+}
+
+// Percent is the win percentage formatted the way standings tables show it, ie. .625
+func (s *Standing) Percent() string {
+	p := fmt.Sprintf("%.3f", s.WinPercent)
+	return strings.TrimPrefix(p, "0")
+}
+
+// Group is a set of standings in the same conference or division
+type Group struct {
+	Name      string
+	Standings []*Standing
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.ScrollMode == nil {
+		c.ScrollMode = atomic.NewBool(false)
+	}
+
+	c.boardDelay = defaultBoardDelay
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err == nil {
+			c.boardDelay = d
+		}
+	}
+
+	c.updateInterval = defaultUpdateInterval
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err == nil {
+			c.updateInterval = d
+		}
+	}
+
+	c.scrollDelay = defaultScrollDelay
+	if c.ScrollDelay != "" {
+		d, err := time.ParseDuration(c.ScrollDelay)
+		if err == nil {
+			c.scrollDelay = d
+		}
+	}
+}
+
+// New ...
+func New(api API, config *Config, logger *zap.Logger, opts ...OptionFunc) (*StandingsBoard, error) {
+	s := &StandingsBoard{
+		config:        config,
+		log:           logger,
+		api:           api,
+		favoriteTeams: config.FavoriteTeams,
+		cancelBoard:   make(chan struct{}),
+		enabler:       enabler.New(),
+	}
+
+	s.writers = rgbrender.NewTextWriters(s.setupWriter)
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	for _, f := range opts {
+		if err := f(s); err != nil {
+			return nil, err
+		}
+	}
+
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	prfx = fmt.Sprintf("/standings%s", prfx)
+
+	s.rpcServer = twirphelpers.NewBasicBoardServer(s, s.config.ScrollMode, s.cancelBoard, prfx, s.log)
+	s.log.Info("registering RPC server for standings board",
+		zap.String("league", s.api.League()),
+		zap.String("prefix", s.rpcServer.PathPrefix()),
+	)
+
+	return s, nil
+}
+
+// Enabler ...
+func (s *StandingsBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *StandingsBoard) InBetween() bool {
+	return false
+}
+
+// Name ...
+func (s *StandingsBoard) Name() string {
+	return fmt.Sprintf("Standings: %s", s.api.League())
+}
+
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *StandingsBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
+// Clear ...
+func (s *StandingsBoard) Clear() error {
+	return nil
+}
+
+// Close ...
+func (s *StandingsBoard) Close() error {
+	return nil
+}
+
+// ScrollMode ...
+func (s *StandingsBoard) ScrollMode() bool {
+	return s.config.ScrollMode.Load()
+}
+
+// GetHTTPHandlers ...
+func (s *StandingsBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return []*board.HTTPHandler{}, nil
+}
+
+// WithFavoriteTeams highlights the given teams, in addition to the favorite teams in the Config
+func WithFavoriteTeams(teams []string) OptionFunc {
+	return func(s *StandingsBoard) error {
+		s.favoriteTeams = append(append([]string{}, s.config.FavoriteTeams...), teams...)
+		return nil
+	}
+}
+
+func (s *StandingsBoard) isFavorite(standing *Standing) bool {
+	for _, t := range s.favoriteTeams {
+		if strings.EqualFold(t, standing.Abbreviation) {
+			return true
+		}
+	}
+	return false
+}
+
+// getStandings returns the cached standings, updating them once the update interval has passed
+func (s *StandingsBoard) getStandings(ctx context.Context) ([]*Standing, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if len(s.standings) > 0 && time.Since(s.lastUpdate) < s.config.updateInterval {
+		return s.standings, nil
+	}
+
+	s.log.Info("updating standings",
+		zap.String("league", s.api.League()),
+	)
+	standings, err := s.api.GetStandings(ctx)
+	if err != nil {
+		if len(s.standings) > 0 {
+			s.log.Error("failed to update standings, using previous standings",
+				zap.String("league", s.api.League()),
+				zap.Error(err),
+			)
+			return s.standings, nil
+		}
+		return nil, err
+	}
+
+	s.standings = standings
+	s.lastUpdate = time.Now()
+
+	return s.standings, nil
+}
+
+// groupStandings groups standings in the order the groups were first seen, with
+// each group ordered by win percentage
+func groupStandings(standings []*Standing) []*Group {
+	var groups []*Group
+	byName := make(map[string]*Group)
+
+	for _, standing := range standings {
+		g, ok := byName[standing.Group]
+		if !ok {
+			name := standing.GroupName
+			if name == "" {
+				name = standing.Group
+			}
+			g = &Group{
+				Name: name,
+			}
+			byName[standing.Group] = g
+			groups = append(groups, g)
+		}
+		g.Standings = append(g.Standings, standing)
+	}
+
+	for _, g := range groups {
+		sort.SliceStable(g.Standings, func(i, j int) bool {
+			if g.Standings[i].WinPercent == g.Standings[j].WinPercent {
+				return g.Standings[i].Wins > g.Standings[j].Wins
+			}
+			return g.Standings[i].WinPercent > g.Standings[j].WinPercent
+		})
+	}
+
+	return groups
+}
@@ -0,0 +1,74 @@
+package standingsboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGroupStandings(t *testing.T) {
+	t.Parallel()
+
+	standings := []*Standing{
+		{Abbreviation: "NYR", Group: "EAST_METRO", GroupName: "Metropolitan", Wins: 10, Losses: 10, WinPercent: 0.5},
+		{Abbreviation: "BOS", Group: "EAST_ATL", GroupName: "Atlantic", Wins: 12, Losses: 4, WinPercent: 0.75},
+		{Abbreviation: "NYI", Group: "EAST_METRO", GroupName: "Metropolitan", Wins: 15, Losses: 5, WinPercent: 0.75},
+		{Abbreviation: "NJD", Group: "EAST_METRO", GroupName: "Metropolitan", Wins: 16, Losses: 4, WinPercent: 0.75},
+		{Abbreviation: "MTL", Group: "EAST_ATL", Wins: 2, Losses: 14, WinPercent: 0.125},
+	}
+
+	groups := groupStandings(standings)
+	require.Len(t, groups, 2)
+
+	require.Equal(t, "Metropolitan", groups[0].Name)
+	abbrevs := []string{}
+	for _, s := range groups[0].Standings {
+		abbrevs = append(abbrevs, s.Abbreviation)
+	}
+	require.Equal(t, []string{"NJD", "NYI", "NYR"}, abbrevs)
+
+	require.Equal(t, "Atlantic", groups[1].Name)
+	require.Len(t, groups[1].Standings, 2)
+}
+
+func TestPages(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{
+		FavoriteTeams: []string{"nyi"},
+	}
+	cfg.SetDefaults()
+	s := &StandingsBoard{
+		config:        cfg,
+		log:           zap.NewNop(),
+		favoriteTeams: cfg.FavoriteTeams,
+	}
+	require.False(t, cfg.StartEnabled.Load())
+
+	g := &Group{
+		Name: "Metropolitan",
+		Standings: []*Standing{
+			{Abbreviation: "NJD", Wins: 16, Losses: 4, WinPercent: 0.8, GamesBehind: "-", Streak: "W3"},
+			{Abbreviation: "NYI", Wins: 15, Losses: 5, Ties: 1, WinPercent: 0.75, GamesBehind: "1", Streak: "L1"},
+			{Abbreviation: "NYR", Wins: 10, Losses: 10, WinPercent: 0.5, GamesBehind: "6", Streak: "W1"},
+		},
+	}
+
+	pages := s.pages([]*Group{g}, 4)
+	require.Len(t, pages, 1)
+	require.Len(t, pages[0], 4)
+	require.True(t, pages[0][0].title)
+	require.Equal(t, []string{"NYI", "15-5-1", ".750", "1", "L1"}, pages[0][2].cols)
+	require.Equal(t, favoriteColor, pages[0][2].clr)
+
+	pages = s.pages([]*Group{g}, 3)
+	require.Len(t, pages, 2)
+	require.Equal(t, "NYR", pages[1][1].cols[0])
+
+	// Column labels are added once the canvas fits enough rows
+	pages = s.pages([]*Group{g}, minLabelRows)
+	require.Len(t, pages, 1)
+	require.Equal(t, columnLabels, pages[0][1].cols)
+	require.Equal(t, "NYR", pages[0][4].cols[0])
+}
@@ -0,0 +1,23 @@
+package standingsboard
+
+import (
+	"fmt"
+	"image"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// setupWriter scales the font of large canvases
+func (s *StandingsBoard) setupWriter(writer *rgbrender.TextWriter, bounds image.Rectangle) {
+	if bounds.Dy() > 128 && bounds.Dx() > 128 {
+		writer.FontSize = 0.08 * float64(bounds.Dy())
+		writer.YStartCorrection = -1 * ((bounds.Dy() / 64) + 1)
+	}
+
+	s.log.Debug("standings board writer font",
+		zap.Float64("size", writer.FontSize),
+		zap.String("canvas", fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())),
+	)
+}
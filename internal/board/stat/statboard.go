@@ -20,7 +20,6 @@ import (
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
 	"github.com/robbydyer/sports/internal/rgbrender"
 	"github.com/robbydyer/sports/internal/twirphelpers"
-	"github.com/robbydyer/sports/internal/util"
 )
 
 var defaultUpdateInterval = 5 * time.Minute
@@ -177,23 +176,6 @@ func New(ctx context.Context, api API, config *Config, logger *zap.Logger, opts
 		s.sorter = defaultSorter
 	}
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		s.log.Warn("statboard turning on",
-			zap.String("league", s.api.LeagueShortName()),
-		)
-		s.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		s.log.Warn("statboard turning off",
-			zap.String("league", s.api.LeagueShortName()),
-		)
-		s.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-
 	svr := &Server{
 		board: s,
 	}
@@ -239,6 +221,11 @@ func (s *StatBoard) Name() string {
 	return fmt.Sprintf("StatBoard: %s", s.api.LeagueShortName())
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *StatBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
 // Clear ...
 func (s *StatBoard) Clear() error {
 	return nil
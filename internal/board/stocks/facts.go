@@ -0,0 +1,31 @@
+package stockboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+// Facts returns market data for evaluating rules:
+//   - tradingOpen: true if the market is open right now
+//   - minutesToOpen: minutes until the market opens today, negative once it has opened
+func (s *StockBoard) Facts(ctx context.Context) (board.Facts, error) {
+	open, err := s.api.TradingOpen()
+	if err != nil {
+		return nil, err
+	}
+	closing, err := s.api.TradingClose()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	weekday := now.In(open.Location()).Weekday()
+	isTradingDay := weekday != time.Saturday && weekday != time.Sunday
+
+	return board.Facts{
+		"tradingOpen":   isTradingDay && !now.Before(open) && now.Before(closing),
+		"minutesToOpen": int(open.Sub(now).Minutes()),
+	}, nil
+}
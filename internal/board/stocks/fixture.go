@@ -0,0 +1,105 @@
+package stockboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Fixture is a recording of quotes from a provider, used to run the board offline
+type Fixture struct {
+	Provider     string    `json:"provider"`
+	TradingOpen  time.Time `json:"tradingOpen"`
+	TradingClose time.Time `json:"tradingClose"`
+	Stocks       []*Stock  `json:"stocks"`
+}
+
+// FixtureAPI is an API that serves the quotes of a Fixture
+type FixtureAPI struct {
+	fixture *Fixture
+}
+
+// NewFixtureAPI loads a fixture file recorded with RecordFixture. If file is empty, the
+// bundled fixture is used.
+func NewFixtureAPI(file string) (*FixtureAPI, error) {
+	var dat []byte
+	var err error
+	if file == "" {
+		dat, err = assets.ReadFile("assets/mock/stocks.json")
+	} else {
+		dat, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stock fixture: %w", err)
+	}
+
+	var f *Fixture
+	if err := json.Unmarshal(dat, &f); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stock fixture: %w", err)
+	}
+
+	return &FixtureAPI{
+		fixture: f,
+	}, nil
+}
+
+// RecordFixture gets the quotes of the symbols from the API, to be saved as a fixture
+func RecordFixture(ctx context.Context, api API, symbols []string, interval time.Duration) (*Fixture, error) {
+	open, err := api.TradingOpen()
+	if err != nil {
+		return nil, err
+	}
+	close, err := api.TradingClose()
+	if err != nil {
+		return nil, err
+	}
+
+	stocks, err := api.Get(ctx, symbols, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Fixture{
+		Provider:     api.Provider(),
+		TradingOpen:  open,
+		TradingClose: close,
+		Stocks:       stocks,
+	}, nil
+}
+
+// Provider ...
+func (f *FixtureAPI) Provider() string {
+	return "fixture"
+}
+
+// Get returns the recorded quotes of the symbols. Symbols that weren't recorded are skipped.
+func (f *FixtureAPI) Get(ctx context.Context, symbols []string, interval time.Duration) ([]*Stock, error) {
+	stocks := []*Stock{}
+	for _, sym := range symbols {
+		for _, stock := range f.fixture.Stocks {
+			if strings.EqualFold(stock.Symbol, sym) {
+				stocks = append(stocks, stock)
+				break
+			}
+		}
+	}
+
+	return stocks, nil
+}
+
+// TradingOpen returns the open of the recorded trading day
+func (f *FixtureAPI) TradingOpen() (time.Time, error) {
+	return f.fixture.TradingOpen, nil
+}
+
+// TradingClose returns the close of the recorded trading day
+func (f *FixtureAPI) TradingClose() (time.Time, error) {
+	return f.fixture.TradingClose, nil
+}
+
+// CacheClear does nothing
+func (f *FixtureAPI) CacheClear() {
+}
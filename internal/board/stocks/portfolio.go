@@ -0,0 +1,156 @@
+package stockboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"strings"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// Position is a holding of shares of a stock
+type Position struct {
+	Symbol string  `json:"symbol"`
+	Shares float64 `json:"shares"`
+	// CostBasis is the average price paid per share
+	CostBasis float64 `json:"costBasis"`
+}
+
+// PositionValue is the value and gain/loss of positions at current prices
+type PositionValue struct {
+	Name      string
+	Value     float64
+	DayChange float64
+	// DayChangePct is the DayChange as a percent of the value at the previous close
+	DayChangePct float64
+	TotalGain    float64
+	// TotalGainPct is the TotalGain as a percent of the cost basis
+	TotalGainPct float64
+}
+
+// portfolioValue values the positions at the prices of the given stocks. Positions in
+// stocks without a quote are skipped.
+func portfolioValue(name string, positions []*Position, stocks []*Stock) *PositionValue {
+	quotes := make(map[string]*Stock, len(stocks))
+	for _, stock := range stocks {
+		quotes[strings.ToUpper(stock.Symbol)] = stock
+	}
+
+	v := &PositionValue{
+		Name: name,
+	}
+	var cost float64
+	for _, p := range positions {
+		stock, ok := quotes[strings.ToUpper(p.Symbol)]
+		if !ok {
+			continue
+		}
+		v.Value += p.Shares * stock.Price
+		v.DayChange += p.Shares * (stock.Price - stock.OpenPrice)
+		cost += p.Shares * p.CostBasis
+	}
+	v.TotalGain = v.Value - cost
+
+	if previous := v.Value - v.DayChange; previous != 0 {
+		v.DayChangePct = v.DayChange / previous * 100.0
+	}
+	if cost != 0 {
+		v.TotalGainPct = v.TotalGain / cost * 100.0
+	}
+
+	return v
+}
+
+// positions returns the portfolio positions of a symbol
+func (s *StockBoard) positions(symbol string) []*Position {
+	var positions []*Position
+	for _, p := range s.config.Portfolio {
+		if strings.EqualFold(p.Symbol, symbol) {
+			positions = append(positions, p)
+		}
+	}
+
+	return positions
+}
+
+func (s *StockBoard) renderPosition(ctx context.Context, v *PositionValue, bounds image.Rectangle) (draw.Image, error) {
+	img := image.NewRGBA(bounds)
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+
+	writer, err := s.getPriceWriter(canvasBounds)
+	if err != nil {
+		return nil, err
+	}
+
+	gainColor := func(gain float64) color.Color {
+		if gain < 0 {
+			return red
+		}
+		return green
+	}
+
+	lines := []struct {
+		text string
+		clr  color.Color
+	}{
+		{
+			text: fmt.Sprintf("%s %s", s.specialName(v.Name), compactMoney(v.Value, false)),
+			clr:  color.White,
+		},
+		{
+			text: fmt.Sprintf("Day %s %+.1f%%", compactMoney(v.DayChange, true), v.DayChangePct),
+			clr:  gainColor(v.DayChange),
+		},
+		{
+			text: fmt.Sprintf("All %s %+.1f%%", compactMoney(v.TotalGain, true), v.TotalGainPct),
+			clr:  gainColor(v.TotalGain),
+		},
+	}
+
+	lineHeight := canvasBounds.Dy() / len(lines)
+	for i, line := range lines {
+		lineBounds := image.Rect(
+			canvasBounds.Min.X,
+			canvasBounds.Min.Y+(i*lineHeight),
+			canvasBounds.Max.X,
+			canvasBounds.Min.Y+((i+1)*lineHeight),
+		)
+		if err := writer.WriteAligned(rgbrender.CenterCenter, img, lineBounds, []string{line.text}, line.clr); err != nil {
+			return nil, err
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, context.Canceled
+	default:
+	}
+
+	return img, nil
+}
+
+// compactMoney formats an amount to fit a small display, ie. 1234567 as "1.23M"
+func compactMoney(amount float64, signed bool) string {
+	format := "%.2f"
+	abs := math.Abs(amount)
+	switch {
+	case abs >= 1000000:
+		format = "%.2fM"
+		amount /= 1000000
+	case abs >= 10000:
+		format = "%.1fK"
+		amount /= 1000
+	case abs >= 1000:
+		format = "%.0f"
+	}
+
+	if signed {
+		format = "%+" + format[1:]
+	}
+
+	return fmt.Sprintf(format, amount)
+}
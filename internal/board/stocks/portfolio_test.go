@@ -0,0 +1,134 @@
+package stockboard
+
+import (
+	"context"
+	"image"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPortfolioValue(t *testing.T) {
+	t.Parallel()
+
+	stocks := []*Stock{
+		{
+			Symbol:    "AAPL",
+			OpenPrice: 100.0,
+			Price:     110.0,
+		},
+		{
+			Symbol:    "MSFT",
+			OpenPrice: 400.0,
+			Price:     380.0,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		positions []*Position
+		expected  *PositionValue
+	}{
+		{
+			name: "one position",
+			positions: []*Position{
+				{Symbol: "aapl", Shares: 10, CostBasis: 55.0},
+			},
+			expected: &PositionValue{
+				Value:        1100.0,
+				DayChange:    100.0,
+				DayChangePct: 10.0,
+				TotalGain:    550.0,
+				TotalGainPct: 100.0,
+			},
+		},
+		{
+			name: "lots and stocks",
+			positions: []*Position{
+				{Symbol: "AAPL", Shares: 5, CostBasis: 120.0},
+				{Symbol: "AAPL", Shares: 5, CostBasis: 80.0},
+				{Symbol: "MSFT", Shares: 1, CostBasis: 300.0},
+			},
+			expected: &PositionValue{
+				Value:        1480.0,
+				DayChange:    80.0,
+				DayChangePct: 80.0 / 1400.0 * 100.0,
+				TotalGain:    180.0,
+				TotalGainPct: 180.0 / 1300.0 * 100.0,
+			},
+		},
+		{
+			name: "no quote",
+			positions: []*Position{
+				{Symbol: "GME", Shares: 10, CostBasis: 20.0},
+			},
+			expected: &PositionValue{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			v := portfolioValue("", test.positions, stocks)
+			require.InDelta(t, test.expected.Value, v.Value, 0.001)
+			require.InDelta(t, test.expected.DayChange, v.DayChange, 0.001)
+			require.InDelta(t, test.expected.DayChangePct, v.DayChangePct, 0.001)
+			require.InDelta(t, test.expected.TotalGain, v.TotalGain, 0.001)
+			require.InDelta(t, test.expected.TotalGainPct, v.TotalGainPct, 0.001)
+		})
+	}
+}
+
+func TestCompactMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount   float64
+		signed   bool
+		expected string
+	}{
+		{amount: 12.345, expected: "12.35"},
+		{amount: 12.345, signed: true, expected: "+12.35"},
+		{amount: -1234.5, signed: true, expected: "-1234"},
+		{amount: 12345.0, expected: "12.3K"},
+		{amount: -2500000.0, signed: true, expected: "-2.50M"},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, compactMoney(test.amount, test.signed))
+	}
+}
+
+func TestFixtureAPI(t *testing.T) {
+	t.Parallel()
+
+	api, err := NewFixtureAPI("")
+	require.NoError(t, err)
+
+	cfg := &Config{
+		Symbols: []string{"AAPL", "NOPE"},
+		Portfolio: []*Position{
+			{Symbol: "aapl", Shares: 1},
+			{Symbol: "MSFT", Shares: 2},
+		},
+	}
+	cfg.SetDefaults()
+	require.Equal(t, []string{"AAPL", "NOPE", "MSFT"}, cfg.AllSymbols())
+
+	stocks, err := api.Get(context.Background(), cfg.AllSymbols(), time.Minute)
+	require.NoError(t, err)
+	require.Len(t, stocks, 2)
+	require.Equal(t, "AAPL", stocks[0].Symbol)
+	require.Equal(t, "MSFT", stocks[1].Symbol)
+	require.NotEmpty(t, stocks[0].Prices)
+
+	b, err := New(api, cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	img, err := b.renderPosition(context.Background(), portfolioValue("Portfolio", cfg.Portfolio, stocks), image.Rect(0, 0, 64, 32))
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 64, 32), img.Bounds())
+}
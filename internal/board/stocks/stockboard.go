@@ -7,6 +7,7 @@ import (
 	"image/color"
 	"image/draw"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,7 +23,6 @@ import (
 	"github.com/robbydyer/sports/internal/rgbrender"
 	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 	"github.com/robbydyer/sports/internal/twirphelpers"
-	"github.com/robbydyer/sports/internal/util"
 )
 
 var (
@@ -68,6 +68,15 @@ type Config struct {
 	MaxChartWidthRatio float64      `json:"maxChartWidthRatio"`
 	SymbolFont         *FontConfig  `json:"symbolFont"`
 	PriceFont          *FontConfig  `json:"priceFont"`
+	// Provider is the source of quotes, ie. "yahoo", "finnhub" or "fixture"
+	Provider string `json:"provider"`
+	// APIKey is used by providers that require one, ie. "finnhub"
+	APIKey string `json:"apiKey"`
+	// FixtureFile is a fixture recorded with `stocktest --record` for the "fixture" provider.
+	// Defaults to the bundled fixture.
+	FixtureFile string `json:"fixtureFile"`
+	// Portfolio positions are shown after their stock, followed by a summary of the portfolio
+	Portfolio []*Position `json:"portfolio"`
 }
 
 type FontConfig struct {
@@ -76,21 +85,23 @@ type FontConfig struct {
 
 // Price represents a price of a stock at a particular time
 type Price struct {
-	Time  time.Time
-	Price float64
+	Time  time.Time `json:"time"`
+	Price float64   `json:"price"`
 }
 
 // Stock ...
 type Stock struct {
-	Symbol    string
-	OpenPrice float64
-	Price     float64
-	Prices    []*Price
-	Change    float64
+	Symbol    string   `json:"symbol"`
+	OpenPrice float64  `json:"openPrice"`
+	Price     float64  `json:"price"`
+	Prices    []*Price `json:"prices"`
+	Change    float64  `json:"change"`
 }
 
 // API interface for getting stock data
 type API interface {
+	// Provider is the name of the quote provider, ie. "yahoo"
+	Provider() string
 	Get(ctx context.Context, symbols []string, interval time.Duration) ([]*Stock, error)
 	TradingOpen() (time.Time, error)
 	TradingClose() (time.Time, error)
@@ -147,6 +158,29 @@ func (c *Config) SetDefaults() {
 	if c.MaxChartWidthRatio == 0 || c.MaxChartWidthRatio > 1 {
 		c.MaxChartWidthRatio = 1
 	}
+
+	if c.Provider == "" {
+		c.Provider = "yahoo"
+	}
+}
+
+// AllSymbols returns the configured symbols, followed by those of portfolio positions
+func (c *Config) AllSymbols() []string {
+	symbols := make([]string, 0, len(c.Symbols)+len(c.Portfolio))
+	seen := make(map[string]struct{})
+	for _, sym := range c.Symbols {
+		seen[strings.ToUpper(sym)] = struct{}{}
+		symbols = append(symbols, sym)
+	}
+	for _, p := range c.Portfolio {
+		if _, ok := seen[strings.ToUpper(p.Symbol)]; ok {
+			continue
+		}
+		seen[strings.ToUpper(p.Symbol)] = struct{}{}
+		symbols = append(symbols, p.Symbol)
+	}
+
+	return symbols
 }
 
 // New ...
@@ -174,19 +208,6 @@ func New(api API, config *Config, log *zap.Logger) (*StockBoard, error) {
 		),
 	)
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		s.log.Info("stockboard turning on")
-		s.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		s.log.Info("stockboard turning off")
-		s.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-
 	return s, nil
 }
 
@@ -208,6 +229,11 @@ func (s *StockBoard) Name() string {
 	return "Stocks"
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *StockBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
 func (s *StockBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
 	s.enablerLock.Lock()
 	defer s.enablerLock.Unlock()
@@ -265,12 +291,14 @@ func (s *StockBoard) render(ctx context.Context, canvas board.Canvas) (board.Can
 
 	go s.enablerCancel(boardCtx, boardCancel)
 
+	symbols := s.config.AllSymbols()
+
 	s.log.Debug("fetching stock info",
-		zap.Strings("stocks", s.config.Symbols),
+		zap.Strings("stocks", symbols),
 		zap.String("update interval str", s.config.updateInterval.String()),
 		zap.Duration("update interval", s.config.updateInterval),
 	)
-	stocks, err := s.api.Get(boardCtx, s.config.Symbols, s.config.updateInterval)
+	stocks, err := s.api.Get(boardCtx, symbols, s.config.updateInterval)
 	if err != nil {
 		return nil, err
 	}
@@ -296,6 +324,33 @@ func (s *StockBoard) render(ctx context.Context, canvas board.Canvas) (board.Can
 		go scrollCanvas.MatchScroll(ctx, base)
 	}
 
+	// show draws a card of the board, or adds it to the scroll
+	show := func(img draw.Image) error {
+		if scrollCanvas != nil && s.config.ScrollMode.Load() {
+			scrollCanvas.AddCanvas(img)
+			return nil
+		}
+
+		draw.Draw(canvas, canvas.Bounds(), img, image.Point{}, draw.Over)
+
+		if err := canvas.Render(boardCtx); err != nil {
+			s.log.Error("failed to render stock board",
+				zap.Error(err),
+			)
+			return nil
+		}
+
+		if !s.config.ScrollMode.Load() {
+			select {
+			case <-boardCtx.Done():
+				return context.Canceled
+			case <-time.After(s.config.boardDelay):
+			}
+		}
+
+		return nil
+	}
+
 STOCK:
 	for _, stock := range stocks {
 		img, err := s.renderStock(boardCtx, stock, canvas.Bounds())
@@ -306,26 +361,37 @@ STOCK:
 			continue STOCK
 		}
 
-		if scrollCanvas != nil && s.config.ScrollMode.Load() {
-			scrollCanvas.AddCanvas(img)
-			continue STOCK
+		if err := show(img); err != nil {
+			return nil, err
 		}
 
-		draw.Draw(canvas, canvas.Bounds(), img, image.Point{}, draw.Over)
+		positions := s.positions(stock.Symbol)
+		if len(positions) < 1 {
+			continue STOCK
+		}
 
-		if err := canvas.Render(boardCtx); err != nil {
-			s.log.Error("failed to render stock board",
+		img, err = s.renderPosition(boardCtx, portfolioValue(stock.Symbol, positions, []*Stock{stock}), canvas.Bounds())
+		if err != nil {
+			s.log.Error("failed to render stock position",
 				zap.Error(err),
+				zap.String("symbol", stock.Symbol),
 			)
 			continue STOCK
 		}
 
-		if !s.config.ScrollMode.Load() {
-			select {
-			case <-boardCtx.Done():
-				return nil, context.Canceled
-			case <-time.After(s.config.boardDelay):
-			}
+		if err := show(img); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(s.config.Portfolio) > 0 {
+		img, err := s.renderPosition(boardCtx, portfolioValue("Portfolio", s.config.Portfolio, stocks), canvas.Bounds())
+		if err != nil {
+			s.log.Error("failed to render portfolio summary",
+				zap.Error(err),
+			)
+		} else if err := show(img); err != nil {
+			return nil, err
 		}
 	}
 
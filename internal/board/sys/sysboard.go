@@ -24,7 +24,6 @@ import (
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
 	"github.com/robbydyer/sports/internal/rgbrender"
 	"github.com/robbydyer/sports/internal/twirphelpers"
-	"github.com/robbydyer/sports/internal/util"
 )
 
 const cpuTempFile = "/sys/class/thermal/thermal_zone0/temp"
@@ -88,19 +87,6 @@ func New(logger *zap.Logger, config *Config) (*SysBoard, error) {
 		),
 	)
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		s.log.Info("sysboard turning on")
-		s.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		s.log.Info("sysboard turning off")
-		s.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-
 	return s, nil
 }
 
@@ -136,6 +122,11 @@ func (s *SysBoard) Name() string {
 	return "Sys"
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *SysBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
 // ScrollRender ...
 func (s *SysBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
 	return nil, nil
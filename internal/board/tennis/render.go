@@ -0,0 +1,378 @@
+package tennisboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+const (
+	headerLayerPriority = rgbrender.BackgroundPriority
+	playerLayerPriority = rgbrender.BackgroundPriority + 1
+)
+
+var (
+	followedColor = color.RGBA{R: 255, G: 215, B: 0, A: 255}
+	dimColor      = color.RGBA{R: 150, G: 150, B: 150, A: 255}
+	liveColor     = color.RGBA{R: 255, G: 0, B: 0, A: 255}
+	currentColor  = color.RGBA{R: 255, G: 255, B: 0, A: 255}
+	serveColor    = color.RGBA{R: 204, G: 255, B: 0, A: 255}
+)
+
+func (s *TennisBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.cancelBoard:
+			cancel()
+			return
+		case <-ticker.C:
+			if !s.Enabler().Enabled() {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// Render ...
+func (s *TennisBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	c, err := s.render(ctx, canvas)
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		defer func() {
+			if scr, ok := c.(*scrcnvs.ScrollCanvas); ok {
+				s.config.scrollDelay = scr.GetScrollSpeed()
+			}
+		}()
+		return c.Render(ctx)
+	}
+
+	return nil
+}
+
+// ScrollRender ...
+func (s *TennisBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	origScrollMode := s.config.ScrollMode.Load()
+	origPad := s.config.TightScrollPadding
+	defer func() {
+		s.config.ScrollMode.Store(origScrollMode)
+		s.config.TightScrollPadding = origPad
+	}()
+
+	s.config.ScrollMode.Store(true)
+	s.config.TightScrollPadding = padding
+
+	return s.render(ctx, canvas)
+}
+
+func (s *TennisBoard) render(ctx context.Context, canvas board.Canvas) (board.Canvas, error) {
+	boardCtx, boardCancel := context.WithCancel(ctx)
+	defer boardCancel()
+
+	go s.enablerCancel(boardCtx, boardCancel)
+
+	matches, err := s.getMatches(boardCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	matches = s.filterMatches(matches)
+	if len(matches) < 1 {
+		s.log.Debug("no live or followed tennis matches",
+			zap.String("league", s.api.League()),
+		)
+		return nil, nil
+	}
+
+	var scrollCanvas *scrcnvs.ScrollCanvas
+	if canvas.Scrollable() && s.config.ScrollMode.Load() {
+		base, ok := canvas.(*scrcnvs.ScrollCanvas)
+		if !ok {
+			return nil, fmt.Errorf("unexpected canvas type for tennis board")
+		}
+
+		scrollCanvas, err = scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+			scrcnvs.WithMergePadding(s.config.TightScrollPadding),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tight scroll canvas: %w", err)
+		}
+		scrollCanvas.SetScrollDirection(scrcnvs.RightToLeft)
+		scrollCanvas.SetScrollSpeed(s.config.scrollDelay)
+		base.SetScrollSpeed(s.config.scrollDelay)
+
+		go scrollCanvas.MatchScroll(ctx, base)
+	}
+
+MATCH:
+	for _, match := range matches {
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+
+		if err := s.drawMatch(boardCtx, canvas, match); err != nil {
+			s.log.Error("failed to render tennis match",
+				zap.String("match", match.ID),
+				zap.Error(err),
+			)
+			continue MATCH
+		}
+
+		if scrollCanvas != nil {
+			scrollCanvas.AddCanvas(canvas)
+			continue MATCH
+		}
+
+		if err := canvas.Render(boardCtx); err != nil {
+			s.log.Error("failed to render tennis board",
+				zap.Error(err),
+			)
+			continue MATCH
+		}
+
+		select {
+		case <-boardCtx.Done():
+			return nil, context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	if scrollCanvas != nil {
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+		return scrollCanvas, nil
+	}
+
+	return nil, nil
+}
+
+// drawMatch draws the round and match status on the top row, then a row for each player
+// with a serve indicator, their name and their games in each set, right aligned.
+func (s *TennisBoard) drawMatch(ctx context.Context, canvas board.Canvas, match *Match) error {
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+
+	writer, err := s.writers.Get(zeroed)
+	if err != nil {
+		return err
+	}
+
+	layers, err := rgbrender.NewLayerDrawer(60*time.Second, s.log)
+	if err != nil {
+		return err
+	}
+
+	rowHeight := zeroed.Dy() / 3
+	header := image.Rect(zeroed.Min.X, zeroed.Min.Y, zeroed.Max.X, zeroed.Min.Y+rowHeight)
+
+	layers.AddTextLayer(headerLayerPriority,
+		rgbrender.NewTextLayer(
+			func(ctx context.Context) (*rgbrender.TextWriter, []string, error) {
+				return writer, []string{match.Round}, nil
+			},
+			func(canvas board.Canvas, writer *rgbrender.TextWriter, text []string) error {
+				return writer.WriteAligned(rgbrender.LeftCenter, canvas, header, text, dimColor)
+			},
+		),
+	)
+
+	status, statusClr := matchStatus(match)
+	layers.AddTextLayer(headerLayerPriority,
+		rgbrender.NewTextLayer(
+			func(ctx context.Context) (*rgbrender.TextWriter, []string, error) {
+				return writer, []string{status}, nil
+			},
+			func(canvas board.Canvas, writer *rgbrender.TextWriter, text []string) error {
+				return writer.WriteAligned(rgbrender.RightCenter, canvas, header, text, statusClr)
+			},
+		),
+	)
+
+	columns, err := setColumns(canvas, writer, match)
+	if err != nil {
+		return err
+	}
+	scoreWidth := 0
+	for _, c := range columns {
+		scoreWidth += c
+	}
+
+	serveWidth := rowHeight / 2
+	for i, player := range match.Players {
+		if player == nil {
+			continue
+		}
+		player := player
+		opponent := match.Players[(i+1)%2]
+		y := header.Max.Y + (i * rowHeight)
+		row := image.Rect(zeroed.Min.X, y, zeroed.Max.X, y+rowHeight)
+
+		if match.State == Live && player.Serving {
+			layers.AddLayer(playerLayerPriority,
+				rgbrender.NewLayer(
+					nil,
+					func(canvas board.Canvas, img image.Image) error {
+						size := rowHeight / 3
+						if size < 1 {
+							size = 1
+						}
+						start := image.Pt(row.Min.X+((serveWidth-size)/2), row.Min.Y+((rowHeight-size)/2))
+						return rgbrender.DrawRectangle(canvas, start.X, start.Y, size, size, serveColor)
+					},
+				),
+			)
+		}
+
+		nameClr := color.Color(color.White)
+		if s.isFollowed(player) {
+			nameClr = followedColor
+		}
+		nameBounds := image.Rect(row.Min.X+serveWidth, row.Min.Y, row.Max.X-scoreWidth, row.Max.Y)
+		layers.AddTextLayer(playerLayerPriority,
+			rgbrender.NewTextLayer(
+				func(ctx context.Context) (*rgbrender.TextWriter, []string, error) {
+					return writer, []string{playerName(player)}, nil
+				},
+				func(canvas board.Canvas, writer *rgbrender.TextWriter, text []string) error {
+					return writer.WriteAligned(rgbrender.LeftCenter, canvas, nameBounds, text, nameClr)
+				},
+			),
+		)
+
+		layers.AddTextLayer(playerLayerPriority,
+			rgbrender.NewTextLayer(
+				func(ctx context.Context) (*rgbrender.TextWriter, []string, error) {
+					return writer, nil, nil
+				},
+				func(canvas board.Canvas, writer *rgbrender.TextWriter, text []string) error {
+					return drawSets(canvas, writer, row, columns, match, player, opponent)
+				},
+			),
+		)
+	}
+
+	return layers.Draw(ctx, canvas)
+}
+
+// drawSets writes the player's games in each set into the columns at the right of the row.
+// Won sets are white, lost sets are dimmed and the set in progress is highlighted. Tiebreak
+// points follow the games of the tiebreak loser, or of both players during a tiebreak.
+func drawSets(canvas board.Canvas, writer *rgbrender.TextWriter, row image.Rectangle, columns []int, match *Match, player *Player, opponent *Player) error {
+	x := row.Max.X
+	for i := len(columns) - 1; i >= 0; i-- {
+		x -= columns[i]
+		if i >= len(player.Sets) {
+			continue
+		}
+		set := player.Sets[i]
+		var other *Set
+		if opponent != nil && i < len(opponent.Sets) {
+			other = opponent.Sets[i]
+		}
+		current := match.State == Live && i == len(player.Sets)-1
+
+		clr := color.Color(color.White)
+		switch {
+		case current:
+			clr = currentColor
+		case other != nil && other.Games > set.Games:
+			clr = dimColor
+		}
+
+		bounds := image.Rect(x, row.Min.Y, x+columns[i], row.Max.Y)
+		if err := writer.WriteAligned(rgbrender.LeftCenter, canvas, bounds, []string{fmt.Sprint(set.Games)}, clr); err != nil {
+			return err
+		}
+
+		if !showTiebreak(set, other, current) {
+			continue
+		}
+		widths, err := writer.MeasureStrings(canvas, []string{fmt.Sprint(set.Games)})
+		if err != nil {
+			return err
+		}
+		tbBounds := image.Rect(x+widths[0], row.Min.Y, x+columns[i], row.Max.Y)
+		if err := writer.WriteAligned(rgbrender.LeftCenter, canvas, tbBounds, []string{fmt.Sprint(*set.Tiebreak)}, dimColor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setColumns returns the pixel width of each set's column, which fits the games and any tiebreak points
+func setColumns(canvas board.Canvas, writer *rgbrender.TextWriter, match *Match) ([]int, error) {
+	var columns []int
+	for i := 0; ; i++ {
+		text := ""
+		hasSet := false
+		for _, p := range match.Players {
+			if p == nil || i >= len(p.Sets) {
+				continue
+			}
+			hasSet = true
+			t := fmt.Sprint(p.Sets[i].Games)
+			if p.Sets[i].Tiebreak != nil {
+				t += fmt.Sprint(*p.Sets[i].Tiebreak)
+			}
+			if len(t) > len(text) {
+				text = t
+			}
+		}
+		if !hasSet {
+			break
+		}
+
+		widths, err := writer.MeasureStrings(canvas, []string{text})
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, widths[0]+2)
+	}
+
+	return columns, nil
+}
+
+func showTiebreak(set *Set, other *Set, current bool) bool {
+	if set.Tiebreak == nil {
+		return false
+	}
+	if current || other == nil {
+		return true
+	}
+	return other.Games > set.Games
+}
+
+func matchStatus(match *Match) (string, color.Color) {
+	switch match.State {
+	case Live:
+		if match.Detail != "" {
+			return match.Detail, liveColor
+		}
+		return "LIVE", liveColor
+	case Final:
+		return "Final", color.White
+	}
+
+	if match.Start.IsZero() {
+		return match.Detail, color.White
+	}
+	return match.Start.Local().Format("3:04PM"), color.White
+}
+
+func playerName(p *Player) string {
+	if p.ShortName != "" {
+		return p.ShortName
+	}
+	return p.Name
+}
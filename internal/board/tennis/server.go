@@ -0,0 +1,10 @@
+package tennisboard
+
+import (
+	"net/http"
+)
+
+// GetRPCHandler ...
+func (s *TennisBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
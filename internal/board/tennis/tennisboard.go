@@ -0,0 +1,315 @@
+package tennisboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+)
+
+var (
+	defaultUpdateInterval     = 10 * time.Minute
+	defaultLiveUpdateInterval = 30 * time.Second
+	defaultBoardDelay         = 10 * time.Second
+	defaultScrollDelay        = 15 * time.Millisecond
+)
+
+// MatchState is the state of a tennis match
+type MatchState int
+
+const (
+	// Scheduled matches haven't started
+	Scheduled MatchState = iota
+	// Live matches are in progress
+	Live
+	// Final matches are completed
+	Final
+)
+
+// TennisBoard displays the live and upcoming matches of a tennis tour
+type TennisBoard struct {
+	config      *Config
+	log         *zap.Logger
+	api         API
+	writers     *rgbrender.TextWriters
+	matches     []*Match
+	lastUpdate  time.Time
+	cancelBoard chan struct{}
+	rpcServer   pb.TwirpServer
+	enabler     board.Enabler
+	sync.Mutex
+}
+
+// Config ...
+type Config struct {
+	boardDelay         time.Duration
+	updateInterval     time.Duration
+	liveUpdateInterval time.Duration
+	scrollDelay        time.Duration
+	StartEnabled       *atomic.Bool `json:"enabled"`
+	BoardDelay         string       `json:"boardDelay"`
+	UpdateInterval     string       `json:"updateInterval"`
+	LiveUpdateInterval string       `json:"liveUpdateInterval"`
+	FollowedPlayers    []string     `json:"followedPlayers"`
+	FollowedOnly       *atomic.Bool `json:"followedOnly"`
+	OnTimes            []string     `json:"onTimes"`
+	OffTimes           []string     `json:"offTimes"`
+	ScrollMode         *atomic.Bool `json:"scrollMode"`
+	TightScrollPadding int          `json:"tightScrollPadding"`
+	ScrollDelay        string       `json:"scrollDelay"`
+}
+
+// API ...
+type API interface {
+	// League is the name of the tour, ie. "ATP"
+	League() string
+	HTTPPathPrefix() string
+	// GetMatches returns the singles matches of the tour's current tournaments
+	GetMatches(ctx context.Context) ([]*Match, error)
+}
+
+// Match is a singles match between two players
+type Match struct {
+	ID         string
+	Tournament string
+	// Round is the short name of the round, ie. "QF"
+	Round string
+	State MatchState
+	// Detail describes the state of the match, ie. "3rd Set"
+	Detail  string
+	Start   time.Time
+	Players [2]*Player
+}
+
+// Player is a player and their score in a Match
+type Player struct {
+	Name      string
+	ShortName string
+	Sets      []*Set
+	Serving   bool
+}
+
+// Set is the games a player won in a set
+type Set struct {
+	Games int
+	// Tiebreak is the player's points in the set's tiebreak, or nil when the set had no tiebreak
+	Tiebreak *int
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.ScrollMode == nil {
+		c.ScrollMode = atomic.NewBool(false)
+	}
+	if c.FollowedOnly == nil {
+		c.FollowedOnly = atomic.NewBool(false)
+	}
+
+	c.boardDelay = defaultBoardDelay
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err == nil {
+			c.boardDelay = d
+		}
+	}
+
+	c.updateInterval = defaultUpdateInterval
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err == nil {
+			c.updateInterval = d
+		}
+	}
+
+	c.liveUpdateInterval = defaultLiveUpdateInterval
+	if c.LiveUpdateInterval != "" {
+		d, err := time.ParseDuration(c.LiveUpdateInterval)
+		if err == nil {
+			c.liveUpdateInterval = d
+		}
+	}
+
+	c.scrollDelay = defaultScrollDelay
+	if c.ScrollDelay != "" {
+		d, err := time.ParseDuration(c.ScrollDelay)
+		if err == nil {
+			c.scrollDelay = d
+		}
+	}
+}
+
+// New ...
+func New(api API, config *Config, logger *zap.Logger) (*TennisBoard, error) {
+	s := &TennisBoard{
+		config:      config,
+		log:         logger,
+		api:         api,
+		cancelBoard: make(chan struct{}),
+		enabler:     enabler.New(),
+	}
+
+	s.writers = rgbrender.NewTextWriters(s.setupWriter)
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	prfx = fmt.Sprintf("/tennis%s", prfx)
+
+	s.rpcServer = twirphelpers.NewBasicBoardServer(s, s.config.ScrollMode, s.cancelBoard, prfx, s.log)
+	s.log.Info("registering RPC server for tennis board",
+		zap.String("league", s.api.League()),
+		zap.String("prefix", s.rpcServer.PathPrefix()),
+	)
+
+	return s, nil
+}
+
+// Enabler ...
+func (s *TennisBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *TennisBoard) InBetween() bool {
+	return false
+}
+
+// Name ...
+func (s *TennisBoard) Name() string {
+	return s.api.League()
+}
+
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *TennisBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
+// Clear ...
+func (s *TennisBoard) Clear() error {
+	return nil
+}
+
+// Close ...
+func (s *TennisBoard) Close() error {
+	return nil
+}
+
+// ScrollMode ...
+func (s *TennisBoard) ScrollMode() bool {
+	return s.config.ScrollMode.Load()
+}
+
+// GetHTTPHandlers ...
+func (s *TennisBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return []*board.HTTPHandler{}, nil
+}
+
+func (s *TennisBoard) isFollowed(p *Player) bool {
+	if p == nil {
+		return false
+	}
+	for _, f := range s.config.FollowedPlayers {
+		f = strings.ToLower(f)
+		if strings.Contains(strings.ToLower(p.Name), f) || strings.EqualFold(p.ShortName, f) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *TennisBoard) hasFollowed(m *Match) bool {
+	return s.isFollowed(m.Players[0]) || s.isFollowed(m.Players[1])
+}
+
+// getMatches returns the cached matches, updating them once the update interval has passed.
+// Live matches are updated on the shorter live update interval.
+func (s *TennisBoard) getMatches(ctx context.Context) ([]*Match, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	interval := s.config.updateInterval
+	if anyLive(s.matches) {
+		interval = s.config.liveUpdateInterval
+	}
+	if len(s.matches) > 0 && time.Since(s.lastUpdate) < interval {
+		return s.matches, nil
+	}
+
+	s.log.Info("updating tennis matches",
+		zap.String("league", s.api.League()),
+	)
+	matches, err := s.api.GetMatches(ctx)
+	if err != nil {
+		if len(s.matches) > 0 {
+			s.log.Error("failed to update tennis matches, using previous matches",
+				zap.String("league", s.api.League()),
+				zap.Error(err),
+			)
+			return s.matches, nil
+		}
+		return nil, err
+	}
+
+	s.matches = matches
+	s.lastUpdate = time.Now()
+
+	return s.matches, nil
+}
+
+// filterMatches returns the live matches, then the upcoming matches of followed players.
+// Matches with a followed player are first within each group. When only followed players
+// are shown, live matches without one are dropped.
+func (s *TennisBoard) filterMatches(matches []*Match) []*Match {
+	var live []*Match
+	var upcoming []*Match
+	for _, m := range matches {
+		followed := s.hasFollowed(m)
+		switch m.State {
+		case Live:
+			if followed || !s.config.FollowedOnly.Load() {
+				live = append(live, m)
+			}
+		case Scheduled:
+			if followed {
+				upcoming = append(upcoming, m)
+			}
+		}
+	}
+
+	sort.SliceStable(live, func(i, j int) bool {
+		return s.hasFollowed(live[i]) && !s.hasFollowed(live[j])
+	})
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].Start.Before(upcoming[j].Start)
+	})
+
+	return append(live, upcoming...)
+}
+
+func anyLive(matches []*Match) bool {
+	for _, m := range matches {
+		if m.State == Live {
+			return true
+		}
+	}
+	return false
+}
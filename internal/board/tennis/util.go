@@ -0,0 +1,24 @@
+package tennisboard
+
+import (
+	"fmt"
+	"image"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// setupWriter scales the font of large canvases
+func (s *TennisBoard) setupWriter(writer *rgbrender.TextWriter, bounds image.Rectangle) {
+	// The match header and each player are a row
+	if bounds.Dy() > 128 {
+		writer.FontSize = 0.25 * float64(bounds.Dy())
+		writer.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
+	}
+
+	s.log.Debug("tennis board writer font",
+		zap.Float64("size", writer.FontSize),
+		zap.String("canvas", fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())),
+	)
+}
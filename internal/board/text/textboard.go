@@ -22,7 +22,6 @@ import (
 	"github.com/robbydyer/sports/internal/rgbrender"
 	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 	"github.com/robbydyer/sports/internal/twirphelpers"
-	"github.com/robbydyer/sports/internal/util"
 )
 
 var defaultScrollDelay = 15 * time.Millisecond
@@ -125,19 +124,6 @@ func New(api API, config *Config, log *zap.Logger, opts ...OptionFunc) (*TextBoa
 		s.enabler.Enable()
 	}
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		s.log.Info("textboard turning on")
-		s.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		s.log.Info("textboard turning off")
-		s.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-
 	for _, o := range opts {
 		if err := o(s); err != nil {
 			return nil, err
@@ -180,6 +166,11 @@ func (s *TextBoard) Name() string {
 	return "Texts"
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (s *TextBoard) EnableTimes() ([]string, []string) {
+	return s.config.OnTimes, s.config.OffTimes
+}
+
 func (s *TextBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
 	s.enablerLock.Lock()
 	defer s.enablerLock.Unlock()
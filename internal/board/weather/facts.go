@@ -0,0 +1,48 @@
+package weatherboard
+
+import (
+	"context"
+	"image"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+// Facts returns today's weather for evaluating rules:
+//   - precipChance: today's chance of precipitation, in percent
+//   - temperature: the current temperature
+//   - highTemp, lowTemp: today's forecasted high and low temperatures
+//   - humidity: the current humidity, in percent
+func (w *WeatherBoard) Facts(ctx context.Context) (board.Facts, error) {
+	facts := board.Facts{}
+
+	current, err := w.api.CurrentForecast(ctx, w.config.ZipCode, w.config.Country, image.Rectangle{}, w.config.MetricUnits.Load())
+	if err != nil {
+		return nil, err
+	}
+	if current.Temperature != nil {
+		facts["temperature"] = *current.Temperature
+	}
+	facts["humidity"] = current.Humidity
+
+	daily, err := w.api.DailyForecasts(ctx, w.config.ZipCode, w.config.Country, image.Rectangle{}, w.config.MetricUnits.Load())
+	if err != nil {
+		return nil, err
+	}
+	if len(daily) > 0 {
+		today := daily[0]
+		if today.PrecipChance != nil {
+			facts["precipChance"] = *today.PrecipChance
+		}
+		if today.HighTemp != nil {
+			facts["highTemp"] = *today.HighTemp
+		}
+		if today.LowTemp != nil {
+			facts["lowTemp"] = *today.LowTemp
+		}
+	}
+	if _, ok := facts["precipChance"]; !ok {
+		facts["precipChance"] = 0
+	}
+
+	return facts, nil
+}
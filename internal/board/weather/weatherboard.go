@@ -22,7 +22,6 @@ import (
 	"github.com/robbydyer/sports/internal/rgbrender"
 	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 	"github.com/robbydyer/sports/internal/twirphelpers"
-	"github.com/robbydyer/sports/internal/util"
 )
 
 // WeatherBoard displays weather
@@ -171,19 +170,6 @@ func New(api API, config *Config, log *zap.Logger) (*WeatherBoard, error) {
 		),
 	)
 
-	if err := util.SetCrons(config.OnTimes, func() {
-		s.log.Info("weatherboard turning on")
-		s.Enabler().Enable()
-	}); err != nil {
-		return nil, err
-	}
-	if err := util.SetCrons(config.OffTimes, func() {
-		s.log.Info("weatherboard turning off")
-		s.Enabler().Disable()
-	}); err != nil {
-		return nil, err
-	}
-
 	return s, nil
 }
 
@@ -205,6 +191,11 @@ func (w *WeatherBoard) Name() string {
 	return "Weather"
 }
 
+// EnableTimes returns the cron specs for when the board is turned on and off
+func (w *WeatherBoard) EnableTimes() ([]string, []string) {
+	return w.config.OnTimes, w.config.OffTimes
+}
+
 func (w *WeatherBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
 	w.enablerLock.Lock()
 	defer w.enablerLock.Unlock()
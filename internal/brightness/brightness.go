@@ -0,0 +1,352 @@
+package brightness
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTransition = 30 * time.Minute
+	autoInterval      = 30 * time.Second
+	fadeStep          = 50 * time.Millisecond
+)
+
+// Config defines brightness schedules and automatic dimming
+type Config struct {
+	// Schedules set the brightness at cron times, ie. "00 22 * * *"
+	Schedules []*Schedule `json:"schedules"`
+	// Auto dims the matrix between sunset and sunrise
+	Auto *AutoConfig `json:"auto"`
+}
+
+// Schedule sets a brightness at each of its cron Times
+type Schedule struct {
+	Times      []string `json:"times"`
+	Brightness int      `json:"brightness"`
+}
+
+// AutoConfig dims the matrix between sunset and sunrise at a location
+type AutoConfig struct {
+	Enabled   bool    `json:"enabled"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// DayBrightness defaults to the hardware brightness
+	DayBrightness int `json:"dayBrightness"`
+	// NightBrightness defaults to 20
+	NightBrightness int `json:"nightBrightness"`
+	// Transition is how long it takes to fade between day and night brightness. Night brightness
+	// is reached Transition after sunset, and day brightness is reached at sunrise. Default is 30m.
+	Transition string `json:"transition"`
+	transition time.Duration
+}
+
+// Setter sets a brightness percentage. matrix.Matrix implements this.
+type Setter interface {
+	SetBrightness(brightness int)
+}
+
+// Setters sets the brightness of each of its Setters, ie. the main matrix and its outputs
+type Setters []Setter
+
+// SetBrightness implements Setter
+func (s Setters) SetBrightness(brightness int) {
+	for _, setter := range s {
+		setter.SetBrightness(brightness)
+	}
+}
+
+// Controller manages the brightness of a matrix
+type Controller struct {
+	config  *Config
+	setter  Setter
+	log     *zap.Logger
+	initial int
+	current *atomic.Int64
+	auto    *atomic.Bool
+	fade    context.CancelFunc
+	sync.Mutex
+}
+
+// SetDefaults sets config defaults. initial is the brightness the matrix starts with.
+func (c *Config) SetDefaults(initial int) {
+	if c.Auto == nil {
+		c.Auto = &AutoConfig{}
+	}
+	if c.Auto.DayBrightness == 0 {
+		c.Auto.DayBrightness = initial
+	}
+	if c.Auto.NightBrightness == 0 {
+		c.Auto.NightBrightness = 20
+	}
+	d, err := time.ParseDuration(c.Auto.Transition)
+	if err != nil || d < 0 {
+		d = defaultTransition
+	}
+	c.Auto.transition = d
+}
+
+// Validate validates the config
+func (c *Config) Validate() error {
+	for _, s := range c.Schedules {
+		if err := validBrightness(s.Brightness); err != nil {
+			return fmt.Errorf("invalid scheduled brightness: %w", err)
+		}
+	}
+	if c.Auto != nil && c.Auto.Enabled {
+		if !c.Auto.located() {
+			return fmt.Errorf("automatic brightness requires a latitude and longitude")
+		}
+		if c.Auto.Latitude < -90 || c.Auto.Latitude > 90 {
+			return fmt.Errorf("invalid latitude %f", c.Auto.Latitude)
+		}
+		if c.Auto.Longitude < -180 || c.Auto.Longitude > 180 {
+			return fmt.Errorf("invalid longitude %f", c.Auto.Longitude)
+		}
+		if err := validBrightness(c.Auto.DayBrightness); err != nil {
+			return fmt.Errorf("invalid day brightness: %w", err)
+		}
+		if err := validBrightness(c.Auto.NightBrightness); err != nil {
+			return fmt.Errorf("invalid night brightness: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *AutoConfig) located() bool {
+	return c.Latitude != 0 || c.Longitude != 0
+}
+
+func validBrightness(brightness int) error {
+	if brightness < 1 || brightness > 100 {
+		return fmt.Errorf("brightness %d is not between 1 and 100", brightness)
+	}
+	return nil
+}
+
+// Level returns the automatic brightness at t
+func (c *AutoConfig) Level(t time.Time) int {
+	// A fade that started before midnight may still be in progress
+	if _, sunset, ok, _ := SunTimes(t.AddDate(0, 0, -1), c.Latitude, c.Longitude); ok && t.After(sunset) && t.Before(sunset.Add(c.transition)) {
+		return c.fade(c.DayBrightness, c.NightBrightness, t.Sub(sunset))
+	}
+
+	sunrise, sunset, ok, polarDay := SunTimes(t, c.Latitude, c.Longitude)
+	if !ok {
+		if polarDay {
+			return c.DayBrightness
+		}
+		return c.NightBrightness
+	}
+
+	switch {
+	case t.Before(sunrise.Add(-c.transition)):
+		return c.NightBrightness
+	case t.Before(sunrise):
+		return c.fade(c.NightBrightness, c.DayBrightness, t.Sub(sunrise.Add(-c.transition)))
+	case t.Before(sunset):
+		return c.DayBrightness
+	case t.Before(sunset.Add(c.transition)):
+		return c.fade(c.DayBrightness, c.NightBrightness, t.Sub(sunset))
+	}
+
+	return c.NightBrightness
+}
+
+func (c *AutoConfig) fade(from int, to int, elapsed time.Duration) int {
+	if c.transition <= 0 {
+		return to
+	}
+	progress := float64(elapsed) / float64(c.transition)
+	return from + int(float64(to-from)*progress)
+}
+
+// New returns a Controller. initial is the brightness the matrix starts with.
+func New(setter Setter, config *Config, initial int, logger *zap.Logger) (*Controller, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	config.SetDefaults(initial)
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Controller{
+		config:  config,
+		setter:  setter,
+		log:     logger,
+		initial: initial,
+		current: atomic.NewInt64(int64(initial)),
+		auto:    atomic.NewBool(config.Auto.Enabled),
+	}, nil
+}
+
+// Brightness returns the current brightness
+func (c *Controller) Brightness() int {
+	return int(c.current.Load())
+}
+
+// Auto returns true if automatic dimming is on
+func (c *Controller) Auto() bool {
+	return c.auto.Load()
+}
+
+// SetAuto turns automatic dimming on or off
+func (c *Controller) SetAuto(auto bool) error {
+	if auto && !c.config.Auto.located() {
+		return fmt.Errorf("automatic brightness requires a latitude and longitude")
+	}
+	c.auto.Store(auto)
+	if auto {
+		c.update()
+	}
+	return nil
+}
+
+// SunTimes returns today's sunrise and sunset at the configured location. ok is false if no
+// location is configured or the sun doesn't rise or set today.
+func (c *Controller) SunTimes() (sunrise time.Time, sunset time.Time, ok bool) {
+	c.Lock()
+	auto := c.config.Auto
+	c.Unlock()
+
+	if !auto.located() {
+		return time.Time{}, time.Time{}, false
+	}
+	sunrise, sunset, ok, _ = SunTimes(time.Now(), auto.Latitude, auto.Longitude)
+	return sunrise, sunset, ok
+}
+
+// Reconfigure replaces the config
+func (c *Controller) Reconfigure(config *Config) error {
+	if config == nil {
+		config = &Config{}
+	}
+	config.SetDefaults(c.initial)
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	c.Lock()
+	c.config = config
+	c.Unlock()
+
+	c.auto.Store(config.Auto.Enabled)
+	c.update()
+
+	return nil
+}
+
+// Set sets the brightness immediately
+func (c *Controller) Set(brightness int) error {
+	if err := validBrightness(brightness); err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.stopFade()
+	c.set(brightness)
+
+	return nil
+}
+
+// FadeTo gradually changes the brightness over the given duration
+func (c *Controller) FadeTo(brightness int, duration time.Duration) error {
+	if err := validBrightness(brightness); err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.stopFade()
+
+	from := c.Brightness()
+	steps := int(duration / fadeStep)
+	if steps < 1 || from == brightness {
+		c.set(brightness)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.fade = cancel
+
+	go func() {
+		ticker := time.NewTicker(fadeStep)
+		defer ticker.Stop()
+		for i := 1; i <= steps; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			c.Lock()
+			if ctx.Err() == nil {
+				c.set(from + ((brightness - from) * i / steps))
+			}
+			c.Unlock()
+		}
+	}()
+
+	return nil
+}
+
+// Run updates the brightness of automatic dimming until the context is canceled
+func (c *Controller) Run(ctx context.Context) {
+	c.update()
+
+	ticker := time.NewTicker(autoInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.update()
+		}
+	}
+}
+
+func (c *Controller) update() {
+	if !c.auto.Load() {
+		return
+	}
+
+	c.Lock()
+	auto := c.config.Auto
+	c.Unlock()
+
+	level := auto.Level(time.Now())
+	if level == c.Brightness() {
+		return
+	}
+
+	// Auto levels change a little at a time during a transition, so fade over the
+	// update interval to keep the changes smooth
+	if err := c.FadeTo(level, autoInterval/2); err != nil {
+		c.log.Error("failed to set automatic brightness",
+			zap.Int("brightness", level),
+			zap.Error(err),
+		)
+	}
+}
+
+// set must be called with the lock held
+func (c *Controller) set(brightness int) {
+	c.current.Store(int64(brightness))
+	c.setter.SetBrightness(brightness)
+}
+
+// stopFade must be called with the lock held
+func (c *Controller) stopFade() {
+	if c.fade != nil {
+		c.fade()
+		c.fade = nil
+	}
+}
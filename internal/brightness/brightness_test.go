@@ -0,0 +1,145 @@
+package brightness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSunTimes(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		lat     float64
+		lng     float64
+		sunrise time.Time
+		sunset  time.Time
+	}{
+		{
+			name:    "new york summer",
+			date:    time.Date(2024, 6, 21, 12, 0, 0, 0, ny),
+			lat:     40.7128,
+			lng:     -74.0060,
+			sunrise: time.Date(2024, 6, 21, 5, 25, 0, 0, ny),
+			sunset:  time.Date(2024, 6, 21, 20, 31, 0, 0, ny),
+		},
+		{
+			name:    "new york late evening",
+			date:    time.Date(2024, 12, 21, 23, 30, 0, 0, ny),
+			lat:     40.7128,
+			lng:     -74.0060,
+			sunrise: time.Date(2024, 12, 21, 7, 17, 0, 0, ny),
+			sunset:  time.Date(2024, 12, 21, 16, 32, 0, 0, ny),
+		},
+		{
+			name:    "sydney",
+			date:    time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC),
+			lat:     -33.8688,
+			lng:     151.2093,
+			sunrise: time.Date(2024, 6, 20, 21, 0, 0, 0, time.UTC),
+			sunset:  time.Date(2024, 6, 21, 6, 54, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			sunrise, sunset, ok, _ := SunTimes(test.date, test.lat, test.lng)
+			require.True(t, ok)
+			require.WithinDuration(t, test.sunrise, sunrise, 5*time.Minute)
+			require.WithinDuration(t, test.sunset, sunset, 5*time.Minute)
+		})
+	}
+}
+
+func TestSunTimesPolar(t *testing.T) {
+	t.Parallel()
+
+	_, _, ok, polarDay := SunTimes(time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC), 78.2232, 15.6267)
+	require.False(t, ok)
+	require.True(t, polarDay)
+
+	_, _, ok, polarDay = SunTimes(time.Date(2024, 12, 21, 12, 0, 0, 0, time.UTC), 78.2232, 15.6267)
+	require.False(t, ok)
+	require.False(t, polarDay)
+}
+
+func TestLevel(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	c := &Config{
+		Auto: &AutoConfig{
+			Latitude:        40.7128,
+			Longitude:       -74.0060,
+			DayBrightness:   80,
+			NightBrightness: 20,
+			Transition:      "1h",
+		},
+	}
+	c.SetDefaults(60)
+
+	// Sunrise is about 05:25 and sunset about 20:31
+	tests := []struct {
+		time     time.Time
+		expected int
+	}{
+		{time: time.Date(2024, 6, 21, 3, 0, 0, 0, ny), expected: 20},
+		{time: time.Date(2024, 6, 21, 12, 0, 0, 0, ny), expected: 80},
+		{time: time.Date(2024, 6, 21, 23, 0, 0, 0, ny), expected: 20},
+		{time: time.Date(2024, 6, 21, 21, 1, 0, 0, ny), expected: 50},
+		{time: time.Date(2024, 6, 21, 4, 55, 0, 0, ny), expected: 50},
+	}
+
+	for _, test := range tests {
+		require.InDelta(t, test.expected, c.Auto.Level(test.time), 3, test.time.String())
+	}
+}
+
+func TestLevelPastMidnight(t *testing.T) {
+	t.Parallel()
+
+	// Sunset in Reykjavik in June is just before midnight
+	c := &Config{
+		Auto: &AutoConfig{
+			Latitude:        64.1466,
+			Longitude:       -21.9426,
+			DayBrightness:   80,
+			NightBrightness: 20,
+			Transition:      "2h",
+		},
+	}
+	c.SetDefaults(60)
+
+	level := c.Auto.Level(time.Date(2024, 6, 1, 0, 30, 0, 0, time.UTC))
+	require.Greater(t, level, 20)
+	require.Less(t, level, 80)
+}
+
+type levelSetter struct {
+	level int
+}
+
+func (l *levelSetter) SetBrightness(brightness int) {
+	l.level = brightness
+}
+
+func TestSetters(t *testing.T) {
+	t.Parallel()
+
+	main := &levelSetter{}
+	output := &levelSetter{}
+
+	Setters{main, output}.SetBrightness(40)
+	require.Equal(t, 40, main.level)
+	require.Equal(t, 40, output.level)
+}
@@ -0,0 +1,62 @@
+package brightness
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	julianUnixEpoch = 2440587.5
+	julian2000      = 2451545.0
+	// sunAltitude is the sun's altitude at sunrise and sunset, accounting for refraction
+	// and the size of the sun's disc
+	sunAltitude = -0.833
+	// earthTilt is the obliquity of the ecliptic
+	earthTilt = 23.4397
+)
+
+// SunTimes returns the sunrise and sunset of the day of t, in t's location. If the sun doesn't
+// rise or set that day, ok is false and polarDay says whether the sun is up all day.
+func SunTimes(t time.Time, lat float64, lng float64) (sunrise time.Time, sunset time.Time, ok bool, polarDay bool) {
+	// Days since Jan 1st, 2000 of the local date
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	n := math.Ceil(toJulian(date) - julian2000 + 0.0008)
+
+	meanSolarTime := n - (lng / 360)
+	anomaly := math.Mod(357.5291+0.98560028*meanSolarTime, 360)
+	center := 1.9148*sin(anomaly) + 0.02*sin(2*anomaly) + 0.0003*sin(3*anomaly)
+	longitude := math.Mod(anomaly+center+180+102.9372, 360)
+	transit := julian2000 + meanSolarTime + 0.0053*sin(anomaly) - 0.0069*sin(2*longitude)
+
+	declination := math.Asin(sin(longitude) * sin(earthTilt))
+	cosHourAngle := (sin(sunAltitude) - sin(lat)*math.Sin(declination)) / (cos(lat) * math.Cos(declination))
+	if cosHourAngle < -1 {
+		return time.Time{}, time.Time{}, false, true
+	}
+	if cosHourAngle > 1 {
+		return time.Time{}, time.Time{}, false, false
+	}
+
+	hourAngle := math.Acos(cosHourAngle) * 180 / math.Pi
+
+	sunrise = fromJulian(transit - hourAngle/360).In(t.Location())
+	sunset = fromJulian(transit + hourAngle/360).In(t.Location())
+
+	return sunrise, sunset, true, false
+}
+
+func toJulian(t time.Time) float64 {
+	return float64(t.Unix())/86400 + julianUnixEpoch
+}
+
+func fromJulian(j float64) time.Time {
+	return time.Unix(int64(math.Round((j-julianUnixEpoch)*86400)), 0)
+}
+
+func sin(degrees float64) float64 {
+	return math.Sin(degrees * math.Pi / 180)
+}
+
+func cos(degrees float64) float64 {
+	return math.Cos(degrees * math.Pi / 180)
+}
@@ -12,6 +12,10 @@ import (
 	"github.com/robbydyer/sports/internal/matrix"
 )
 
+type httpHandlerer interface {
+	GetHTTPHandlers() ([]*board.HTTPHandler, error)
+}
+
 // Canvas is a image.Image representation of a WS281x matrix, it implements
 // image.Image interface and can be used with draw.Draw for example
 type Canvas struct {
@@ -33,6 +37,11 @@ func NewCanvas(m matrix.Matrix) *Canvas {
 	}
 }
 
+// Matrix returns the matrix the canvas draws to
+func (c *Canvas) Matrix() matrix.Matrix {
+	return c.m
+}
+
 func (c *Canvas) Name() string {
 	return "RGB Canvas"
 }
@@ -125,5 +134,23 @@ func (c *Canvas) SetStateChangeCallback(s func()) {
 
 // GetHTTPHandlers ...
 func (c *Canvas) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
-	return nil, nil
+	var handlers []*board.HTTPHandler
+
+	if src, ok := c.m.(matrix.FrameSource); ok && src.Frames() != nil {
+		handlers = append(handlers, &board.HTTPHandler{
+			Path:    "/api/matrix/stream",
+			Handler: src.Frames().ServeMJPEG,
+		})
+	}
+
+	// Matrix wrappers, such as a recorder, may provide their own handlers
+	if h, ok := c.m.(httpHandlerer); ok {
+		more, err := h.GetHTTPHandlers()
+		if err != nil {
+			return nil, err
+		}
+		handlers = append(handlers, more...)
+	}
+
+	return handlers, nil
 }
@@ -1,15 +1,27 @@
 package config
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
 	calendarboard "github.com/robbydyer/sports/internal/board/calendar"
 	clock "github.com/robbydyer/sports/internal/board/clock"
+	fantasyboard "github.com/robbydyer/sports/internal/board/fantasy"
+	golfboard "github.com/robbydyer/sports/internal/board/golf"
 	imageboard "github.com/robbydyer/sports/internal/board/image"
+	layoutboard "github.com/robbydyer/sports/internal/board/layout"
 	racingboard "github.com/robbydyer/sports/internal/board/racing"
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
 	statboard "github.com/robbydyer/sports/internal/board/stat"
 	stockboard "github.com/robbydyer/sports/internal/board/stocks"
 	sysboard "github.com/robbydyer/sports/internal/board/sys"
+	tennisboard "github.com/robbydyer/sports/internal/board/tennis"
 	weatherboard "github.com/robbydyer/sports/internal/board/weather"
+	"github.com/robbydyer/sports/internal/espnboard"
+	"github.com/robbydyer/sports/internal/notifier"
 	"github.com/robbydyer/sports/internal/sportsmatrix"
 )
 
@@ -33,11 +45,18 @@ type Config struct {
 	ClockConfig        *clock.Config         `json:"clockConfig"`
 	SysConfig          *sysboard.Config      `json:"sysConfig"`
 	PGA                *statboard.Config     `json:"pga"`
+	PGAConfig          *golfboard.Config     `json:"pgaConfig,omitempty"`
+	LPGAConfig         *golfboard.Config     `json:"lpgaConfig,omitempty"`
+	DPWorldConfig      *golfboard.Config     `json:"dpWorldConfig,omitempty"`
+	ChampionsConfig    *golfboard.Config     `json:"championsConfig,omitempty"`
 	SportsMatrixConfig *sportsmatrix.Config  `json:"sportsMatrixConfig,omitempty"`
 	StocksConfig       *stockboard.Config    `json:"stocksConfig"`
 	WeatherConfig      *weatherboard.Config  `json:"weatherConfig"`
 	F1Config           *racingboard.Config   `json:"f1Config"`
 	IRLConfig          *racingboard.Config   `json:"irlConfig"`
+	NASCARConfig       *racingboard.Config   `json:"nascarConfig,omitempty"`
+	XfinityConfig      *racingboard.Config   `json:"xfinityConfig,omitempty"`
+	MotoGPConfig       *racingboard.Config   `json:"motoGPConfig,omitempty"`
 	CalenderConfig     *calendarboard.Config `json:"calendarConfig"`
 	NCAAWConfig        *sportboard.Config    `json:"ncaawConfig,omitempty"`
 	WNBAConfig         *sportboard.Config    `json:"wnbaConfig,omitempty"`
@@ -45,4 +64,76 @@ type Config struct {
 	SerieaConfig       *sportboard.Config    `json:"serieaConfig,omitempty"`
 	LaligaConfig       *sportboard.Config    `json:"laligaConfig,omitempty"`
 	XFLConfig          *sportboard.Config    `json:"xflConfig,omitempty"`
+	FantasyConfig      *fantasyboard.Config  `json:"fantasyConfig,omitempty"`
+	ATPConfig          *tennisboard.Config   `json:"atpConfig,omitempty"`
+	WTAConfig          *tennisboard.Config   `json:"wtaConfig,omitempty"`
+	ESPNLeagues        []*ESPNLeague         `json:"espnLeagues,omitempty"`
+	NotifierConfig     *notifier.Config      `json:"notifierConfig,omitempty"`
+	Layouts            []*layoutboard.Config `json:"layouts,omitempty"`
+}
+
+// ESPNLeague is an ESPN league defined in config rather than built in, with the config of its sport board
+type ESPNLeague struct {
+	espnboard.LeagueConfig
+	Board *sportboard.Config `json:"board"`
+}
+
+// Sections returns the json names of each top level section of the config, in order
+func Sections() []string {
+	t := reflect.TypeOf(Config{})
+	sections := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sections = append(sections, sectionName(t.Field(i)))
+	}
+
+	return sections
+}
+
+// Diff returns the json names of the top level sections that differ between two configs
+func Diff(a *Config, b *Config) ([]string, error) {
+	va := reflect.ValueOf(a).Elem()
+	vb := reflect.ValueOf(b).Elem()
+
+	var changed []string
+	for i := 0; i < va.NumField(); i++ {
+		ja, err := json.Marshal(va.Field(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare config section %s: %w", sectionName(va.Type().Field(i)), err)
+		}
+		jb, err := json.Marshal(vb.Field(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare config section %s: %w", sectionName(va.Type().Field(i)), err)
+		}
+		if !bytes.Equal(ja, jb) {
+			changed = append(changed, sectionName(va.Type().Field(i)))
+		}
+	}
+
+	return changed, nil
+}
+
+// Only returns a shallow copy of the config with every section but the given ones zeroed
+func (c *Config) Only(sections ...string) *Config {
+	only := &Config{}
+	v := reflect.ValueOf(c).Elem()
+	o := reflect.ValueOf(only).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name := sectionName(v.Type().Field(i))
+		for _, s := range sections {
+			if s == name {
+				o.Field(i).Set(v.Field(i))
+			}
+		}
+	}
+
+	return only
+}
+
+func sectionName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return f.Name
+	}
+
+	return name
 }
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	clock "github.com/robbydyer/sports/internal/board/clock"
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	"github.com/robbydyer/sports/internal/sportsmatrix"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a        *Config
+		b        *Config
+		expected []string
+	}{
+		{
+			name:     "identical",
+			a:        &Config{ClockConfig: &clock.Config{StartEnabled: atomic.NewBool(true)}},
+			b:        &Config{ClockConfig: &clock.Config{StartEnabled: atomic.NewBool(true)}},
+			expected: nil,
+		},
+		{
+			name: "enabled state",
+			a: &Config{
+				ClockConfig: &clock.Config{StartEnabled: atomic.NewBool(true)},
+				NHLConfig:   &sportboard.Config{FavoriteTeams: []string{"NYI"}},
+			},
+			b: &Config{
+				ClockConfig: &clock.Config{StartEnabled: atomic.NewBool(false)},
+				NHLConfig:   &sportboard.Config{FavoriteTeams: []string{"NYI"}},
+			},
+			expected: []string{"clockConfig"},
+		},
+		{
+			name: "added and removed sections",
+			a: &Config{
+				NHLConfig: &sportboard.Config{WatchTeams: []string{"NYI"}},
+			},
+			b: &Config{
+				MLBConfig:          &sportboard.Config{WatchTeams: []string{"ATL"}},
+				SportsMatrixConfig: &sportsmatrix.Config{ScreenOnTimes: []string{"0 8 * * *"}},
+			},
+			expected: []string{"nhlConfig", "mlbConfig", "sportsMatrixConfig"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			changed, err := Diff(test.a, test.b)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, changed)
+		})
+	}
+}
+
+func TestOnly(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		Debug:       true,
+		NHLConfig:   &sportboard.Config{},
+		ClockConfig: &clock.Config{},
+	}
+
+	only := c.Only("nhlConfig", "debug")
+	require.True(t, only.Debug)
+	require.Same(t, c.NHLConfig, only.NHLConfig)
+	require.Nil(t, only.ClockConfig)
+
+	require.Contains(t, Sections(), "nhlConfig")
+	require.Contains(t, Sections(), "layouts")
+}
@@ -0,0 +1,29 @@
+package espnboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GetFouls implements sportlive.BasketballGame
+func (g *Game) GetFouls(ctx context.Context) (int, int, error) {
+	if g.Situation == nil || g.Situation.HomeFouls == nil || g.Situation.AwayFouls == nil {
+		return 0, 0, fmt.Errorf("could not get team fouls")
+	}
+
+	return g.Situation.HomeFouls.TeamFouls, g.Situation.AwayFouls.TeamFouls, nil
+}
+
+// GetBonus implements sportlive.BasketballGame
+func (g *Game) GetBonus(ctx context.Context) (bool, bool, error) {
+	if g.Situation == nil || g.Situation.HomeFouls == nil || g.Situation.AwayFouls == nil {
+		return false, false, fmt.Errorf("could not get bonus state")
+	}
+
+	return inBonus(g.Situation.HomeFouls), inBonus(g.Situation.AwayFouls), nil
+}
+
+func inBonus(f *teamFouls) bool {
+	return f.BonusState != "" && !strings.EqualFold(f.BonusState, "none")
+}
@@ -60,6 +60,8 @@ type ESPNBoard struct {
 	offSeason        map[string]bool
 	mockLiveGames    map[string][]byte
 	mockSchedule     []byte
+	recorder         *Recorder
+	replayer         *Replayer
 	sync.Mutex
 }
 
@@ -116,8 +118,10 @@ func (e *ESPNBoard) CacheClear(ctx context.Context) {
 	for k := range e.logos {
 		delete(e.logos, k)
 	}
+	e.Lock()
 	e.allTeamIDs = []string{}
 	e.teams = nil
+	e.Unlock()
 	e.rankSorted.Store(false)
 	e.ranksSet.Store(false)
 	if _, err := e.GetTeams(ctx); err != nil {
@@ -130,15 +134,19 @@ func (e *ESPNBoard) CacheClear(ctx context.Context) {
 
 // GetTeams ...
 func (e *ESPNBoard) GetTeams(ctx context.Context) ([]sportboard.Team, error) {
-	var err error
-	e.teams, err = e.getTeams(ctx)
+	teams, err := e.getTeams(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	e.Lock()
+	defer e.Unlock()
+
+	e.teams = teams
+
 	var tList []sportboard.Team
 
-	for _, t := range e.teams {
+	for _, t := range teams {
 		e.allTeamIDs = append(e.allTeamIDs, t.ID)
 		tList = append(tList, t)
 
@@ -487,3 +495,19 @@ func WithMockData(mockSchedule []byte, mockLiveGames map[string][]byte) Option {
 		return nil
 	}
 }
+
+// WithRecorder records every scoreboard, live game, team and logo API response
+func WithRecorder(r *Recorder) Option {
+	return func(e *ESPNBoard) error {
+		e.recorder = r
+		return nil
+	}
+}
+
+// WithReplay serves scoreboard, live game, team and logo data from a recorded archive instead of the API
+func WithReplay(r *Replayer) Option {
+	return func(e *ESPNBoard) error {
+		e.replayer = r
+		return nil
+	}
+}
@@ -0,0 +1,47 @@
+package espnboard
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetDownDistance implements sportlive.FootballGame
+func (g *Game) GetDownDistance(ctx context.Context) (string, error) {
+	if g.Situation == nil || g.Situation.ShortDownDistanceText == "" {
+		return "", fmt.Errorf("could not get down and distance")
+	}
+
+	return g.Situation.ShortDownDistanceText, nil
+}
+
+// GetBallPosition implements sportlive.FootballGame
+func (g *Game) GetBallPosition(ctx context.Context) (string, error) {
+	if g.Situation == nil || g.Situation.PossessionText == "" {
+		return "", fmt.Errorf("could not get ball position")
+	}
+
+	return g.Situation.PossessionText, nil
+}
+
+// GetPossession implements sportlive.FootballGame
+func (g *Game) GetPossession(ctx context.Context) (string, error) {
+	if g.Situation == nil {
+		return "", fmt.Errorf("could not get possession")
+	}
+
+	return g.teamAbbrev(g.Situation.Possession), nil
+}
+
+// IsRedZone implements sportlive.FootballGame
+func (g *Game) IsRedZone(ctx context.Context) bool {
+	return g.Situation != nil && g.Situation.IsRedZone
+}
+
+// GetTimeouts implements sportlive.FootballGame and sportlive.BasketballGame
+func (g *Game) GetTimeouts(ctx context.Context) (int, int, error) {
+	if g.Situation == nil || g.Situation.HomeTimeouts == nil || g.Situation.AwayTimeouts == nil {
+		return 0, 0, fmt.Errorf("could not get timeouts")
+	}
+
+	return *g.Situation.HomeTimeouts, *g.Situation.AwayTimeouts, nil
+}
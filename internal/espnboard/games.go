@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -29,13 +30,46 @@ type schedule struct {
 	Events []*event `json:"events"`
 }
 
-type baseballSituation struct {
+// situation is the live state of a game. Only the fields for the game's sport are set.
+type situation struct {
+	// Baseball
 	Balls    int  `json:"balls"`
 	Strikes  int  `json:"strikes"`
 	OnFirst  bool `json:"onFirst"`
 	OnSecond bool `json:"onSecond"`
 	OnThird  bool `json:"onThird"`
 	Outs     int  `json:"outs"`
+
+	// Football
+	Down                  int    `json:"down"`
+	Distance              int    `json:"distance"`
+	YardLine              int    `json:"yardLine"`
+	ShortDownDistanceText string `json:"shortDownDistanceText"`
+	PossessionText        string `json:"possessionText"`
+	Possession            string `json:"possession"`
+	IsRedZone             bool   `json:"isRedZone"`
+
+	// Football and basketball
+	HomeTimeouts *int `json:"homeTimeouts"`
+	AwayTimeouts *int `json:"awayTimeouts"`
+
+	// Basketball
+	HomeFouls *teamFouls `json:"homeFouls"`
+	AwayFouls *teamFouls `json:"awayFouls"`
+
+	// Hockey
+	IsPowerPlay   bool   `json:"isPowerPlay"`
+	PowerPlayTeam string `json:"powerPlayTeamId"`
+}
+
+type teamFouls struct {
+	TeamFouls  int    `json:"teamFouls"`
+	BonusState string `json:"bonusState"`
+}
+
+type statistic struct {
+	Name         string `json:"name"`
+	DisplayValue string `json:"displayValue"`
 }
 
 type event struct {
@@ -44,12 +78,15 @@ type event struct {
 	Status       *status `json:"status"`
 	Competitions []struct {
 		Competitors []struct {
-			HomeAway string `json:"homeAway"`
-			Team     *Team  `json:"team"`
-			Score    string `json:"score"`
+			HomeAway   string            `json:"homeAway"`
+			Team       *Team             `json:"team"`
+			Score      string            `json:"score"`
+			Statistics []*statistic      `json:"statistics"`
+			Leaders    []*leaderCategory `json:"leaders"`
 		}
-		Odds      []*Odds            `json:"odds"`
-		Situation *baseballSituation `json:"situation"`
+		Odds      []*Odds           `json:"odds"`
+		Situation *situation        `json:"situation"`
+		Leaders   []*leaderCategory `json:"leaders"`
 	} `json:"competitions"`
 }
 
@@ -74,7 +111,13 @@ type Game struct {
 	status    *status
 	leaguer   Leaguer
 	odds      []*Odds
-	Situation *baseballSituation
+	Situation *situation
+	homeStats []*statistic
+	awayStats []*statistic
+	leaders   []*leaderCategory
+	// plays are fetched once for each update of the game
+	plays     []*sportboard.Play
+	playsLock sync.Mutex
 }
 
 type status struct {
@@ -158,6 +201,19 @@ func (g *Game) AwayAbbrev() string {
 	return g.Away.Abbreviation
 }
 
+// teamAbbrev returns the abbreviation of the home or away team with the given ID
+func (g *Game) teamAbbrev(id string) string {
+	switch {
+	case id == "":
+		return ""
+	case g.Home != nil && g.Home.ID == id:
+		return g.Home.Abbreviation
+	case g.Away != nil && g.Away.ID == id:
+		return g.Away.Abbreviation
+	}
+	return ""
+}
+
 func (g *Game) HomeColor() (*color.RGBA, *color.RGBA, error) {
 	if g.Home != nil {
 		r, gr, b, err := rgbrender.HexToRGB(g.Home.Color)
@@ -251,6 +307,40 @@ func (g *Game) getMockUpdate() (sportboard.Game, error) {
 		return nil, err
 	}
 
+	return g.updateFromEvent(event)
+}
+
+func (g *Game) getReplayUpdate() (sportboard.Game, error) {
+	league := g.espnBoard.leaguer.HTTPPathPrefix()
+
+	body, err := g.espnBoard.replayer.body(league, archiveKindGame, g.ID)
+	if err != nil {
+		// Live data may not have been recorded for every game, so fall back
+		// to the game's state in the scoreboard
+		for _, sched := range g.espnBoard.replayer.scoreboards(league) {
+			var schedule *schedule
+			if err := json.Unmarshal(sched, &schedule); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal recorded schedule JSON: %w", err)
+			}
+			for _, event := range schedule.Events {
+				if event.ID == g.ID {
+					return g.updateFromEvent(event)
+				}
+			}
+		}
+		return nil, err
+	}
+
+	var event *event
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal recorded game JSON: %w", err)
+	}
+
+	return g.updateFromEvent(event)
+}
+
+func (g *Game) updateFromEvent(event *event) (*Game, error) {
 	newG, err := gameFromEvent(event, g.espnBoard)
 	if err != nil {
 		return nil, err
@@ -269,6 +359,9 @@ func (g *Game) GetUpdate(ctx context.Context) (sportboard.Game, error) {
 	if g.espnBoard != nil && len(g.espnBoard.mockLiveGames) > 0 {
 		return g.getMockUpdate()
 	}
+	if g.espnBoard != nil && g.espnBoard.replayer != nil {
+		return g.getReplayUpdate()
+	}
 
 	uri, err := url.Parse(
 		fmt.Sprintf("http://site.api.espn.com/apis/site/v2/sports/%s/scoreboard/%s", g.leaguer.APIPath(), g.ID),
@@ -308,17 +401,16 @@ func (g *Game) GetUpdate(ctx context.Context) (sportboard.Game, error) {
 		return nil, fmt.Errorf("failed to unmarshal game JSON: %w", err)
 	}
 
-	newG, err := gameFromEvent(event, g.espnBoard)
-	if err != nil {
-		return nil, err
-	}
-	newG.leaguer = g.leaguer
-
-	if len(newG.odds) < 1 {
-		newG.odds = append(newG.odds, g.odds...)
+	if g.espnBoard != nil && g.espnBoard.recorder != nil {
+		if err := g.espnBoard.recorder.record(g.leaguer.HTTPPathPrefix(), archiveKindGame, g.ID, body); err != nil {
+			g.espnBoard.log.Error("failed to record live game",
+				zap.Error(err),
+				zap.String("game ID", g.ID),
+			)
+		}
 	}
 
-	return newG, nil
+	return g.updateFromEvent(event)
 }
 
 // GetStartTime ...
@@ -360,6 +452,29 @@ func (e *ESPNBoard) getMockGames() ([]*Game, error) {
 		return nil, err
 	}
 
+	return e.gamesFromSchedule(schedule)
+}
+
+func (e *ESPNBoard) getReplayGames(dateStr string) ([]*Game, error) {
+	body, err := e.replayer.body(e.leaguer.HTTPPathPrefix(), archiveKindScoreboard, dateStr)
+	if err != nil {
+		e.log.Warn("no recorded schedule for date",
+			zap.String("date", dateStr),
+			zap.String("league", e.League()),
+		)
+		return []*Game{}, nil
+	}
+
+	var schedule *schedule
+
+	if err := json.Unmarshal(body, &schedule); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal recorded game JSON: %w", err)
+	}
+
+	return e.gamesFromSchedule(schedule)
+}
+
+func (e *ESPNBoard) gamesFromSchedule(schedule *schedule) ([]*Game, error) {
 	var games []*Game
 	for _, event := range schedule.Events {
 		game, err := gameFromEvent(event, e)
@@ -383,6 +498,9 @@ func (e *ESPNBoard) GetGames(ctx context.Context, dateStr string) ([]*Game, erro
 	if e.mockSchedule != nil {
 		return e.getMockGames()
 	}
+	if e.replayer != nil {
+		return e.getReplayGames(dateStr)
+	}
 
 	t, ok := e.lastScheduleCall[dateStr]
 	if !ok || t == nil {
@@ -447,16 +565,19 @@ func (e *ESPNBoard) GetGames(ctx context.Context, dateStr string) ([]*Game, erro
 		return nil, fmt.Errorf("failed to unmarshal game JSON: %w", err)
 	}
 
-	var games []*Game
-	for _, event := range schedule.Events {
-		game, err := gameFromEvent(event, e)
-		if err != nil {
-			return nil, err
+	if e.recorder != nil {
+		if err := e.recorder.record(e.leaguer.HTTPPathPrefix(), archiveKindScoreboard, dateStr, body); err != nil {
+			e.log.Error("failed to record schedule",
+				zap.Error(err),
+				zap.String("date", dateStr),
+				zap.String("league", e.League()),
+			)
 		}
+	}
 
-		game.leaguer = e.leaguer
-
-		games = append(games, game)
+	games, err := e.gamesFromSchedule(schedule)
+	if err != nil {
+		return nil, err
 	}
 
 	now := time.Now().Local()
@@ -483,11 +604,15 @@ func gameFromEvent(event *event, b *ESPNBoard) (*Game, error) {
 			if strings.ToLower(team.HomeAway) == "home" {
 				game.Home = team.Team
 				game.Home.Points = team.Score
+				game.homeStats = team.Statistics
 			} else {
 				game.Away = team.Team
 				game.Away.Points = team.Score
+				game.awayStats = team.Statistics
 			}
+			game.leaders = append(game.leaders, withTeam(team.Leaders, team.Team)...)
 		}
+		game.leaders = append(game.leaders, comp.Leaders...)
 	}
 
 	return game, nil
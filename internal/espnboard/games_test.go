@@ -1,9 +1,13 @@
 package espnboard
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
 )
 
 func TestExtractOverUnder(t *testing.T) {
@@ -78,3 +82,107 @@ func TestExtractOverUnder(t *testing.T) {
 		})
 	}
 }
+
+func TestGameSituation(t *testing.T) {
+	t.Parallel()
+
+	competitors := `"competitors": [
+		{"homeAway": "home", "score": "14", "team": {"id": "2", "abbreviation": "BUF"}, "statistics": [{"name": "shotsTotal", "displayValue": "31"}]},
+		{"homeAway": "away", "score": "10", "team": {"id": "15", "abbreviation": "MIA"}, "statistics": [{"name": "shots", "displayValue": "24"}]}
+	]`
+
+	getGame := func(t *testing.T, situation string) *Game {
+		t.Helper()
+		var e *event
+		require.NoError(t, json.Unmarshal([]byte(`{"id": "1", "date": "2023-01-08T18:00Z", "competitions": [{`+competitors+`, "situation": `+situation+`}]}`), &e))
+		g, err := gameFromEvent(e, nil)
+		require.NoError(t, err)
+		return g
+	}
+	ctx := context.Background()
+
+	t.Run("football", func(t *testing.T) {
+		t.Parallel()
+		g := getGame(t, `{"down": 2, "distance": 7, "shortDownDistanceText": "2nd & 7", "possessionText": "MIA 18", "possession": "2", "isRedZone": true, "homeTimeouts": 3, "awayTimeouts": 1}`)
+
+		dd, err := g.GetDownDistance(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "2nd & 7", dd)
+		pos, err := g.GetBallPosition(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "MIA 18", pos)
+		possession, err := g.GetPossession(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "BUF", possession)
+		require.True(t, g.IsRedZone(ctx))
+		home, away, err := g.GetTimeouts(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, home)
+		require.Equal(t, 1, away)
+	})
+
+	t.Run("hockey", func(t *testing.T) {
+		t.Parallel()
+		g := getGame(t, `{"isPowerPlay": true, "powerPlayTeamId": "15"}`)
+
+		home, away, err := g.GetShotsOnGoal(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 31, home)
+		require.Equal(t, 24, away)
+		pp, err := g.GetPowerPlay(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "MIA", pp)
+	})
+
+	t.Run("basketball", func(t *testing.T) {
+		t.Parallel()
+		g := getGame(t, `{"homeTimeouts": 4, "awayTimeouts": 2, "homeFouls": {"teamFouls": 5, "bonusState": "BONUS"}, "awayFouls": {"teamFouls": 3, "bonusState": "NONE"}}`)
+
+		home, away, err := g.GetFouls(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 5, home)
+		require.Equal(t, 3, away)
+		homeBonus, awayBonus, err := g.GetBonus(ctx)
+		require.NoError(t, err)
+		require.True(t, homeBonus)
+		require.False(t, awayBonus)
+		_, err = g.GetDownDistance(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestGameLeaders(t *testing.T) {
+	t.Parallel()
+
+	dat := `{"id": "1", "date": "2023-01-08T18:00Z", "competitions": [{
+		"competitors": [
+			{"homeAway": "home", "team": {"id": "2"}, "leaders": [
+				{"name": "points", "abbreviation": "PTS", "leaders": [
+					{"displayValue": "31", "athlete": {"shortName": "J. Brunson"}},
+					{"displayValue": "20", "athlete": {"shortName": "J. Randle"}}
+				]}
+			]},
+			{"homeAway": "away", "team": {"id": "15"}, "leaders": [
+				{"name": "points", "abbreviation": "PTS", "leaders": [{"displayValue": "28", "athlete": {"displayName": "Jimmy Butler"}}]}
+			]}
+		],
+		"leaders": [
+			{"name": "passingYards", "abbreviation": "PYDS", "leaders": [{"displayValue": "301 YDS", "athlete": {"shortName": "J. Allen"}, "team": {"id": "2"}}]}
+		]
+	}]}`
+
+	var e *event
+	require.NoError(t, json.Unmarshal([]byte(dat), &e))
+	g, err := gameFromEvent(e, nil)
+	require.NoError(t, err)
+
+	home, away, err := g.GetLeaders(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*sportboard.Leader{
+		{Category: "points", Abbreviation: "PTS", Name: "J. Brunson", Value: "31"},
+		{Category: "passingYards", Abbreviation: "PYDS", Name: "J. Allen", Value: "301 YDS"},
+	}, home)
+	require.Equal(t, []*sportboard.Leader{
+		{Category: "points", Abbreviation: "PTS", Name: "Jimmy Butler", Value: "28"},
+	}, away)
+}
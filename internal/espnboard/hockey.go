@@ -0,0 +1,42 @@
+package espnboard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// GetShotsOnGoal implements sportlive.HockeyGame
+func (g *Game) GetShotsOnGoal(ctx context.Context) (int, int, error) {
+	home, err := shots(g.homeStats)
+	if err != nil {
+		return 0, 0, err
+	}
+	away, err := shots(g.awayStats)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return home, away, nil
+}
+
+// GetPowerPlay implements sportlive.HockeyGame
+func (g *Game) GetPowerPlay(ctx context.Context) (string, error) {
+	if g.Situation == nil || !g.Situation.IsPowerPlay {
+		return "", nil
+	}
+
+	return g.teamAbbrev(g.Situation.PowerPlayTeam), nil
+}
+
+func shots(stats []*statistic) (int, error) {
+	for _, name := range []string{"shotsTotal", "shots"} {
+		for _, stat := range stats {
+			if stat.Name == name {
+				return strconv.Atoi(stat.DisplayValue)
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("could not get shots on goal")
+}
@@ -0,0 +1,88 @@
+package espnboard
+
+import (
+	"context"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+)
+
+// leaderCategory is a stat category of the leaders in a game. Basketball and hockey
+// list them per competitor, football lists them for the whole competition.
+type leaderCategory struct {
+	Name         string    `json:"name"`
+	Abbreviation string    `json:"abbreviation"`
+	Leaders      []*leader `json:"leaders"`
+}
+
+type leader struct {
+	DisplayValue string `json:"displayValue"`
+	Athlete      *struct {
+		ShortName   string `json:"shortName"`
+		DisplayName string `json:"displayName"`
+	} `json:"athlete"`
+	Team *teamRef `json:"team"`
+}
+
+type teamRef struct {
+	ID string `json:"id"`
+}
+
+// withTeam sets the team of competitor leaders, which don't include it
+func withTeam(categories []*leaderCategory, team *Team) []*leaderCategory {
+	if team == nil {
+		return categories
+	}
+	for _, c := range categories {
+		for _, l := range c.Leaders {
+			if l.Team == nil {
+				l.Team = &teamRef{ID: team.ID}
+			}
+		}
+	}
+	return categories
+}
+
+// GetLeaders implements sportboard.Leaderer. It returns the top leader of each team in each category.
+func (g *Game) GetLeaders(ctx context.Context) ([]*sportboard.Leader, []*sportboard.Leader, error) {
+	var home []*sportboard.Leader
+	var away []*sportboard.Leader
+
+	type key struct {
+		team     string
+		category string
+	}
+	seen := make(map[key]struct{})
+
+	for _, c := range g.leaders {
+		for _, l := range c.Leaders {
+			if l.Team == nil || l.Athlete == nil {
+				continue
+			}
+			k := key{team: l.Team.ID, category: c.Name}
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+
+			name := l.Athlete.ShortName
+			if name == "" {
+				name = l.Athlete.DisplayName
+			}
+			leader := &sportboard.Leader{
+				Category:     c.Name,
+				Abbreviation: c.Abbreviation,
+				Name:         name,
+				Value:        l.DisplayValue,
+			}
+
+			switch {
+			case g.Home != nil && g.Home.ID == l.Team.ID:
+				home = append(home, leader)
+			case g.Away != nil && g.Away.ID == l.Team.ID:
+				away = append(away, leader)
+			}
+		}
+	}
+
+	return home, away, nil
+}
@@ -12,6 +12,18 @@ import (
 
 // GetLeaguer ...
 func GetLeaguer(league string) (Leaguer, error) {
+	if l, err := builtinLeaguer(league); err == nil {
+		return l, nil
+	}
+
+	if l, ok := registeredLeaguer(strings.Trim(strings.ToLower(league), " ")); ok {
+		return l, nil
+	}
+
+	return nil, fmt.Errorf("invalid league '%s'", league)
+}
+
+func builtinLeaguer(league string) (Leaguer, error) {
 	switch strings.Trim(strings.ToLower(league), " ") {
 	case "nfl":
 		return &nfl{}, nil
@@ -84,8 +96,8 @@ func (n *nfl) SetScoreboardQuery(v url.Values) {
 }
 
 // NewNFL ...
-func NewNFL(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &nfl{}, logger, defaultRankSetter, defaultRankSetter)
+func NewNFL(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &nfl{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type ncaam struct{}
@@ -122,8 +134,8 @@ func (n *ncaam) SetScoreboardQuery(v url.Values) {
 }
 
 // NewNCAAMensBasketball ...
-func NewNCAAMensBasketball(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &ncaam{}, logger, defaultRankSetter, defaultRankSetter)
+func NewNCAAMensBasketball(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &ncaam{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type nba struct{}
@@ -156,8 +168,8 @@ func (n *nba) SetScoreboardQuery(v url.Values) {
 }
 
 // NewNBA ...
-func NewNBA(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &nba{}, logger, defaultRankSetter, defaultRankSetter)
+func NewNBA(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &nba{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type mls struct{}
@@ -190,8 +202,8 @@ func (n *mls) SetScoreboardQuery(v url.Values) {
 }
 
 // NewMLS ...
-func NewMLS(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &mls{}, logger, defaultRankSetter, defaultRankSetter)
+func NewMLS(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &mls{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type nhl struct{}
@@ -227,8 +239,8 @@ func (n *nhl) SetScoreboardQuery(v url.Values) {
 }
 
 // NewNHL ...
-func NewNHL(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &nhl{}, logger, defaultRankSetter, defaultRankSetter)
+func NewNHL(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &nhl{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type mlb struct{}
@@ -300,17 +312,17 @@ func (n *ncaaf) HomeSideSwap() bool {
 }
 
 // NewNCAAF ...
-func NewNCAAF(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
+func NewNCAAF(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
 	n := &ncaaf{}
-	return New(ctx, n, logger, n.setRankings, n.setRecords)
+	return New(ctx, n, logger, n.setRankings, n.setRecords, opts...)
 }
 
 func (n *ncaaf) SetScoreboardQuery(v url.Values) {
 }
 
 // NewEPL ...
-func NewEPL(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &epl{}, logger, defaultRankSetter, defaultRankSetter)
+func NewEPL(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &epl{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type epl struct{}
@@ -345,8 +357,8 @@ func (n *epl) SetScoreboardQuery(v url.Values) {
 }
 
 // NewDFL ...
-func NewDFL(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &dfl{}, logger, defaultRankSetter, defaultRankSetter)
+func NewDFL(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &dfl{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type dfl struct{}
@@ -381,8 +393,8 @@ func (n *dfl) SetScoreboardQuery(v url.Values) {
 }
 
 // NewDFB ...
-func NewDFB(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &dfb{}, logger, defaultRankSetter, defaultRankSetter)
+func NewDFB(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &dfb{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type dfb struct{}
@@ -417,8 +429,8 @@ func (n *dfb) SetScoreboardQuery(v url.Values) {
 }
 
 // NewUEFA ...
-func NewUEFA(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &uefa{}, logger, defaultRankSetter, defaultRankSetter)
+func NewUEFA(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &uefa{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type uefa struct{}
@@ -453,8 +465,8 @@ func (n *uefa) SetScoreboardQuery(v url.Values) {
 }
 
 // NewFIFA ...
-func NewFIFA(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &fifa{}, logger, defaultRankSetter, defaultRankSetter)
+func NewFIFA(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &fifa{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type fifa struct{}
@@ -489,8 +501,8 @@ func (n *fifa) SetScoreboardQuery(v url.Values) {
 }
 
 // NewNCAAWomensBasketball ...
-func NewNCAAWomensBasketball(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &ncaaw{}, logger, defaultRankSetter, defaultRankSetter)
+func NewNCAAWomensBasketball(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &ncaaw{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type ncaaw struct{}
@@ -527,8 +539,8 @@ func (n *ncaaw) SetScoreboardQuery(v url.Values) {
 }
 
 // NewWNBA ...
-func NewWNBA(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &wnba{}, logger, defaultRankSetter, defaultRankSetter)
+func NewWNBA(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &wnba{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type wnba struct{}
@@ -561,8 +573,8 @@ func (n *wnba) SetScoreboardQuery(v url.Values) {
 }
 
 // NewLigue1 ...
-func NewLigue(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &ligue{}, logger, defaultRankSetter, defaultRankSetter)
+func NewLigue(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &ligue{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type ligue struct{}
@@ -597,8 +609,8 @@ func (n *ligue) SetScoreboardQuery(v url.Values) {
 }
 
 // NewSerieA ...
-func NewSerieA(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &seriea{}, logger, defaultRankSetter, defaultRankSetter)
+func NewSerieA(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &seriea{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type seriea struct{}
@@ -633,8 +645,8 @@ func (n *seriea) SetScoreboardQuery(v url.Values) {
 }
 
 // LaLiga ...
-func NewLaLiga(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &laliga{}, logger, defaultRankSetter, defaultRankSetter)
+func NewLaLiga(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &laliga{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
 
 type laliga struct{}
@@ -698,6 +710,6 @@ func (n *xfl) SetScoreboardQuery(v url.Values) {
 }
 
 // NewXFL ...
-func NewXFL(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
-	return New(ctx, &xfl{}, logger, defaultRankSetter, defaultRankSetter)
+func NewXFL(ctx context.Context, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, &xfl{}, logger, defaultRankSetter, defaultRankSetter, opts...)
 }
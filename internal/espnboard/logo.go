@@ -1,7 +1,9 @@
 package espnboard
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"image"
 	"image/png"
@@ -186,7 +188,23 @@ func (e *ESPNBoard) GetLogoSource(ctx context.Context, teamID string, logoURLSea
 		if err != nil {
 			return nil, fmt.Errorf("failed to open logo cache file: %w", err)
 		}
-		return png.Decode(r)
+		defer r.Close()
+		i, err := png.Decode(r)
+		if err != nil {
+			return nil, err
+		}
+		e.recordLogo(teamID, i)
+		return i, nil
+	}
+
+	if e.replayer != nil {
+		if i, err := e.replayLogo(teamID); err == nil {
+			return i, nil
+		}
+		e.log.Warn("no recorded logo for team",
+			zap.String("league", e.leaguer.League()),
+			zap.String("team", teamID),
+		)
 	}
 
 	teams, err := e.getTeams(ctx)
@@ -251,6 +269,7 @@ OUTER:
 		if err != nil || i == nil {
 			return nil, fmt.Errorf("failed to retrieve logo from API for %s %s: %w", teamID, href, err)
 		}
+		e.recordLogo(teamID, i)
 		foundSource = true
 	}
 
@@ -269,3 +288,43 @@ OUTER:
 
 	return i, nil
 }
+
+// recordLogo stores a source logo in the archive. The PNG is encoded as a JSON string.
+func (e *ESPNBoard) recordLogo(teamID string, i image.Image) {
+	if e.recorder == nil {
+		return
+	}
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, i); err != nil {
+		e.log.Error("failed to encode logo for recording", zap.Error(err))
+		return
+	}
+	body, err := json.Marshal(buf.Bytes())
+	if err != nil {
+		e.log.Error("failed to encode logo for recording", zap.Error(err))
+		return
+	}
+
+	if err := e.recorder.record(e.leaguer.HTTPPathPrefix(), archiveKindLogo, teamID, body); err != nil {
+		e.log.Error("failed to record logo",
+			zap.Error(err),
+			zap.String("team", teamID),
+			zap.String("league", e.League()),
+		)
+	}
+}
+
+func (e *ESPNBoard) replayLogo(teamID string) (image.Image, error) {
+	body, err := e.replayer.latest(e.leaguer.HTTPPathPrefix(), archiveKindLogo, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var dat []byte
+	if err := json.Unmarshal(body, &dat); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal recorded logo: %w", err)
+	}
+
+	return png.Decode(bytes.NewReader(dat))
+}
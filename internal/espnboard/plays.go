@@ -0,0 +1,181 @@
+package espnboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+)
+
+type summaryPlay struct {
+	Text        string `json:"text"`
+	ScoringPlay bool   `json:"scoringPlay"`
+	Period      struct {
+		Number int `json:"number"`
+	} `json:"period"`
+	Clock struct {
+		DisplayValue string `json:"displayValue"`
+	} `json:"clock"`
+	Team *struct {
+		ID string `json:"id"`
+	} `json:"team"`
+}
+
+type summaryDrive struct {
+	Description string `json:"description"`
+	IsScore     bool   `json:"isScore"`
+	Team        *struct {
+		ID string `json:"id"`
+	} `json:"team"`
+}
+
+// summary is the play data of the ESPN game summary API. Hockey and basketball
+// have every play, football has scoring plays and drives, and soccer has key events.
+type summary struct {
+	Plays        []*summaryPlay `json:"plays"`
+	ScoringPlays []*summaryPlay `json:"scoringPlays"`
+	KeyEvents    []*summaryPlay `json:"keyEvents"`
+	Drives       *struct {
+		Previous []*summaryDrive `json:"previous"`
+	} `json:"drives"`
+}
+
+// GetPlays implements sportboard.PlayByPlayer. The plays are fetched once for each update of the game.
+func (g *Game) GetPlays(ctx context.Context) ([]*sportboard.Play, error) {
+	// Play-by-play isn't part of mock or recorded game data
+	if g.espnBoard != nil && (len(g.espnBoard.mockLiveGames) > 0 || g.espnBoard.replayer != nil) {
+		return nil, nil
+	}
+
+	g.playsLock.Lock()
+	defer g.playsLock.Unlock()
+
+	if g.plays != nil {
+		return g.plays, nil
+	}
+
+	uri, err := url.Parse(fmt.Sprintf("http://site.api.espn.com/apis/site/v2/sports/%s/summary", g.leaguer.APIPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	v := uri.Query()
+	v.Set("event", g.ID)
+	uri.RawQuery = v.Encode()
+
+	req, err := http.NewRequest("GET", uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET game summary: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	plays, err := g.playsFromSummary(body)
+	if err != nil {
+		return nil, err
+	}
+	if plays == nil {
+		plays = []*sportboard.Play{}
+	}
+	g.plays = plays
+
+	return plays, nil
+}
+
+func (g *Game) playsFromSummary(dat []byte) ([]*sportboard.Play, error) {
+	var s *summary
+	if err := json.Unmarshal(dat, &s); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal game summary JSON: %w", err)
+	}
+
+	var plays []*sportboard.Play
+	switch {
+	case len(s.Plays) > 0:
+		for _, p := range s.Plays {
+			plays = append(plays, g.play(p, p.ScoringPlay))
+		}
+	case len(s.KeyEvents) > 0:
+		for _, p := range s.KeyEvents {
+			plays = append(plays, g.play(p, p.ScoringPlay))
+		}
+	default:
+		var drives []*summaryDrive
+		if s.Drives != nil {
+			for _, d := range s.Drives.Previous {
+				if d.IsScore {
+					drives = append(drives, d)
+				}
+			}
+		}
+		for _, p := range s.ScoringPlays {
+			play := g.play(p, true)
+
+			// Scoring drives are in the same order as the scoring plays
+			for i, d := range drives {
+				if d.Team != nil && p.Team != nil && d.Team.ID == p.Team.ID {
+					if d.Description != "" {
+						play.Text = fmt.Sprintf("%s - %s", play.Text, d.Description)
+					}
+					drives = drives[i+1:]
+					break
+				}
+			}
+
+			plays = append(plays, play)
+		}
+	}
+
+	return plays, nil
+}
+
+func (g *Game) play(p *summaryPlay, scoring bool) *sportboard.Play {
+	play := &sportboard.Play{
+		Text:    strings.TrimSpace(p.Text),
+		Clock:   p.Clock.DisplayValue,
+		Scoring: scoring,
+	}
+	if p.Period.Number > 0 {
+		play.Period = ordinal(p.Period.Number)
+	}
+	if p.Team != nil {
+		play.Team = g.teamAbbrev(p.Team.ID)
+	}
+
+	return play
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,69 @@
+package espnboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+)
+
+func TestPlaysFromSummary(t *testing.T) {
+	t.Parallel()
+
+	g := &Game{
+		Home: &Team{ID: "12", Abbreviation: "NYI"},
+		Away: &Team{ID: "4", Abbreviation: "CHI"},
+	}
+
+	tests := []struct {
+		name     string
+		summary  string
+		expected []*sportboard.Play
+	}{
+		{
+			name: "every play",
+			summary: `{"plays": [
+				{"text": "Faceoff won by Horvat", "period": {"number": 2}, "clock": {"displayValue": "20:00"}, "team": {"id": "12"}},
+				{"text": "Barzal Goal, assists: Dobson, Lee", "scoringPlay": true, "period": {"number": 2}, "clock": {"displayValue": "12:34"}, "team": {"id": "12"}}
+			]}`,
+			expected: []*sportboard.Play{
+				{Team: "NYI", Period: "2nd", Clock: "20:00", Text: "Faceoff won by Horvat"},
+				{Team: "NYI", Period: "2nd", Clock: "12:34", Text: "Barzal Goal, assists: Dobson, Lee", Scoring: true},
+			},
+		},
+		{
+			name: "scoring plays with drives",
+			summary: `{
+				"scoringPlays": [
+					{"text": "Allen 5 Yd Run (Bass Kick)", "period": {"number": 1}, "clock": {"displayValue": "9:12"}, "team": {"id": "4"}},
+					{"text": "Bass 40 Yd Field Goal", "period": {"number": 3}, "clock": {"displayValue": "1:02"}, "team": {"id": "4"}}
+				],
+				"drives": {"previous": [
+					{"description": "3 plays, 10 yards, 1:30", "isScore": false, "team": {"id": "12"}},
+					{"description": "10 plays, 75 yards, 5:12", "isScore": true, "team": {"id": "4"}},
+					{"description": "8 plays, 40 yards, 3:01", "isScore": true, "team": {"id": "4"}}
+				]}
+			}`,
+			expected: []*sportboard.Play{
+				{Team: "CHI", Period: "1st", Clock: "9:12", Text: "Allen 5 Yd Run (Bass Kick) - 10 plays, 75 yards, 5:12", Scoring: true},
+				{Team: "CHI", Period: "3rd", Clock: "1:02", Text: "Bass 40 Yd Field Goal - 8 plays, 40 yards, 3:01", Scoring: true},
+			},
+		},
+		{
+			name:     "no plays",
+			summary:  `{}`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			plays, err := g.playsFromSummary([]byte(test.summary))
+			require.NoError(t, err)
+			require.Equal(t, test.expected, plays)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package espnboard
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+var (
+	registry     = make(map[string]*configLeaguer)
+	registryLock sync.RWMutex
+)
+
+// LeagueConfig defines an ESPN league that doesn't have a built-in Leaguer
+type LeagueConfig struct {
+	// Name is the display name of the league, ie. "CFL"
+	Name string `json:"name"`
+	// HTTPPathPrefix is the unique key of the league, used in its API paths, ie. "cfl"
+	HTTPPathPrefix string `json:"httpPathPrefix"`
+	// APIPath is the sport and league of the ESPN API, ie. "football/cfl"
+	APIPath string `json:"apiPath"`
+	// TeamEndpoints are the API paths teams are pulled from. Defaults to the league's teams endpoint.
+	TeamEndpoints []string `json:"teamEndpoints"`
+	// HeadlinePath is the API path of the league's news. Defaults to the league's news endpoint.
+	HeadlinePath string `json:"headlinePath"`
+	// HomeSideSwap shows the home team on the left, as soccer does
+	HomeSideSwap bool `json:"homeSideSwap"`
+	// ScoreboardParams are added to the scoreboard query, ie. groups: "80"
+	ScoreboardParams map[string]string `json:"scoreboardParams"`
+}
+
+// configLeaguer implements Leaguer from a LeagueConfig
+type configLeaguer struct {
+	config *LeagueConfig
+}
+
+// Validate ...
+func (c *LeagueConfig) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("ESPN league must have a name")
+	}
+	if c.HTTPPathPrefix == "" {
+		return fmt.Errorf("ESPN league %s must have an httpPathPrefix", c.Name)
+	}
+	if c.APIPath == "" {
+		return fmt.Errorf("ESPN league %s must have an apiPath", c.Name)
+	}
+	if strings.Count(strings.Trim(c.APIPath, "/"), "/") != 1 {
+		return fmt.Errorf("ESPN league %s apiPath must be in the form <sport>/<league>, got '%s'", c.Name, c.APIPath)
+	}
+
+	return nil
+}
+
+// RegisterLeague adds a league defined in config, so that GetLeaguer can find it by its HTTPPathPrefix.
+// Registering a league with the same HTTPPathPrefix again replaces it.
+func RegisterLeague(c *LeagueConfig) (Leaguer, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := strings.ToLower(strings.TrimSpace(c.HTTPPathPrefix))
+	if _, err := builtinLeaguer(key); err == nil {
+		return nil, fmt.Errorf("ESPN league %s conflicts with the built-in league '%s'", c.Name, key)
+	}
+
+	l := &configLeaguer{
+		config: c,
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[key] = l
+
+	return l, nil
+}
+
+// NewLeague returns an ESPNBoard for a registered league
+func NewLeague(ctx context.Context, leaguer Leaguer, logger *zap.Logger, opts ...Option) (*ESPNBoard, error) {
+	return New(ctx, leaguer, logger, defaultRankSetter, defaultRankSetter, opts...)
+}
+
+func registeredLeaguer(league string) (Leaguer, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	l, ok := registry[league]
+	return l, ok
+}
+
+func (n *configLeaguer) League() string {
+	return n.config.Name
+}
+
+func (n *configLeaguer) APIPath() string {
+	return strings.Trim(n.config.APIPath, "/")
+}
+
+func (n *configLeaguer) TeamEndpoints() []string {
+	if len(n.config.TeamEndpoints) > 0 {
+		return n.config.TeamEndpoints
+	}
+	return []string{filepath.Join(n.APIPath(), "teams")}
+}
+
+func (n *configLeaguer) HTTPPathPrefix() string {
+	return strings.ToLower(strings.TrimSpace(n.config.HTTPPathPrefix))
+}
+
+func (n *configLeaguer) HeadlinePath() string {
+	if n.config.HeadlinePath != "" {
+		return n.config.HeadlinePath
+	}
+	return fmt.Sprintf("%s/news", n.APIPath())
+}
+
+func (n *configLeaguer) HomeSideSwap() bool {
+	return n.config.HomeSideSwap
+}
+
+func (n *configLeaguer) SetScoreboardQuery(v url.Values) {
+	for k, val := range n.config.ScoreboardParams {
+		v.Set(k, val)
+	}
+}
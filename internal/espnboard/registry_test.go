@@ -0,0 +1,98 @@
+package espnboard
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterLeague(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  *LeagueConfig
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			config: &LeagueConfig{
+				Name:           "CFL",
+				HTTPPathPrefix: "cfl",
+				APIPath:        "football/cfl",
+			},
+		},
+		{
+			name: "missing api path",
+			config: &LeagueConfig{
+				Name:           "AHL",
+				HTTPPathPrefix: "ahl",
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad api path",
+			config: &LeagueConfig{
+				Name:           "AHL",
+				HTTPPathPrefix: "ahl",
+				APIPath:        "ahl",
+			},
+			wantErr: true,
+		},
+		{
+			name: "built-in conflict",
+			config: &LeagueConfig{
+				Name:           "National Football League",
+				HTTPPathPrefix: "NFL",
+				APIPath:        "football/nfl",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := RegisterLeague(test.config)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConfigLeaguer(t *testing.T) {
+	t.Parallel()
+
+	_, err := RegisterLeague(&LeagueConfig{
+		Name:           "NCAA Women's Soccer",
+		HTTPPathPrefix: "NCAAWS",
+		APIPath:        "/soccer/usa.ncaa.w.1/",
+		HomeSideSwap:   true,
+		ScoreboardParams: map[string]string{
+			"groups": "80",
+		},
+	})
+	require.NoError(t, err)
+
+	l, err := GetLeaguer("ncaaws")
+	require.NoError(t, err)
+
+	require.Equal(t, "NCAA Women's Soccer", l.League())
+	require.Equal(t, "ncaaws", l.HTTPPathPrefix())
+	require.Equal(t, "soccer/usa.ncaa.w.1", l.APIPath())
+	require.Equal(t, []string{"soccer/usa.ncaa.w.1/teams"}, l.TeamEndpoints())
+	require.Equal(t, "soccer/usa.ncaa.w.1/news", l.HeadlinePath())
+	require.True(t, l.HomeSideSwap())
+
+	v := url.Values{}
+	l.SetScoreboardQuery(v)
+	require.Equal(t, "80", v.Get("groups"))
+
+	_, err = GetLeaguer("kbo")
+	require.Error(t, err)
+}
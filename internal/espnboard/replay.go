@@ -0,0 +1,245 @@
+package espnboard
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	archiveKindScoreboard = "scoreboard"
+	archiveKindGame       = "game"
+	archiveKindTeams      = "teams"
+	archiveKindLogo       = "logo"
+)
+
+// ArchiveEntry is a single recorded ESPN API response
+type ArchiveEntry struct {
+	Time   time.Time       `json:"time"`
+	League string          `json:"league"`
+	Kind   string          `json:"kind"`
+	Key    string          `json:"key"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// Recorder captures every scoreboard, live game, team and logo response into an archive file.
+// The archive is written as one JSON encoded ArchiveEntry per line, so that a recording
+// that is interrupted is still replayable.
+type Recorder struct {
+	writer io.WriteCloser
+	enc    *json.Encoder
+	now    func() time.Time
+	sync.Mutex
+}
+
+// Replayer serves recorded API responses from an archive as if they were live.
+// Time in the archive advances relative to when the Replayer was created, multiplied by the speed.
+type Replayer struct {
+	entries []*ArchiveEntry
+	start   time.Time
+	began   time.Time
+	speed   float64
+	now     func() time.Time
+}
+
+// NewRecorder creates a Recorder that appends to the given archive file
+func NewRecorder(archive string) (*Recorder, error) {
+	f, err := os.OpenFile(archive, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive %s: %w", archive, err)
+	}
+
+	return newRecorder(f), nil
+}
+
+func newRecorder(w io.WriteCloser) *Recorder {
+	return &Recorder{
+		writer: w,
+		enc:    json.NewEncoder(w),
+		now:    time.Now,
+	}
+}
+
+// Close closes the underlying archive file
+func (r *Recorder) Close() error {
+	r.Lock()
+	defer r.Unlock()
+	return r.writer.Close()
+}
+
+func (r *Recorder) record(league string, kind string, key string, body []byte) error {
+	if !json.Valid(body) {
+		return fmt.Errorf("refusing to record invalid JSON for %s %s", kind, key)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	return r.enc.Encode(&ArchiveEntry{
+		Time:   r.now(),
+		League: league,
+		Kind:   kind,
+		Key:    key,
+		Body:   body,
+	})
+}
+
+// LoadReplayer reads an archive file written by a Recorder
+func LoadReplayer(archive string, speed float64) (*Replayer, error) {
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive %s: %w", archive, err)
+	}
+	defer f.Close()
+
+	return NewReplayer(f, speed)
+}
+
+// NewReplayer reads archive entries from the given reader. A speed of 1 replays in real time.
+func NewReplayer(r io.Reader, speed float64) (*Replayer, error) {
+	if speed <= 0 {
+		return nil, fmt.Errorf("invalid replay speed %f", speed)
+	}
+
+	var entries []*ArchiveEntry
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry *ArchiveEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse archive entry %d: %w", len(entries)+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	if len(entries) < 1 {
+		return nil, fmt.Errorf("archive is empty")
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+
+	return &Replayer{
+		entries: entries,
+		start:   entries[0].Time,
+		began:   time.Now(),
+		speed:   speed,
+		now:     time.Now,
+	}, nil
+}
+
+// Now returns the current point in time of the replay
+func (r *Replayer) Now() time.Time {
+	elapsed := r.now().Sub(r.began)
+	return r.start.Add(time.Duration(float64(elapsed) * r.speed))
+}
+
+// Start returns the time of the first recorded response
+func (r *Replayer) Start() time.Time {
+	return r.start
+}
+
+// End returns the time of the last recorded response
+func (r *Replayer) End() time.Time {
+	return r.entries[len(r.entries)-1].Time
+}
+
+// Done returns true once the replay has passed the last recorded response
+func (r *Replayer) Done() bool {
+	return r.Now().After(r.End())
+}
+
+// Leagues returns the HTTP path prefix of every league in the archive
+func (r *Replayer) Leagues() []string {
+	seen := make(map[string]struct{})
+	leagues := []string{}
+	for _, entry := range r.entries {
+		if _, ok := seen[entry.League]; ok {
+			continue
+		}
+		seen[entry.League] = struct{}{}
+		leagues = append(leagues, entry.League)
+	}
+
+	return leagues
+}
+
+// Dates returns every scoreboard date recorded for a league
+func (r *Replayer) Dates(league string) []string {
+	seen := make(map[string]struct{})
+	dates := []string{}
+	for _, entry := range r.entries {
+		if entry.League != league || entry.Kind != archiveKindScoreboard {
+			continue
+		}
+		if _, ok := seen[entry.Key]; ok {
+			continue
+		}
+		seen[entry.Key] = struct{}{}
+		dates = append(dates, entry.Key)
+	}
+
+	return dates
+}
+
+// body returns the most recent response as of the replay's current time
+func (r *Replayer) body(league string, kind string, key string) ([]byte, error) {
+	now := r.Now()
+
+	var found *ArchiveEntry
+	for _, entry := range r.entries {
+		if entry.Time.After(now) {
+			break
+		}
+		if entry.League != league || entry.Kind != kind || entry.Key != key {
+			continue
+		}
+		found = entry
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no recorded %s data for %s %s", kind, league, key)
+	}
+
+	return found.Body, nil
+}
+
+// latest returns the last recorded response regardless of the replay's current time. Teams and
+// logos don't change during a game, so they are served even before the time they were recorded.
+func (r *Replayer) latest(league string, kind string, key string) ([]byte, error) {
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		entry := r.entries[i]
+		if entry.League == league && entry.Kind == kind && entry.Key == key {
+			return entry.Body, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no recorded %s data for %s %s", kind, league, key)
+}
+
+// scoreboards returns the most recent scoreboard response for each recorded date
+func (r *Replayer) scoreboards(league string) [][]byte {
+	var bodies [][]byte
+	for _, date := range r.Dates(league) {
+		body, err := r.body(league, archiveKindScoreboard, date)
+		if err != nil {
+			continue
+		}
+		bodies = append(bodies, body)
+	}
+
+	return bodies
+}
@@ -0,0 +1,130 @@
+package espnboard
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (n nopWriteCloser) Close() error {
+	return nil
+}
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2022, time.October, 2, 13, 0, 0, 0, time.UTC)
+	scheduleBody := []byte(`{"events":[{"id":"1","date":"2022-10-02T17:00Z","status":{"period":0,"type":{"name":"STATUS_SCHEDULED"}},"competitions":[{"competitors":[{"homeAway":"home","team":{"id":"10","abbreviation":"HOM"},"score":"0"},{"homeAway":"away","team":{"id":"20","abbreviation":"AWY"},"score":"0"}]}]}]}`)
+	liveBody := []byte(`{"id":"1","date":"2022-10-02T17:00Z","status":{"period":2,"type":{"name":"STATUS_IN_PROGRESS"}},"competitions":[{"competitors":[{"homeAway":"home","team":{"id":"10","abbreviation":"HOM"},"score":"7"},{"homeAway":"away","team":{"id":"20","abbreviation":"AWY"},"score":"3"}]}]}`)
+
+	buf := &bytes.Buffer{}
+	rec := newRecorder(nopWriteCloser{buf})
+	rec.now = func() time.Time { return start }
+	require.NoError(t, rec.record("nfl", archiveKindScoreboard, "20221002", scheduleBody))
+	rec.now = func() time.Time { return start.Add(time.Hour) }
+	require.NoError(t, rec.record("nfl", archiveKindGame, "1", liveBody))
+	require.Error(t, rec.record("nfl", archiveKindGame, "1", []byte("not json")))
+
+	replayer, err := NewReplayer(buf, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"nfl"}, replayer.Leagues())
+	require.Equal(t, []string{"20221002"}, replayer.Dates("nfl"))
+
+	wall := time.Now()
+	replayer.began = wall
+	replayer.now = func() time.Time { return wall }
+
+	e := &ESPNBoard{
+		leaguer:  &nfl{},
+		log:      zap.NewNop(),
+		replayer: replayer,
+	}
+
+	games, err := e.GetGames(context.Background(), "20221002")
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+
+	// Before the live data was recorded, the scoreboard state is used
+	update, err := games[0].GetUpdate(context.Background())
+	require.NoError(t, err)
+	home, err := update.HomeTeam()
+	require.NoError(t, err)
+	require.Equal(t, 0, home.Score())
+
+	// 6 minutes at 10x speed is one hour into the archive
+	replayer.now = func() time.Time { return wall.Add(6 * time.Minute) }
+	require.Equal(t, start.Add(time.Hour), replayer.Now())
+
+	update, err = games[0].GetUpdate(context.Background())
+	require.NoError(t, err)
+	home, err = update.HomeTeam()
+	require.NoError(t, err)
+	require.Equal(t, 7, home.Score())
+	require.False(t, replayer.Done())
+
+	games, err = e.GetGames(context.Background(), "20221003")
+	require.NoError(t, err)
+	require.Len(t, games, 0)
+}
+
+func TestReplayTeamsAndLogos(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2022, time.October, 2, 13, 0, 0, 0, time.UTC)
+	teamsBody := []byte(`{"sports":[{"leagues":[{"teams":[{"team":{"id":"replay-test","abbreviation":"RPL"}}]}]}]}`)
+
+	logo := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	logo.Set(1, 1, color.RGBA{R: 255, A: 255})
+	pngBuf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(pngBuf, logo))
+	logoBody, err := json.Marshal(pngBuf.Bytes())
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	rec := newRecorder(nopWriteCloser{buf})
+	rec.now = func() time.Time { return start }
+	require.NoError(t, rec.record("nfl", archiveKindScoreboard, "20221002", []byte(`{"events":[]}`)))
+	// Teams and logos recorded after the replay's current time are still served
+	rec.now = func() time.Time { return start.Add(time.Hour) }
+	for _, endpoint := range (&nfl{}).TeamEndpoints() {
+		require.NoError(t, rec.record("nfl", archiveKindTeams, endpoint, teamsBody))
+	}
+	require.NoError(t, rec.record("nfl", archiveKindLogo, "replay-test", logoBody))
+
+	replayer, err := NewReplayer(buf, 1)
+	require.NoError(t, err)
+
+	e := &ESPNBoard{
+		leaguer:     &nfl{},
+		log:         zap.NewNop(),
+		replayer:    replayer,
+		logoLockers: make(map[string]*sync.Mutex),
+	}
+
+	teams, err := e.teamsFromAPI(context.Background())
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	require.Equal(t, "RPL", teams[0].GetAbbreviation())
+
+	i, err := e.GetLogoSource(context.Background(), "replay-test", "")
+	require.NoError(t, err)
+	r, g, b, a := i.At(1, 1).RGBA()
+	require.Equal(t, []uint32{0xffff, 0, 0, 0xffff}, []uint32{r, g, b, a})
+
+	_, err = e.replayLogo("missing")
+	require.Error(t, err)
+}
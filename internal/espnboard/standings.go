@@ -0,0 +1,173 @@
+package espnboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	standingsboard "github.com/robbydyer/sports/internal/board/standings"
+)
+
+type standingsNode struct {
+	Name         string           `json:"name"`
+	Abbreviation string           `json:"abbreviation"`
+	Children     []*standingsNode `json:"children"`
+	Standings    *struct {
+		Entries []*standingsEntry `json:"entries"`
+	} `json:"standings"`
+}
+
+type standingsEntry struct {
+	Team struct {
+		ID           string `json:"id"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"team"`
+	Stats []struct {
+		Name         string  `json:"name"`
+		Value        float64 `json:"value"`
+		DisplayValue string  `json:"displayValue"`
+	} `json:"stats"`
+}
+
+// GetStandings implements standingsboard.API. Teams are grouped by their ConferenceName(),
+// falling back to the grouping of the standings API for teams without a conference.
+func (e *ESPNBoard) GetStandings(ctx context.Context) ([]*standingsboard.Standing, error) {
+	dat, err := pullStandings(ctx, e.leaguer.APIPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s standings: %w", e.League(), err)
+	}
+
+	standings, err := parseStandings(dat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s standings: %w", e.League(), err)
+	}
+
+	if err := e.groupStandings(ctx, standings); err != nil {
+		return nil, err
+	}
+
+	e.log.Debug("got standings",
+		zap.String("league", e.League()),
+		zap.Int("num teams", len(standings)),
+	)
+
+	return standings, nil
+}
+
+// groupStandings sets the group of each standing to its team's conference
+func (e *ESPNBoard) groupStandings(ctx context.Context, standings []*standingsboard.Standing) error {
+	e.Lock()
+	loaded := len(e.teams) > 0
+	e.Unlock()
+
+	if !loaded {
+		if _, err := e.GetTeams(ctx); err != nil {
+			return err
+		}
+	}
+
+	// The scores path updates teams concurrently, so copy them under the lock
+	e.Lock()
+	teams := make(map[string]*Team, len(e.teams))
+	for _, t := range e.teams {
+		teams[t.ID] = t
+	}
+	e.Unlock()
+
+	for _, standing := range standings {
+		team, ok := teams[standing.TeamID]
+		if !ok || team.Conference == nil {
+			continue
+		}
+		standing.Group = team.ConferenceName()
+		standing.GroupName = team.Conference.Name
+	}
+
+	return nil
+}
+
+func parseStandings(dat []byte) ([]*standingsboard.Standing, error) {
+	var root *standingsNode
+	if err := json.Unmarshal(dat, &root); err != nil {
+		return nil, err
+	}
+
+	var standings []*standingsboard.Standing
+	var walk func(node *standingsNode)
+	walk = func(node *standingsNode) {
+		if node.Standings != nil {
+			for _, entry := range node.Standings.Entries {
+				standings = append(standings, entry.standing(node))
+			}
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	return standings, nil
+}
+
+func (entry *standingsEntry) standing(node *standingsNode) *standingsboard.Standing {
+	group := node.Abbreviation
+	if group == "" {
+		group = node.Name
+	}
+	s := &standingsboard.Standing{
+		TeamID:       entry.Team.ID,
+		Abbreviation: entry.Team.Abbreviation,
+		Group:        group,
+		GroupName:    node.Name,
+	}
+
+	for _, stat := range entry.Stats {
+		switch stat.Name {
+		case "wins":
+			s.Wins = int(stat.Value)
+		case "losses":
+			s.Losses = int(stat.Value)
+		// Overtime losses are shown in place of ties, ie. NHL records are W-L-OT
+		case "ties", "otLosses":
+			s.Ties += int(stat.Value)
+		case "winPercent":
+			s.WinPercent = stat.Value
+		case "gamesBehind":
+			s.GamesBehind = stat.DisplayValue
+		case "streak":
+			s.Streak = stat.DisplayValue
+		}
+	}
+
+	return s
+}
+
+func pullStandings(ctx context.Context, apiPath string) ([]byte, error) {
+	uri, err := url.Parse(fmt.Sprintf("https://site.api.espn.com/apis/v2/sports/%s/standings", apiPath))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", uri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,119 @@
+package espnboard
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	standingsboard "github.com/robbydyer/sports/internal/board/standings"
+)
+
+func TestParseStandings(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{
+  "children": [
+    {
+      "name": "Eastern Conference",
+      "abbreviation": "East",
+      "standings": {
+        "entries": [
+          {
+            "team": {"id": "12", "abbreviation": "NYI"},
+            "stats": [
+              {"name": "wins", "value": 15, "displayValue": "15"},
+              {"name": "losses", "value": 5, "displayValue": "5"},
+              {"name": "otLosses", "value": 2, "displayValue": "2"},
+              {"name": "winPercent", "value": 0.682, "displayValue": ".682"},
+              {"name": "streak", "value": 3, "displayValue": "W3"}
+            ]
+          }
+        ]
+      }
+    },
+    {
+      "name": "Western Conference",
+      "children": [
+        {
+          "name": "Central Division",
+          "standings": {
+            "entries": [
+              {
+                "team": {"id": "4", "abbreviation": "CHI"},
+                "stats": [
+                  {"name": "wins", "value": 8, "displayValue": "8"},
+                  {"name": "losses", "value": 12, "displayValue": "12"},
+                  {"name": "gamesBehind", "value": 7, "displayValue": "7"}
+                ]
+              }
+            ]
+          }
+        }
+      ]
+    }
+  ]
+}`)
+
+	standings, err := parseStandings(dat)
+	require.NoError(t, err)
+	require.Len(t, standings, 2)
+
+	require.Equal(t, "NYI", standings[0].Abbreviation)
+	require.Equal(t, "East", standings[0].Group)
+	require.Equal(t, "Eastern Conference", standings[0].GroupName)
+	require.Equal(t, "15-5-2", standings[0].Record())
+	require.Equal(t, ".682", standings[0].Percent())
+	require.Equal(t, "W3", standings[0].Streak)
+
+	require.Equal(t, "Central Division", standings[1].Group)
+	require.Equal(t, "8-12", standings[1].Record())
+	require.Equal(t, "7", standings[1].GamesBehind)
+}
+
+func TestGroupStandingsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	teamsBody := []byte(`{"groups":[{"abbreviation":"AFC","children":[{"name":"AFC East","abbreviation":"East","teams":[{"id":"1","abbreviation":"BUF"},{"id":"2","abbreviation":"MIA"}]}]}]}`)
+
+	buf := &bytes.Buffer{}
+	rec := newRecorder(nopWriteCloser{buf})
+	for _, endpoint := range (&nfl{}).TeamEndpoints() {
+		require.NoError(t, rec.record("nfl", archiveKindTeams, endpoint, teamsBody))
+	}
+	replayer, err := NewReplayer(buf, 1)
+	require.NoError(t, err)
+
+	e := &ESPNBoard{
+		leaguer:         &nfl{},
+		log:             zap.NewNop(),
+		replayer:        replayer,
+		conferenceNames: make(map[string]struct{}),
+		rankSorted:      atomic.NewBool(false),
+		ranksSet:        atomic.NewBool(false),
+	}
+
+	// Standings are grouped while the scores path updates teams
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := e.GetTeams(context.Background())
+			require.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			standings := []*standingsboard.Standing{{TeamID: "1"}, {TeamID: "3"}}
+			require.NoError(t, e.groupStandings(context.Background(), standings))
+			require.Equal(t, "AFC_East", standings[0].Group)
+			require.Equal(t, "AFC East", standings[0].GroupName)
+			require.Empty(t, standings[1].Group)
+		}()
+	}
+	wg.Wait()
+}
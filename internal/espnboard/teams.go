@@ -93,12 +93,16 @@ type teamDetails struct {
 
 // GetTeams reads team data sourced via http://site.api.espn.com/apis/site/v2/sports/football/nfl/groups
 func (e *ESPNBoard) getTeams(ctx context.Context) ([]*Team, error) {
-	if len(e.teams) > 1 {
+	e.Lock()
+	cached := e.teams
+	e.Unlock()
+
+	if len(cached) > 1 {
 		e.log.Debug("returning cached ESPN teams",
-			zap.Int("num teams", len(e.teams)),
+			zap.Int("num teams", len(cached)),
 			zap.String("league", e.leaguer.League()),
 		)
-		return e.teams, nil
+		return cached, nil
 	}
 
 	teams, err := e.teamsFromAPI(ctx)
@@ -119,7 +123,7 @@ func (e *ESPNBoard) teamsFromAPI(ctx context.Context) ([]*Team, error) {
 	)
 	teams := []*Team{}
 	for _, endpoint := range e.leaguer.TeamEndpoints() {
-		dat, err := pullTeams(ctx, endpoint)
+		dat, err := e.teamData(ctx, endpoint)
 		if err != nil {
 			return nil, err
 		}
@@ -133,6 +137,30 @@ func (e *ESPNBoard) teamsFromAPI(ctx context.Context) ([]*Team, error) {
 	return teams, nil
 }
 
+// teamData pulls a team endpoint from the API, or from the archive when replaying
+func (e *ESPNBoard) teamData(ctx context.Context, endpoint string) ([]byte, error) {
+	if e.replayer != nil {
+		return e.replayer.latest(e.leaguer.HTTPPathPrefix(), archiveKindTeams, endpoint)
+	}
+
+	dat, err := pullTeams(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.recorder != nil {
+		if err := e.recorder.record(e.leaguer.HTTPPathPrefix(), archiveKindTeams, endpoint, dat); err != nil {
+			e.log.Error("failed to record teams",
+				zap.Error(err),
+				zap.String("endpoint", endpoint),
+				zap.String("league", e.League()),
+			)
+		}
+	}
+
+	return dat, nil
+}
+
 func (e *ESPNBoard) teamsFromAssests() ([]*Team, error) {
 	assetFiles := []string{
 		fmt.Sprintf("%s_groups.json", e.leaguer.HTTPPathPrefix()),
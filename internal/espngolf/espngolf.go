@@ -0,0 +1,274 @@
+package espngolf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	golfboard "github.com/robbydyer/sports/internal/board/golf"
+)
+
+const leaderboardURL = "https://site.web.api.espn.com/apis/site/v2/sports/golf/leaderboard"
+
+// API ...
+type API struct {
+	leaguer Leaguer
+	log     *zap.Logger
+}
+
+// Leaguer ...
+type Leaguer interface {
+	League() string
+	HTTPPathPrefix() string
+	// Slug is the league query parameter of the ESPN leaderboard API, ie. "pga"
+	Slug() string
+}
+
+type leaderboardDat struct {
+	Events []*struct {
+		Name       string `json:"name"`
+		ShortName  string `json:"shortName"`
+		Tournament *struct {
+			CutRound int      `json:"cutRound"`
+			CutScore *float64 `json:"cutScore"`
+		} `json:"tournament"`
+		Competitions []*struct {
+			Status *struct {
+				Period int `json:"period"`
+				Type   *struct {
+					Detail string `json:"detail"`
+				} `json:"type"`
+			} `json:"status"`
+			Competitors []*competitor `json:"competitors"`
+		} `json:"competitions"`
+	} `json:"events"`
+}
+
+type competitor struct {
+	ID      string `json:"id"`
+	Athlete *struct {
+		DisplayName string `json:"displayName"`
+	} `json:"athlete"`
+	Status *struct {
+		Period   int    `json:"period"`
+		Thru     int    `json:"thru"`
+		TeeTime  string `json:"teeTime"`
+		Position *struct {
+			DisplayName string `json:"displayName"`
+		} `json:"position"`
+		Type *struct {
+			Name string `json:"name"`
+		} `json:"type"`
+	} `json:"status"`
+	Score *struct {
+		DisplayValue string `json:"displayValue"`
+	} `json:"score"`
+	SortOrder  int `json:"sortOrder"`
+	Statistics []*struct {
+		Name         string `json:"name"`
+		DisplayValue string `json:"displayValue"`
+	} `json:"statistics"`
+	Linescores []*struct {
+		Period       int    `json:"period"`
+		DisplayValue string `json:"displayValue"`
+		Linescores   []*struct {
+			Period    int     `json:"period"`
+			Value     float64 `json:"value"`
+			ScoreType *struct {
+				DisplayValue string `json:"displayValue"`
+			} `json:"scoreType"`
+		} `json:"linescores"`
+	} `json:"linescores"`
+}
+
+// New ...
+func New(leaguer Leaguer, log *zap.Logger) (*API, error) {
+	return &API{
+		leaguer: leaguer,
+		log:     log,
+	}, nil
+}
+
+// League ...
+func (a *API) League() string {
+	return a.leaguer.League()
+}
+
+// HTTPPathPrefix ...
+func (a *API) HTTPPathPrefix() string {
+	return a.leaguer.HTTPPathPrefix()
+}
+
+// GetLeaderboard ...
+func (a *API) GetLeaderboard(ctx context.Context) (*golfboard.Leaderboard, error) {
+	dat, err := a.leaderboardFromAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return leaderboardFromDat(dat)
+}
+
+func leaderboardFromDat(dat *leaderboardDat) (*golfboard.Leaderboard, error) {
+	if len(dat.Events) < 1 || len(dat.Events[0].Competitions) < 1 {
+		return nil, fmt.Errorf("no current golf tournament")
+	}
+
+	event := dat.Events[0]
+	comp := event.Competitions[0]
+
+	l := &golfboard.Leaderboard{
+		Tournament: event.ShortName,
+	}
+	if l.Tournament == "" {
+		l.Tournament = event.Name
+	}
+	if comp.Status != nil {
+		l.Round = comp.Status.Period
+		if comp.Status.Type != nil {
+			l.RoundDetail = comp.Status.Type.Detail
+		}
+	}
+	if t := event.Tournament; t != nil && t.CutScore != nil && t.CutRound > 0 && l.Round <= t.CutRound {
+		cut := int(*t.CutScore)
+		l.CutScore = &cut
+	}
+
+	competitors := comp.Competitors
+	sort.SliceStable(competitors, func(i, j int) bool {
+		if competitors[i].SortOrder < 1 || competitors[j].SortOrder < 1 {
+			return competitors[j].SortOrder < 1 && competitors[i].SortOrder > 0
+		}
+		return competitors[i].SortOrder < competitors[j].SortOrder
+	})
+
+	for _, c := range competitors {
+		l.Players = append(l.Players, c.player())
+	}
+
+	return l, nil
+}
+
+func (c *competitor) player() *golfboard.Player {
+	p := &golfboard.Player{
+		ID: c.ID,
+	}
+	if c.Athlete != nil {
+		p.Name = c.Athlete.DisplayName
+	}
+
+	if c.Score != nil {
+		p.Score = c.Score.DisplayValue
+	}
+	for _, stat := range c.Statistics {
+		if stat.Name == "scoreToPar" && stat.DisplayValue != "" {
+			p.Score = stat.DisplayValue
+		}
+	}
+
+	round := 0
+	if c.Status != nil {
+		round = c.Status.Period
+		if c.Status.Position != nil {
+			p.Position = c.Status.Position.DisplayName
+		}
+		if c.Status.Type != nil {
+			p.Cut = isCut(c.Status.Type.Name)
+		}
+		p.Thru = thru(c.Status.Thru, c.Status.TeeTime)
+	}
+	if isCut(p.Position) {
+		p.Cut = true
+	}
+	if p.Cut {
+		p.Thru = "MC"
+	}
+
+	for _, l := range c.Linescores {
+		if l.Period != round {
+			continue
+		}
+		p.Today = l.DisplayValue
+		for _, h := range l.Linescores {
+			hole := &golfboard.Hole{
+				Number:  h.Period,
+				Strokes: int(h.Value),
+			}
+			if h.ScoreType != nil {
+				if toPar, err := golfboard.ScoreToInt(h.ScoreType.DisplayValue); err == nil {
+					hole.ToPar = toPar
+				}
+			}
+			p.Holes = append(p.Holes, hole)
+		}
+	}
+
+	return p
+}
+
+// thru is "F" for a finished round, the number of holes played, or the player's tee time
+func thru(holes int, teeTime string) string {
+	switch {
+	case holes >= 18:
+		return "F"
+	case holes > 0:
+		return fmt.Sprint(holes)
+	}
+
+	t, err := time.Parse("2006-01-02T15:04Z", teeTime)
+	if err != nil {
+		return "-"
+	}
+	return t.Local().Format("3:04")
+}
+
+func isCut(status string) bool {
+	status = strings.ToUpper(status)
+	for _, s := range []string{"CUT", "WD", "DQ", "MC", "WITHDRAWN", "DISQUALIFIED"} {
+		if status == s || strings.HasSuffix(status, "_"+s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *API) leaderboardFromAPI(ctx context.Context) (*leaderboardDat, error) {
+	v := url.Values{}
+	v.Set("league", a.leaguer.Slug())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s?%s", leaderboardURL, v.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s leaderboard: %w", a.leaguer.League(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s leaderboard: http status %s", a.leaguer.League(), resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var dat *leaderboardDat
+	if err := json.Unmarshal(body, &dat); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s leaderboard: %w", a.leaguer.League(), err)
+	}
+
+	return dat, nil
+}
@@ -0,0 +1,102 @@
+package espngolf
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaderboardFromDat(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{
+  "events": [
+    {
+      "name": "The Memorial Tournament presented by Workday",
+      "shortName": "The Memorial",
+      "tournament": {"cutRound": 2, "cutScore": 1},
+      "competitions": [
+        {
+          "status": {"period": 2, "type": {"detail": "Round 2 - In Progress"}},
+          "competitors": [
+            {
+              "id": "2",
+              "athlete": {"displayName": "Viktor Hovland"},
+              "status": {"period": 2, "thru": 18, "position": {"displayName": "T2"}},
+              "sortOrder": 2,
+              "statistics": [{"name": "scoreToPar", "displayValue": "-5"}],
+              "linescores": [
+                {"period": 1, "displayValue": "-3"},
+                {"period": 2, "displayValue": "-2"}
+              ]
+            },
+            {
+              "id": "1",
+              "athlete": {"displayName": "Scottie Scheffler"},
+              "status": {"period": 2, "thru": 2, "position": {"displayName": "1"}},
+              "sortOrder": 1,
+              "score": {"displayValue": "-8"},
+              "linescores": [
+                {"period": 1, "displayValue": "-7"},
+                {
+                  "period": 2,
+                  "displayValue": "-1",
+                  "linescores": [
+                    {"period": 1, "value": 4, "scoreType": {"displayValue": "E"}},
+                    {"period": 2, "value": 2, "scoreType": {"displayValue": "-1"}}
+                  ]
+                }
+              ]
+            },
+            {
+              "id": "3",
+              "athlete": {"displayName": "Tony Finau"},
+              "status": {"period": 2, "position": {"displayName": "WD"}, "type": {"name": "STATUS_WITHDRAWN"}},
+              "sortOrder": 3,
+              "score": {"displayValue": "+4"}
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`)
+
+	var dat *leaderboardDat
+	require.NoError(t, json.Unmarshal(body, &dat))
+
+	l, err := leaderboardFromDat(dat)
+	require.NoError(t, err)
+
+	require.Equal(t, "The Memorial", l.Tournament)
+	require.Equal(t, 2, l.Round)
+	require.NotNil(t, l.CutScore)
+	require.Equal(t, 1, *l.CutScore)
+	require.Len(t, l.Players, 3)
+
+	leader := l.Players[0]
+	require.Equal(t, "Scottie Scheffler", leader.Name)
+	require.Equal(t, "-8", leader.Score)
+	require.Equal(t, "-1", leader.Today)
+	require.Equal(t, "2", leader.Thru)
+	require.Len(t, leader.Holes, 2)
+	require.Equal(t, 2, leader.Holes[1].Strokes)
+	require.Equal(t, -1, leader.Holes[1].ToPar)
+
+	require.Equal(t, "-5", l.Players[1].Score)
+	require.Equal(t, "F", l.Players[1].Thru)
+
+	require.True(t, l.Players[2].Cut)
+	require.Equal(t, "MC", l.Players[2].Thru)
+}
+
+func TestIsCut(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isCut("CUT"))
+	require.True(t, isCut("STATUS_CUT"))
+	require.True(t, isCut("wd"))
+	require.False(t, isCut("T12"))
+	require.False(t, isCut("STATUS_PLAY_COMPLETE"))
+}
@@ -0,0 +1,73 @@
+package espngolf
+
+// PGA ...
+type PGA struct{}
+
+// League ...
+func (a *PGA) League() string {
+	return "PGA"
+}
+
+// HTTPPathPrefix ...
+func (a *PGA) HTTPPathPrefix() string {
+	return "pga"
+}
+
+// Slug ...
+func (a *PGA) Slug() string {
+	return "pga"
+}
+
+// LPGA ...
+type LPGA struct{}
+
+// League ...
+func (a *LPGA) League() string {
+	return "LPGA"
+}
+
+// HTTPPathPrefix ...
+func (a *LPGA) HTTPPathPrefix() string {
+	return "lpga"
+}
+
+// Slug ...
+func (a *LPGA) Slug() string {
+	return "lpga"
+}
+
+// DPWorld is the DP World Tour, formerly the European Tour
+type DPWorld struct{}
+
+// League ...
+func (a *DPWorld) League() string {
+	return "DP World"
+}
+
+// HTTPPathPrefix ...
+func (a *DPWorld) HTTPPathPrefix() string {
+	return "dpworld"
+}
+
+// Slug ...
+func (a *DPWorld) Slug() string {
+	return "eur"
+}
+
+// Champions is the PGA Tour Champions
+type Champions struct{}
+
+// League ...
+func (a *Champions) League() string {
+	return "Champions"
+}
+
+// HTTPPathPrefix ...
+func (a *Champions) HTTPPathPrefix() string {
+	return "champions"
+}
+
+// Slug ...
+func (a *Champions) Slug() string {
+	return "champions-tour"
+}
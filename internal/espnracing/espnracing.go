@@ -6,6 +6,8 @@ import (
 	"embed"
 	"fmt"
 	"image"
+	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 
@@ -22,12 +24,16 @@ const cacheDir = "/tmp/sportsmatrix/racing"
 
 // API ...
 type API struct {
-	myLogo   *image.Image
-	leaguer  Leaguer
-	schedule *Scoreboard
-	log      *zap.Logger
+	myLogo         *image.Image
+	leaguer        Leaguer
+	schedule       *Scoreboard
+	log            *zap.Logger
+	mockScoreboard []byte
 }
 
+// Option is an option for the racing API
+type Option func(a *API) error
+
 // Leaguer ...
 type Leaguer interface {
 	ShortName() string
@@ -38,11 +44,32 @@ type Leaguer interface {
 }
 
 // New ...
-func New(leaguer Leaguer, log *zap.Logger) (*API, error) {
-	return &API{
+func New(leaguer Leaguer, log *zap.Logger, opts ...Option) (*API, error) {
+	a := &API{
 		leaguer: leaguer,
 		log:     log,
-	}, nil
+	}
+
+	for _, f := range opts {
+		if err := f(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+// WithMockScoreboard serves the scoreboard from the given bundled mock fixture, ie. "f1_live.json",
+// instead of the ESPN API
+func WithMockScoreboard(fixture string) Option {
+	return func(a *API) error {
+		dat, err := assets.ReadFile(filepath.Join("assets", "mock", fixture))
+		if err != nil {
+			return fmt.Errorf("failed to read racing mock fixture %s: %w", fixture, err)
+		}
+		a.mockScoreboard = dat
+		return nil
+	}
 }
 
 // GetLogo ...
@@ -89,11 +116,68 @@ func (a *API) logoSourceGetter(ctx context.Context) (image.Image, error) {
 		return imaging.Open(cacheFile)
 	}
 
-	b, err := assets.ReadFile(filepath.Join("assets", a.leaguer.LogoAsset()))
+	if asset := a.leaguer.LogoAsset(); asset != "" {
+		b, err := assets.ReadFile(filepath.Join("assets", asset))
+		if err != nil {
+			return nil, err
+		}
+
+		reader := bytes.NewReader(b)
+		return imaging.Decode(reader)
+	}
+
+	b, err := a.downloadLogo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s logo: %w", a.leaguer.ShortName(), err)
+	}
+
+	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+		a.log.Error("failed to create logo cache dir", zap.Error(err))
+	} else if err := os.WriteFile(cacheFile, b, 0o644); err != nil {
+		a.log.Error("failed to cache logo", zap.Error(err))
+	}
+
+	return imaging.Decode(bytes.NewReader(b))
+}
+
+// downloadLogo gets the league's logo from its LogoSourceURL, or the logo of the league in the ESPN scoreboard
+func (a *API) downloadLogo(ctx context.Context) ([]byte, error) {
+	src := a.leaguer.LogoSourceURL()
+	if src == "" {
+		sched, err := a.scheduledEventsFromAPI(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range sched.Leagues {
+			for _, lg := range l.Logos {
+				if lg.Href != "" {
+					src = lg.Href
+					break
+				}
+			}
+			if src != "" {
+				break
+			}
+		}
+	}
+	if src == "" {
+		return nil, fmt.Errorf("no logo source")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
 
-	reader := bytes.NewReader(b)
-	return imaging.Decode(reader)
+	return io.ReadAll(resp.Body)
 }
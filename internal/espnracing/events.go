@@ -20,7 +20,10 @@ type Scoreboard struct {
 		ID           string `json:"id"`
 		Abbreviation string `json:"abbreviation"`
 		Slug         string `json:"slug"`
-		Season       *struct {
+		Logos        []*struct {
+			Href string `json:"href"`
+		} `json:"logos"`
+		Season *struct {
 			Year      int    `json:"year"`
 			StartDate string `json:"startDate"`
 			EndDate   string `json:"endDate"`
@@ -42,22 +45,64 @@ type Scoreboard struct {
 			Completed    bool   `json:"completed"`
 			DisplayClock string `json:"displayClock"`
 		} `json:"status"`
-		Competitions []*struct {
-			ID   string `json:"id"`
-			Type *struct {
-				ID           string `json:"id"`
-				Abbreviation string `json:"abbreviation"`
-			} `json:"type"`
-			Status *struct {
-				Name         string `json:"name"`
-				State        string `json:"state"`
-				Completed    bool   `json:"completed"`
-				DisplayClock string `json:"displayClock"`
-			} `json:"status"`
-		}
+		Competitions []*Competition `json:"competitions"`
 	} `json:"events"`
 }
 
+// Competition is a session of an event
+type Competition struct {
+	ID   string `json:"id"`
+	Date string `json:"date"`
+	Laps int    `json:"laps"`
+	Type *struct {
+		ID           string `json:"id"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"type"`
+	Status *struct {
+		Name         string `json:"name"`
+		State        string `json:"state"`
+		Completed    bool   `json:"completed"`
+		DisplayClock string `json:"displayClock"`
+		Period       int    `json:"period"`
+		Type         *struct {
+			State     string `json:"state"`
+			Completed bool   `json:"completed"`
+		} `json:"type"`
+	} `json:"status"`
+	Competitors []*Competitor `json:"competitors"`
+	// Stages are only set for leagues with stage racing, such as NASCAR
+	Stages []*struct {
+		Number      int           `json:"number"`
+		EndLap      int           `json:"endLap"`
+		Completed   bool          `json:"completed"`
+		Competitors []*Competitor `json:"competitors"`
+	} `json:"stages"`
+}
+
+// Competitor is a driver in a Competition
+type Competitor struct {
+	ID      string `json:"id"`
+	Order   int    `json:"order"`
+	Athlete *struct {
+		DisplayName  string `json:"displayName"`
+		ShortName    string `json:"shortName"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"athlete"`
+	Vehicle *struct {
+		Number       string `json:"number"`
+		Manufacturer string `json:"manufacturer"`
+		Team         string `json:"team"`
+	} `json:"vehicle"`
+	Team *struct {
+		DisplayName string `json:"displayName"`
+		Color       string `json:"color"`
+	} `json:"team"`
+	Statistics []*struct {
+		Name         string `json:"name"`
+		DisplayValue string `json:"displayValue"`
+	} `json:"statistics"`
+}
+
 // GetScheduledEvents ...
 func (a *API) GetScheduledEvents(ctx context.Context) ([]*racingboard.Event, error) {
 	if a.schedule != nil {
@@ -97,6 +142,14 @@ func (a *API) eventsFromSchedule(sched *Scoreboard) ([]*racingboard.Event, error
 }
 
 func (a *API) scheduledEventsFromAPI(ctx context.Context) (*Scoreboard, error) {
+	if a.mockScoreboard != nil {
+		var sched *Scoreboard
+		if err := json.Unmarshal(a.mockScoreboard, &sched); err != nil {
+			return nil, err
+		}
+		return sched, nil
+	}
+
 	uri, err := url.Parse(fmt.Sprintf("%s/%s/scoreboard", baseURL, a.leaguer.APIPath()))
 	if err != nil {
 		return nil, err
@@ -55,3 +55,87 @@ func (a *IRL) APIPath() string {
 func (a *IRL) LogoAsset() string {
 	return "irl.png"
 }
+
+// NASCAR is the NASCAR Cup Series
+type NASCAR struct{}
+
+// ShortName ...
+func (a *NASCAR) ShortName() string {
+	return "NASCAR"
+}
+
+// LogoSourceURL ...
+func (a *NASCAR) LogoSourceURL() string {
+	return ""
+}
+
+// HTTPPathPrefix ...
+func (a *NASCAR) HTTPPathPrefix() string {
+	return "nascar"
+}
+
+// APIPath ...
+func (a *NASCAR) APIPath() string {
+	return "racing/nascar-premier"
+}
+
+// LogoAsset is empty, so the logo is downloaded from the ESPN scoreboard
+func (a *NASCAR) LogoAsset() string {
+	return ""
+}
+
+// Xfinity is the NASCAR Xfinity Series
+type Xfinity struct{}
+
+// ShortName ...
+func (a *Xfinity) ShortName() string {
+	return "Xfinity"
+}
+
+// LogoSourceURL ...
+func (a *Xfinity) LogoSourceURL() string {
+	return ""
+}
+
+// HTTPPathPrefix ...
+func (a *Xfinity) HTTPPathPrefix() string {
+	return "xfinity"
+}
+
+// APIPath ...
+func (a *Xfinity) APIPath() string {
+	return "racing/nascar-secondary"
+}
+
+// LogoAsset is empty, so the logo is downloaded from the ESPN scoreboard
+func (a *Xfinity) LogoAsset() string {
+	return ""
+}
+
+// MotoGP ...
+type MotoGP struct{}
+
+// ShortName ...
+func (a *MotoGP) ShortName() string {
+	return "MotoGP"
+}
+
+// LogoSourceURL ...
+func (a *MotoGP) LogoSourceURL() string {
+	return ""
+}
+
+// HTTPPathPrefix ...
+func (a *MotoGP) HTTPPathPrefix() string {
+	return "motogp"
+}
+
+// APIPath ...
+func (a *MotoGP) APIPath() string {
+	return "racing/motogp"
+}
+
+// LogoAsset is empty, so the logo is downloaded from the ESPN scoreboard
+func (a *MotoGP) LogoAsset() string {
+	return ""
+}
@@ -0,0 +1,237 @@
+package espnracing
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	racingboard "github.com/robbydyer/sports/internal/board/racing"
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// teamColors are used for teams the API doesn't give a color for
+var teamColors = map[string]color.Color{
+	"red bull":      color.RGBA{R: 54, G: 113, B: 198, A: 255},
+	"ferrari":       color.RGBA{R: 232, G: 0, B: 45, A: 255},
+	"mercedes":      color.RGBA{R: 39, G: 244, B: 210, A: 255},
+	"mclaren":       color.RGBA{R: 255, G: 128, B: 0, A: 255},
+	"aston martin":  color.RGBA{R: 34, G: 153, B: 113, A: 255},
+	"alpine":        color.RGBA{R: 255, G: 135, B: 188, A: 255},
+	"williams":      color.RGBA{R: 100, G: 196, B: 255, A: 255},
+	"racing bulls":  color.RGBA{R: 102, G: 146, B: 255, A: 255},
+	"visa cash app": color.RGBA{R: 102, G: 146, B: 255, A: 255},
+	"alphatauri":    color.RGBA{R: 102, G: 146, B: 255, A: 255},
+	"sauber":        color.RGBA{R: 82, G: 226, B: 82, A: 255},
+	"alfa romeo":    color.RGBA{R: 82, G: 226, B: 82, A: 255},
+	"haas":          color.RGBA{R: 182, G: 186, B: 189, A: 255},
+	"hendrick":      color.RGBA{R: 0, G: 87, B: 184, A: 255},
+	"gibbs":         color.RGBA{R: 0, G: 122, B: 61, A: 255},
+	"childress":     color.RGBA{R: 255, G: 200, B: 0, A: 255},
+	"trackhouse":    color.RGBA{R: 120, G: 40, B: 160, A: 255},
+	"ducati":        color.RGBA{R: 204, G: 0, B: 0, A: 255},
+	"aprilia":       color.RGBA{R: 50, G: 50, B: 50, A: 255},
+	"ktm":           color.RGBA{R: 255, G: 102, B: 0, A: 255},
+	"yamaha":        color.RGBA{R: 0, G: 51, B: 153, A: 255},
+	"honda":         color.RGBA{R: 225, G: 25, B: 35, A: 255},
+	"penske":        color.RGBA{R: 255, G: 221, B: 0, A: 255},
+	"ganassi":       color.RGBA{R: 200, G: 16, B: 46, A: 255},
+	"andretti":      color.RGBA{R: 0, G: 104, B: 180, A: 255},
+	"arrow mclaren": color.RGBA{R: 255, G: 128, B: 0, A: 255},
+}
+
+// GetSession returns the session in progress, or else the most recently completed session of
+// the current events
+func (a *API) GetSession(ctx context.Context) (*racingboard.Session, error) {
+	sched, err := a.scheduledEventsFromAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionFromScoreboard(sched)
+}
+
+func sessionFromScoreboard(sched *Scoreboard) (*racingboard.Session, error) {
+	if sched == nil {
+		return nil, nil
+	}
+
+	var latest *racingboard.Session
+	for _, e := range sched.Events {
+		for _, c := range e.Competitions {
+			state := c.state()
+			if state == racingboard.Upcoming {
+				continue
+			}
+
+			session, err := c.session(e.ShortName)
+			if err != nil {
+				return nil, err
+			}
+			if state == racingboard.Live {
+				return session, nil
+			}
+			if latest == nil || session.Date.After(latest.Date) {
+				latest = session
+			}
+		}
+	}
+
+	return latest, nil
+}
+
+func (c *Competition) state() racingboard.SessionState {
+	if c.Status == nil {
+		return racingboard.Upcoming
+	}
+
+	state := c.Status.State
+	completed := c.Status.Completed
+	if c.Status.Type != nil {
+		if c.Status.Type.State != "" {
+			state = c.Status.Type.State
+		}
+		completed = completed || c.Status.Type.Completed
+	}
+
+	switch {
+	case completed || state == "post":
+		return racingboard.Complete
+	case state == "in":
+		return racingboard.Live
+	}
+
+	return racingboard.Upcoming
+}
+
+func (c *Competition) session(event string) (*racingboard.Session, error) {
+	session := &racingboard.Session{
+		Event: event,
+		Name:  "Race",
+		State: c.state(),
+	}
+	if c.Type != nil && c.Type.Abbreviation != "" {
+		session.Name = c.Type.Abbreviation
+	}
+	if c.Date != "" {
+		d, err := time.Parse("2006-01-02T15:04Z", c.Date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse session date: %w", err)
+		}
+		session.Date = d
+	}
+	if c.Status != nil {
+		session.Lap = c.Status.Period
+	}
+	session.TotalLaps = c.Laps
+
+	for _, competitor := range c.Competitors {
+		session.Drivers = append(session.Drivers, competitor.driver())
+	}
+	sortDrivers(session.Drivers)
+
+	for _, st := range c.Stages {
+		stage := &racingboard.Stage{
+			Number: st.Number,
+			EndLap: st.EndLap,
+			// The API can lag behind the lap count in marking a stage complete
+			Complete: st.Completed || session.State == racingboard.Complete || (st.EndLap > 0 && session.Lap > st.EndLap),
+		}
+		for _, competitor := range st.Competitors {
+			stage.Drivers = append(stage.Drivers, competitor.driver())
+		}
+		sortDrivers(stage.Drivers)
+		session.Stages = append(session.Stages, stage)
+	}
+	sort.SliceStable(session.Stages, func(i, j int) bool {
+		return session.Stages[i].Number < session.Stages[j].Number
+	})
+
+	return session, nil
+}
+
+func sortDrivers(drivers []*racingboard.Driver) {
+	sort.SliceStable(drivers, func(i, j int) bool {
+		return drivers[i].Position < drivers[j].Position
+	})
+}
+
+func (c *Competitor) driver() *racingboard.Driver {
+	d := &racingboard.Driver{
+		Position: c.Order,
+	}
+	if c.Athlete != nil {
+		d.Name = c.Athlete.DisplayName
+		d.Abbreviation = c.Athlete.Abbreviation
+	}
+	if d.Abbreviation == "" {
+		d.Abbreviation = abbreviate(d.Name)
+	}
+
+	if c.Team != nil {
+		d.Team = c.Team.DisplayName
+		if c.Team.Color != "" {
+			if r, g, b, err := rgbrender.HexToRGB(c.Team.Color); err == nil {
+				d.TeamColor = color.RGBA{R: r, G: g, B: b, A: 255}
+			}
+		}
+	}
+	if d.Team == "" && c.Vehicle != nil {
+		d.Team = c.Vehicle.Team
+		if d.Team == "" {
+			d.Team = c.Vehicle.Manufacturer
+		}
+	}
+	if d.TeamColor == nil {
+		d.TeamColor = lookupTeamColor(d.Team)
+	}
+
+	stats := make(map[string]string, len(c.Statistics))
+	for _, s := range c.Statistics {
+		stats[s.Name] = s.DisplayValue
+	}
+	switch {
+	case stats["behindLaps"] != "" && stats["behindLaps"] != "0":
+		d.Gap = fmt.Sprintf("+%sL", stats["behindLaps"])
+	case stats["behindTime"] != "" && d.Position > 1:
+		d.Gap = stats["behindTime"]
+	default:
+		d.Gap = stats["totalTime"]
+	}
+	if laps, err := strconv.Atoi(stats["lapsCompleted"]); err == nil {
+		d.Laps = laps
+	}
+
+	return d
+}
+
+// abbreviate returns the first three letters of the driver's last name, ie. "VER"
+func abbreviate(name string) string {
+	parts := strings.Fields(name)
+	if len(parts) < 1 {
+		return ""
+	}
+	last := []rune(strings.ToUpper(parts[len(parts)-1]))
+	if len(last) > 3 {
+		last = last[0:3]
+	}
+	return string(last)
+}
+
+func lookupTeamColor(team string) color.Color {
+	team = strings.ToLower(team)
+	var match string
+	for name := range teamColors {
+		// Prefer the longest match, so "Arrow McLaren" isn't matched as "McLaren"
+		if strings.Contains(team, name) && len(name) > len(match) {
+			match = name
+		}
+	}
+	if match == "" {
+		return nil
+	}
+	return teamColors[match]
+}
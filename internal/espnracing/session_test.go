@@ -0,0 +1,106 @@
+package espnracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	racingboard "github.com/robbydyer/sports/internal/board/racing"
+)
+
+func TestGetSession(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		state   racingboard.SessionState
+		lap     int
+		gap     string
+	}{
+		{
+			fixture: "f1_live.json",
+			state:   racingboard.Live,
+			lap:     35,
+			gap:     "55:12.4",
+		},
+		{
+			fixture: "f1_results.json",
+			state:   racingboard.Complete,
+			lap:     57,
+			gap:     "1:31:44.7",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.fixture, func(t *testing.T) {
+			t.Parallel()
+			a, err := New(&F1{}, zap.NewNop(), WithMockScoreboard(test.fixture))
+			require.NoError(t, err)
+
+			session, err := a.GetSession(context.Background())
+			require.NoError(t, err)
+			require.NotNil(t, session)
+
+			require.Equal(t, "Bahrain GP", session.Event)
+			require.Equal(t, "Race", session.Name)
+			require.Equal(t, test.state, session.State)
+			require.Equal(t, test.lap, session.Lap)
+			require.Equal(t, 57, session.TotalLaps)
+			require.Len(t, session.Drivers, 10)
+
+			leader := session.Drivers[0]
+			require.Equal(t, 1, leader.Position)
+			require.Equal(t, "VER", leader.Abbreviation)
+			require.Equal(t, test.gap, leader.Gap)
+			require.NotNil(t, leader.TeamColor)
+
+			require.Equal(t, "+2.137", session.Drivers[1].Gap)
+			require.Equal(t, "+1L", session.Drivers[9].Gap)
+		})
+	}
+}
+
+func TestLookupTeamColor(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, teamColors["arrow mclaren"], lookupTeamColor("Arrow McLaren"))
+	require.Equal(t, teamColors["mclaren"], lookupTeamColor("McLaren"))
+	require.Nil(t, lookupTeamColor("Unknown Racing"))
+}
+
+func TestAbbreviate(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "VER", abbreviate("Max Verstappen"))
+	require.Equal(t, "ZHO", abbreviate("Zhou"))
+	require.Equal(t, "", abbreviate(""))
+}
+
+func TestGetSessionStages(t *testing.T) {
+	t.Parallel()
+
+	a, err := New(&NASCAR{}, zap.NewNop(), WithMockScoreboard("nascar_live.json"))
+	require.NoError(t, err)
+
+	session, err := a.GetSession(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, session)
+
+	require.Equal(t, racingboard.Live, session.State)
+	require.Equal(t, "LAR", session.Drivers[0].Abbreviation)
+	require.Equal(t, "Hendrick Motorsports", session.Drivers[0].Team)
+	require.Equal(t, teamColors["hendrick"], session.Drivers[0].TeamColor)
+
+	require.Len(t, session.Stages, 3)
+	require.Equal(t, 1, session.Stages[0].Number)
+	require.True(t, session.Stages[0].Complete)
+	require.Len(t, session.Stages[0].Drivers, 3)
+	require.Equal(t, "BYR", session.Stages[0].Drivers[0].Abbreviation)
+
+	stage := session.CurrentStage()
+	require.NotNil(t, stage)
+	require.Equal(t, 2, stage.Number)
+}
@@ -0,0 +1,240 @@
+package espntennis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	tennisboard "github.com/robbydyer/sports/internal/board/tennis"
+)
+
+const baseURL = "http://site.api.espn.com/apis/site/v2/sports"
+
+var roundNumber = regexp.MustCompile(`(?i)^(round|rd\.?)\s*(\d+)$`)
+
+// API ...
+type API struct {
+	leaguer Leaguer
+	log     *zap.Logger
+}
+
+// Leaguer ...
+type Leaguer interface {
+	League() string
+	HTTPPathPrefix() string
+	APIPath() string
+}
+
+// Scoreboard ...
+type Scoreboard struct {
+	Events []*struct {
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+		ShortName string `json:"shortName"`
+		Groupings []*struct {
+			Grouping *struct {
+				Slug        string `json:"slug"`
+				DisplayName string `json:"displayName"`
+			} `json:"grouping"`
+			Competitions []*competition `json:"competitions"`
+		} `json:"groupings"`
+	} `json:"events"`
+}
+
+type competition struct {
+	ID     string `json:"id"`
+	Date   string `json:"date"`
+	Status *struct {
+		Type *struct {
+			State       string `json:"state"`
+			Detail      string `json:"detail"`
+			ShortDetail string `json:"shortDetail"`
+		} `json:"type"`
+	} `json:"status"`
+	Round *struct {
+		DisplayName string `json:"displayName"`
+	} `json:"round"`
+	Competitors []*struct {
+		Order      int  `json:"order"`
+		Possession bool `json:"possession"`
+		Athlete    *struct {
+			DisplayName string `json:"displayName"`
+			ShortName   string `json:"shortName"`
+		} `json:"athlete"`
+		Linescores []*struct {
+			Value    float64 `json:"value"`
+			Tiebreak *int    `json:"tiebreak"`
+		} `json:"linescores"`
+	} `json:"competitors"`
+}
+
+// New ...
+func New(leaguer Leaguer, log *zap.Logger) (*API, error) {
+	return &API{
+		leaguer: leaguer,
+		log:     log,
+	}, nil
+}
+
+// League ...
+func (a *API) League() string {
+	return a.leaguer.League()
+}
+
+// HTTPPathPrefix ...
+func (a *API) HTTPPathPrefix() string {
+	return a.leaguer.HTTPPathPrefix()
+}
+
+// GetMatches ...
+func (a *API) GetMatches(ctx context.Context) ([]*tennisboard.Match, error) {
+	sb, err := a.scoreboardFromAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return matchesFromScoreboard(sb)
+}
+
+// matchesFromScoreboard returns the singles matches of each tournament in the scoreboard
+func matchesFromScoreboard(sb *Scoreboard) ([]*tennisboard.Match, error) {
+	var matches []*tennisboard.Match
+	for _, event := range sb.Events {
+		for _, grouping := range event.Groupings {
+			if grouping.Grouping == nil || !strings.Contains(strings.ToLower(grouping.Grouping.Slug), "singles") {
+				continue
+			}
+			for _, c := range grouping.Competitions {
+				m, err := c.match()
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse tennis match %s: %w", c.ID, err)
+				}
+				m.Tournament = event.ShortName
+				if m.Tournament == "" {
+					m.Tournament = event.Name
+				}
+				matches = append(matches, m)
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func (c *competition) match() (*tennisboard.Match, error) {
+	m := &tennisboard.Match{
+		ID: c.ID,
+	}
+
+	if c.Date != "" {
+		start, err := time.Parse("2006-01-02T15:04Z", c.Date)
+		if err != nil {
+			return nil, err
+		}
+		m.Start = start
+	}
+
+	if c.Round != nil {
+		m.Round = roundAbbrev(c.Round.DisplayName)
+	}
+
+	if c.Status != nil && c.Status.Type != nil {
+		switch c.Status.Type.State {
+		case "in":
+			m.State = tennisboard.Live
+		case "post":
+			m.State = tennisboard.Final
+		}
+		m.Detail = c.Status.Type.ShortDetail
+		if m.Detail == "" {
+			m.Detail = c.Status.Type.Detail
+		}
+	}
+
+	for i, competitor := range c.Competitors {
+		if i > 1 {
+			break
+		}
+		p := &tennisboard.Player{
+			Serving: competitor.Possession,
+		}
+		if competitor.Athlete != nil {
+			p.Name = competitor.Athlete.DisplayName
+			p.ShortName = competitor.Athlete.ShortName
+		}
+		for _, l := range competitor.Linescores {
+			p.Sets = append(p.Sets, &tennisboard.Set{
+				Games:    int(l.Value),
+				Tiebreak: l.Tiebreak,
+			})
+		}
+
+		idx := i
+		if competitor.Order == 1 || competitor.Order == 2 {
+			idx = competitor.Order - 1
+		}
+		m.Players[idx] = p
+	}
+
+	return m, nil
+}
+
+// roundAbbrev shortens round names to fit the header of a match, ie. "Quarterfinal" to "QF"
+func roundAbbrev(round string) string {
+	r := strings.ToLower(strings.TrimSpace(round))
+	switch {
+	case strings.HasPrefix(r, "quarterfinal"):
+		return "QF"
+	case strings.HasPrefix(r, "semifinal"):
+		return "SF"
+	case r == "final" || r == "finals":
+		return "F"
+	case strings.HasPrefix(r, "round of "):
+		return fmt.Sprintf("R%s", strings.TrimPrefix(r, "round of "))
+	}
+
+	if match := roundNumber.FindStringSubmatch(round); len(match) == 3 {
+		return fmt.Sprintf("R%s", match[2])
+	}
+
+	return round
+}
+
+func (a *API) scoreboardFromAPI(ctx context.Context) (*Scoreboard, error) {
+	uri := fmt.Sprintf("%s/%s/scoreboard", baseURL, a.leaguer.APIPath())
+
+	req, err := http.NewRequest("GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s scoreboard: %w", a.leaguer.League(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s scoreboard: http status %s", a.leaguer.League(), resp.Status)
+	}
+
+	dat, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb *Scoreboard
+	if err := json.Unmarshal(dat, &sb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s scoreboard: %w", a.leaguer.League(), err)
+	}
+
+	return sb, nil
+}
@@ -0,0 +1,114 @@
+package espntennis
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tennisboard "github.com/robbydyer/sports/internal/board/tennis"
+)
+
+func TestMatchesFromScoreboard(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{
+  "events": [
+    {
+      "id": "1",
+      "name": "Wimbledon",
+      "groupings": [
+        {
+          "grouping": {"slug": "mens-doubles"},
+          "competitions": [{"id": "9", "date": "2023-07-14T12:00Z"}]
+        },
+        {
+          "grouping": {"slug": "mens-singles"},
+          "competitions": [
+            {
+              "id": "10",
+              "date": "2023-07-14T12:00Z",
+              "status": {"type": {"state": "in", "shortDetail": "3rd Set"}},
+              "round": {"displayName": "Quarterfinal"},
+              "competitors": [
+                {
+                  "order": 2,
+                  "possession": true,
+                  "athlete": {"displayName": "Carlos Alcaraz", "shortName": "C. Alcaraz"},
+                  "linescores": [{"value": 6, "tiebreak": 5}, {"value": 6}, {"value": 2}]
+                },
+                {
+                  "order": 1,
+                  "athlete": {"displayName": "Novak Djokovic", "shortName": "N. Djokovic"},
+                  "linescores": [{"value": 7, "tiebreak": 7}, {"value": 3}, {"value": 1}]
+                }
+              ]
+            },
+            {
+              "id": "11",
+              "date": "2023-07-14T16:30Z",
+              "status": {"type": {"state": "pre", "shortDetail": "7/14 - 12:30 PM EDT"}},
+              "round": {"displayName": "Round 2"},
+              "competitors": [
+                {"order": 1, "athlete": {"displayName": "Jannik Sinner"}},
+                {"order": 2, "athlete": {"displayName": "Daniil Medvedev"}}
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`)
+
+	var sb *Scoreboard
+	require.NoError(t, json.Unmarshal(dat, &sb))
+
+	matches, err := matchesFromScoreboard(sb)
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+
+	live := matches[0]
+	require.Equal(t, "Wimbledon", live.Tournament)
+	require.Equal(t, "QF", live.Round)
+	require.Equal(t, tennisboard.Live, live.State)
+	require.Equal(t, "3rd Set", live.Detail)
+	require.Equal(t, "N. Djokovic", live.Players[0].ShortName)
+	require.False(t, live.Players[0].Serving)
+	require.True(t, live.Players[1].Serving)
+	require.Len(t, live.Players[0].Sets, 3)
+	require.Equal(t, 7, live.Players[0].Sets[0].Games)
+	require.Equal(t, 7, *live.Players[0].Sets[0].Tiebreak)
+	require.Equal(t, 5, *live.Players[1].Sets[0].Tiebreak)
+	require.Nil(t, live.Players[1].Sets[1].Tiebreak)
+
+	upcoming := matches[1]
+	require.Equal(t, tennisboard.Scheduled, upcoming.State)
+	require.Equal(t, "R2", upcoming.Round)
+	require.Equal(t, "Jannik Sinner", upcoming.Players[0].Name)
+	require.Equal(t, 16, upcoming.Start.Hour())
+}
+
+func TestRoundAbbrev(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		round string
+		want  string
+	}{
+		{round: "Quarterfinals", want: "QF"},
+		{round: "Semifinal", want: "SF"},
+		{round: "Final", want: "F"},
+		{round: "Round of 16", want: "R16"},
+		{round: "Round 1", want: "R1"},
+		{round: "Qualifying", want: "Qualifying"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.round, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, test.want, roundAbbrev(test.round))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package espntennis
+
+// ATP ...
+type ATP struct{}
+
+// League ...
+func (a *ATP) League() string {
+	return "ATP"
+}
+
+// HTTPPathPrefix ...
+func (a *ATP) HTTPPathPrefix() string {
+	return "atp"
+}
+
+// APIPath ...
+func (a *ATP) APIPath() string {
+	return "tennis/atp"
+}
+
+// WTA ...
+type WTA struct{}
+
+// League ...
+func (a *WTA) League() string {
+	return "WTA"
+}
+
+// HTTPPathPrefix ...
+func (a *WTA) HTTPPathPrefix() string {
+	return "wta"
+}
+
+// APIPath ...
+func (a *WTA) APIPath() string {
+	return "tennis/wta"
+}
@@ -0,0 +1,226 @@
+package finnhub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	stockboard "github.com/robbydyer/sports/internal/board/stocks"
+)
+
+const baseURL = "https://finnhub.io/api/v1"
+
+// API is a client of the Finnhub stock API. Finnhub's free tier only has realtime quotes, so
+// the intraday chart is built from the quotes pulled throughout the day.
+type API struct {
+	log      *zap.Logger
+	apiKey   string
+	mockData map[string][]byte
+	stocks   map[string]*stockboard.Stock
+	updated  map[string]time.Time
+	sync.Mutex
+}
+
+// Option is an option for the Finnhub API
+type Option func(a *API) error
+
+type quote struct {
+	Current       float64 `json:"c"`
+	ChangePct     float64 `json:"dp"`
+	PreviousClose float64 `json:"pc"`
+	Time          int64   `json:"t"`
+}
+
+// New ...
+func New(apiKey string, logger *zap.Logger, opts ...Option) (*API, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("must pass a Finnhub API key")
+	}
+
+	a := &API{
+		log:     logger,
+		apiKey:  apiKey,
+		stocks:  make(map[string]*stockboard.Stock),
+		updated: make(map[string]time.Time),
+	}
+
+	for _, f := range opts {
+		if err := f(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+// WithMockData serves quotes from the given data, keyed by symbol, instead of the Finnhub API
+func WithMockData(data map[string][]byte) Option {
+	return func(a *API) error {
+		a.mockData = data
+		return nil
+	}
+}
+
+// Provider ...
+func (a *API) Provider() string {
+	return "finnhub"
+}
+
+// Get returns the stocks of the given symbols. Quotes are pulled again once they are older than
+// the interval.
+func (a *API) Get(ctx context.Context, symbols []string, interval time.Duration) ([]*stockboard.Stock, error) {
+	stocks := []*stockboard.Stock{}
+
+	for _, sym := range symbols {
+		stock, err := a.getStock(ctx, strings.ToUpper(sym), interval)
+		if err != nil {
+			a.log.Error("error pulling stock info",
+				zap.Error(err),
+				zap.String("symbol", sym),
+			)
+			continue
+		}
+		stocks = append(stocks, stock)
+	}
+
+	return stocks, nil
+}
+
+func (a *API) getStock(ctx context.Context, symbol string, interval time.Duration) (*stockboard.Stock, error) {
+	a.Lock()
+	stock, ok := a.stocks[symbol]
+	updated := a.updated[symbol]
+	a.Unlock()
+
+	if ok && time.Since(updated) < interval {
+		return stock, nil
+	}
+
+	q, err := a.getQuote(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Lock()
+	defer a.Unlock()
+
+	quoteTime := time.Unix(q.Time, 0)
+
+	stock, ok = a.stocks[symbol]
+	if !ok || stock.OpenPrice != q.PreviousClose {
+		// A new previous close means a new trading day
+		stock = &stockboard.Stock{
+			Symbol: symbol,
+		}
+		a.stocks[symbol] = stock
+	}
+	stock.OpenPrice = q.PreviousClose
+	stock.Price = q.Current
+	stock.Change = q.ChangePct
+
+	if len(stock.Prices) < 1 || stock.Prices[len(stock.Prices)-1].Time.Before(quoteTime) {
+		stock.Prices = append(stock.Prices, &stockboard.Price{
+			Time:  quoteTime,
+			Price: q.Current,
+		})
+	}
+	a.updated[symbol] = time.Now()
+
+	return stock, nil
+}
+
+func (a *API) getQuote(ctx context.Context, symbol string) (*quote, error) {
+	var body []byte
+	if a.mockData != nil {
+		dat, ok := a.mockData[symbol]
+		if !ok {
+			return nil, fmt.Errorf("no mock quote for %s", symbol)
+		}
+		body = dat
+	} else {
+		uri, err := url.Parse(fmt.Sprintf("%s/quote", baseURL))
+		if err != nil {
+			return nil, err
+		}
+
+		v := uri.Query()
+		v.Set("symbol", symbol)
+		uri.RawQuery = v.Encode()
+
+		a.log.Debug("get stock quote from API",
+			zap.String("url", uri.String()),
+		)
+
+		req, err := http.NewRequestWithContext(ctx, "GET", uri.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Finnhub-Token", a.apiKey)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("finnhub API returned status %d", resp.StatusCode)
+		}
+
+		body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var q *quote
+	if err := json.Unmarshal(body, &q); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal quote: %w", err)
+	}
+
+	// Unknown symbols return an empty quote
+	if q == nil || q.Time == 0 {
+		return nil, fmt.Errorf("no quote found for %s", symbol)
+	}
+
+	return q, nil
+}
+
+// TradingOpen ...
+func (a *API) TradingOpen() (time.Time, error) {
+	return tradingTime(9, 30)
+}
+
+// TradingClose ...
+func (a *API) TradingClose() (time.Time, error) {
+	return tradingTime(16, 0)
+}
+
+// CacheClear makes the next Get pull new quotes. The prices pulled so far today are kept for
+// the chart.
+func (a *API) CacheClear() {
+	a.Lock()
+	defer a.Unlock()
+
+	for k := range a.updated {
+		delete(a.updated, k)
+	}
+}
+
+func tradingTime(hour int, min int) (time.Time, error) {
+	t := time.Now()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return t, err
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), hour, min, 0, 0, loc), nil
+}
@@ -0,0 +1,52 @@
+package finnhub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	data := map[string][]byte{
+		"AAPL": []byte(`{"c": 231.5, "d": 1.5, "dp": 0.6522, "h": 232, "l": 229, "o": 230, "pc": 230, "t": 1792166400}`),
+		"NOPE": []byte(`{"c": 0, "d": null, "dp": null, "h": 0, "l": 0, "o": 0, "pc": 0, "t": 0}`),
+	}
+
+	api, err := New("key", zap.NewNop(), WithMockData(data))
+	require.NoError(t, err)
+
+	stocks, err := api.Get(context.Background(), []string{"aapl", "NOPE"}, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, stocks, 1)
+	require.Equal(t, "AAPL", stocks[0].Symbol)
+	require.Equal(t, 230.0, stocks[0].OpenPrice)
+	require.Equal(t, 231.5, stocks[0].Price)
+	require.Equal(t, 0.6522, stocks[0].Change)
+	require.Len(t, stocks[0].Prices, 1)
+
+	// Quotes are cached until the interval passes
+	data["AAPL"] = []byte(`{"c": 233, "dp": 1.3043, "pc": 230, "t": 1792166700}`)
+	stocks, err = api.Get(context.Background(), []string{"AAPL"}, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 231.5, stocks[0].Price)
+
+	// New quotes are added to the day's chart
+	api.CacheClear()
+	stocks, err = api.Get(context.Background(), []string{"AAPL"}, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 233.0, stocks[0].Price)
+	require.Len(t, stocks[0].Prices, 2)
+
+	// A new previous close starts a new day
+	data["AAPL"] = []byte(`{"c": 234, "dp": 0.4292, "pc": 233, "t": 1792252800}`)
+	api.CacheClear()
+	stocks, err = api.Get(context.Background(), []string{"AAPL"}, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 233.0, stocks[0].OpenPrice)
+	require.Len(t, stocks[0].Prices, 1)
+}
@@ -0,0 +1,183 @@
+package matrix
+
+import (
+	"context"
+	"image/color"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// bufferedMatrix is the LED buffer, scroll playback and brightness shared by matrices that
+// draw whole frames to a local display
+type bufferedMatrix struct {
+	width       int
+	height      int
+	leds        []uint32
+	preload     [][]uint32
+	preloadLock sync.Mutex
+	frames      *Frames
+	brightness  *atomic.Int32
+	log         *zap.Logger
+	// draw displays a frame of LED colors, already adjusted for brightness
+	draw func(leds []uint32) error
+}
+
+func newBufferedMatrix(width int, height int, logger *zap.Logger, draw func(leds []uint32) error) *bufferedMatrix {
+	return &bufferedMatrix{
+		width:      width,
+		height:     height,
+		leds:       make([]uint32, width*height),
+		frames:     NewFrames(width, height),
+		brightness: atomic.NewInt32(100),
+		log:        logger,
+		draw:       draw,
+	}
+}
+
+// Geometry ...
+func (b *bufferedMatrix) Geometry() (int, int) {
+	return b.width, b.height
+}
+
+func (b *bufferedMatrix) position(x int, y int) int {
+	if x < 0 || x >= b.width {
+		return -1
+	}
+	return x + (y * b.width)
+}
+
+// At ...
+func (b *bufferedMatrix) At(x int, y int) color.Color {
+	position := b.position(x, y)
+	if position > len(b.leds)-1 || position < 0 {
+		return color.Black
+	}
+
+	return uint32ToColorGo(b.leds[position])
+}
+
+// Set ...
+func (b *bufferedMatrix) Set(x int, y int, clr color.Color) {
+	position := b.position(x, y)
+	if position > len(b.leds)-1 || position < 0 {
+		return
+	}
+
+	b.leds[position] = colorToUint32(clr)
+}
+
+// PreLoad ...
+func (b *bufferedMatrix) PreLoad(scene *MatrixScene) {
+	b.preloadLock.Lock()
+	defer b.preloadLock.Unlock()
+
+	prep := make([]uint32, b.width*b.height)
+	for _, pt := range scene.Points {
+		position := b.position(pt.X, pt.Y)
+		if position > len(prep)-1 || position < 0 {
+			continue
+		}
+		prep[position] = colorToUint32(pt.Color)
+	}
+
+	if len(b.preload) < scene.Index+1 {
+		newPreload := make([][]uint32, scene.Index+1)
+		copy(newPreload, b.preload)
+		b.preload = newPreload
+	}
+
+	b.preload[scene.Index] = prep
+}
+
+// ReversePreLoad ...
+func (b *bufferedMatrix) ReversePreLoad() {
+	b.preloadLock.Lock()
+	defer b.preloadLock.Unlock()
+
+	for i, j := 0, len(b.preload)-1; i < j; i, j = i+1, j-1 {
+		b.preload[i], b.preload[j] = b.preload[j], b.preload[i]
+	}
+}
+
+// Play draws the preloaded scenes, waiting the interval between each
+func (b *bufferedMatrix) Play(ctx context.Context, startInterval time.Duration, interval <-chan time.Duration) error {
+	b.preloadLock.Lock()
+	scenes := b.preload
+	b.preload = [][]uint32{}
+	b.preloadLock.Unlock()
+
+	waitInterval := startInterval
+	for _, leds := range scenes {
+		// An updated interval can be sent to the channel to change scroll speed
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case waitInterval = <-interval:
+		default:
+		}
+
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-time.After(waitInterval):
+		}
+
+		if err := WaitPlayGate(ctx); err != nil {
+			return err
+		}
+
+		if leds == nil {
+			leds = make([]uint32, b.width*b.height)
+		}
+		if err := b.render(leds); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Render ...
+func (b *bufferedMatrix) Render() error {
+	defer func() {
+		b.leds = make([]uint32, b.width*b.height)
+	}()
+
+	return b.render(b.leds)
+}
+
+// Frames ...
+func (b *bufferedMatrix) Frames() *Frames {
+	return b.frames
+}
+
+// SetBrightness dims the colors drawn, like the brightness of an LED matrix
+func (b *bufferedMatrix) SetBrightness(brightness int) {
+	if brightness < 0 {
+		brightness = 0
+	}
+	if brightness > 100 {
+		brightness = 100
+	}
+	b.brightness.Store(int32(brightness))
+}
+
+func (b *bufferedMatrix) render(leds []uint32) error {
+	b.frames.Publish(leds)
+
+	brightness := uint32(b.brightness.Load())
+	if brightness < 100 {
+		dimmed := make([]uint32, len(leds))
+		for i, led := range leds {
+			dimmed[i] = ((led>>16&0xff)*brightness/100)<<16 |
+				((led>>8&0xff)*brightness/100)<<8 |
+				(led&0xff)*brightness/100
+		}
+		leds = dimmed
+	}
+
+	return b.draw(leds)
+}
@@ -21,6 +21,7 @@ type ConsoleMatrix struct {
 	out         io.Writer
 	preload     [][]uint32
 	log         *zap.Logger
+	frames      *Frames
 	preloadLock sync.Mutex
 }
 
@@ -32,6 +33,7 @@ func NewConsoleMatrix(width int, height int, out io.Writer, logger *zap.Logger)
 		matrix: make([]uint32, (width * height)),
 		out:    out,
 		log:    logger,
+		frames: NewFrames(width, height),
 	}
 
 	c.Reset()
@@ -128,6 +130,10 @@ func (c *ConsoleMatrix) Play(ctx context.Context, startInterval time.Duration, i
 		case <-time.After(waitInterval):
 		}
 
+		if err := WaitPlayGate(ctx); err != nil {
+			return err
+		}
+
 		if err := c.render(leds); err != nil {
 			return err
 		}
@@ -141,7 +147,14 @@ func (c *ConsoleMatrix) Render() error {
 	return c.render(c.matrix)
 }
 
+// Frames ...
+func (c *ConsoleMatrix) Frames() *Frames {
+	return c.frames
+}
+
 func (c *ConsoleMatrix) render(leds []uint32) error {
+	c.frames.Publish(leds)
+
 	rendered := []string{
 		strings.Repeat("_ ", c.width+1),
 	}
@@ -0,0 +1,178 @@
+package matrix
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// fbSysfsDir is where the kernel describes the geometry of each framebuffer device
+var fbSysfsDir = "/sys/class/graphics"
+
+// FramebufferMatrix draws a matrix to a Linux framebuffer device, ie. /dev/fb0. Each LED is
+// scaled to the largest square that fits the screen, centered. DRM drivers expose their
+// display as a framebuffer device through fbdev emulation.
+type FramebufferMatrix struct {
+	*bufferedMatrix
+	device *os.File
+	// fbWidth and fbHeight are the size of the framebuffer in pixels
+	fbWidth  int
+	fbHeight int
+	// bytesPerPixel is 2 for RGB565, 3 for BGR or 4 for BGRX
+	bytesPerPixel int
+	stride        int
+	scale         int
+	offsetX       int
+	offsetY       int
+	row           []byte
+	lock          sync.Mutex
+}
+
+// NewFramebufferMatrix opens the framebuffer device, ie. /dev/fb0
+func NewFramebufferMatrix(width int, height int, device string, logger *zap.Logger) (*FramebufferMatrix, error) {
+	f := &FramebufferMatrix{}
+
+	if err := f.readGeometry(filepath.Join(fbSysfsDir, filepath.Base(device))); err != nil {
+		return nil, fmt.Errorf("failed to read geometry of framebuffer %s: %w", device, err)
+	}
+
+	f.scale = f.fbWidth / width
+	if s := f.fbHeight / height; s < f.scale {
+		f.scale = s
+	}
+	if f.scale < 1 {
+		return nil, fmt.Errorf("framebuffer %s is %dx%d, too small for a %dx%d matrix", device, f.fbWidth, f.fbHeight, width, height)
+	}
+	f.offsetX = (f.fbWidth - width*f.scale) / 2
+	f.offsetY = (f.fbHeight - height*f.scale) / 2
+	f.row = make([]byte, width*f.scale*f.bytesPerPixel)
+
+	var err error
+	f.device, err = os.OpenFile(device, os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open framebuffer: %w", err)
+	}
+
+	if err := f.clear(); err != nil {
+		f.device.Close()
+		return nil, err
+	}
+
+	logger.Info("initialized framebuffer matrix",
+		zap.String("device", device),
+		zap.Int("framebuffer width", f.fbWidth),
+		zap.Int("framebuffer height", f.fbHeight),
+		zap.Int("bits per pixel", f.bytesPerPixel*8),
+		zap.Int("scale", f.scale),
+	)
+
+	f.bufferedMatrix = newBufferedMatrix(width, height, logger, f.draw)
+
+	return f, nil
+}
+
+func (f *FramebufferMatrix) readGeometry(dir string) error {
+	read := func(name string) (string, error) {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+
+	size, err := read("virtual_size")
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Sscanf(size, "%d,%d", &f.fbWidth, &f.fbHeight); err != nil {
+		return fmt.Errorf("invalid virtual_size '%s': %w", size, err)
+	}
+
+	bpp, err := read("bits_per_pixel")
+	if err != nil {
+		return err
+	}
+	bits, err := strconv.Atoi(bpp)
+	if err != nil {
+		return fmt.Errorf("invalid bits_per_pixel '%s': %w", bpp, err)
+	}
+	switch bits {
+	case 16, 24, 32:
+		f.bytesPerPixel = bits / 8
+	default:
+		return fmt.Errorf("unsupported %d bits per pixel", bits)
+	}
+
+	stride, err := read("stride")
+	if err != nil {
+		return err
+	}
+	f.stride, err = strconv.Atoi(stride)
+	if err != nil {
+		return fmt.Errorf("invalid stride '%s': %w", stride, err)
+	}
+	if f.stride < f.fbWidth*f.bytesPerPixel {
+		f.stride = f.fbWidth * f.bytesPerPixel
+	}
+
+	return nil
+}
+
+func (f *FramebufferMatrix) clear() error {
+	if _, err := f.device.WriteAt(make([]byte, f.stride*f.fbHeight), 0); err != nil {
+		return fmt.Errorf("failed to clear framebuffer: %w", err)
+	}
+	return nil
+}
+
+func (f *FramebufferMatrix) draw(leds []uint32) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for y := 0; y < f.height; y++ {
+		for x := 0; x < f.width; x++ {
+			pixel := f.pixel(leds[y*f.width+x])
+			for s := 0; s < f.scale; s++ {
+				copy(f.row[(x*f.scale+s)*f.bytesPerPixel:], pixel)
+			}
+		}
+		for s := 0; s < f.scale; s++ {
+			offset := int64((f.offsetY+y*f.scale+s)*f.stride + f.offsetX*f.bytesPerPixel)
+			if _, err := f.device.WriteAt(f.row, offset); err != nil {
+				return fmt.Errorf("failed to write framebuffer: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pixel encodes a color in the framebuffer's pixel format
+func (f *FramebufferMatrix) pixel(led uint32) []byte {
+	r, g, b := byte(led>>16), byte(led>>8), byte(led)
+	switch f.bytesPerPixel {
+	case 2:
+		p := make([]byte, 2)
+		binary.LittleEndian.PutUint16(p, uint16(r>>3)<<11|uint16(g>>2)<<5|uint16(b>>3))
+		return p
+	case 3:
+		return []byte{b, g, r}
+	default:
+		return []byte{b, g, r, 0xff}
+	}
+}
+
+// Close blanks the screen and closes the device
+func (f *FramebufferMatrix) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	_ = f.clear()
+	return f.device.Close()
+}
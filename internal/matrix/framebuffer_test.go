@@ -0,0 +1,63 @@
+package matrix
+
+import (
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFramebufferMatrix(t *testing.T) {
+	dir := t.TempDir()
+	sysfs := filepath.Join(dir, "sys")
+	require.NoError(t, os.MkdirAll(filepath.Join(sysfs, "fb0"), 0o755))
+	for name, val := range map[string]string{
+		"virtual_size":   "6,4\n",
+		"bits_per_pixel": "32\n",
+		"stride":         "24\n",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(sysfs, "fb0", name), []byte(val), 0o644))
+	}
+	device := filepath.Join(dir, "fb0")
+	require.NoError(t, os.WriteFile(device, make([]byte, 24*4), 0o644))
+
+	fbSysfsDir = sysfs
+	defer func() {
+		fbSysfsDir = "/sys/class/graphics"
+	}()
+
+	_, err := NewFramebufferMatrix(8, 8, device, zap.NewNop())
+	require.Error(t, err)
+
+	// A 2x1 matrix is scaled 3x to fit the 6x4 framebuffer
+	m, err := NewFramebufferMatrix(2, 1, device, zap.NewNop())
+	require.NoError(t, err)
+	m.Set(0, 0, color.RGBA{255, 0, 0, 255})
+	m.Set(1, 0, color.RGBA{0, 0, 255, 255})
+	require.NoError(t, m.Render())
+
+	fb, err := os.ReadFile(device)
+	require.NoError(t, err)
+
+	red := []byte{0, 0, 255, 255}
+	blue := []byte{255, 0, 0, 255}
+	black := []byte{0, 0, 0, 0}
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 6; x++ {
+			want := black
+			if y < 3 {
+				want = blue
+				if x < 3 {
+					want = red
+				}
+			}
+			offset := y*24 + x*4
+			require.Equal(t, want, fb[offset:offset+4], "pixel %d,%d", x, y)
+		}
+	}
+
+	require.NoError(t, m.Close())
+}
@@ -0,0 +1,271 @@
+package matrix
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultStreamFPS = 30
+	maxStreamFPS     = 60
+	maxStreamScale   = 32
+	// defaultStreamWidth is the width in pixels a stream is scaled to if no scale is requested
+	defaultStreamWidth = 640
+	mjpegBoundary      = "sportsmatrixframe"
+)
+
+// FrameSource is implemented by matrices that broadcast the frames they display
+type FrameSource interface {
+	Frames() *Frames
+}
+
+// Frames broadcasts every frame a matrix displays, including scroll frames played
+// through Play, to any subscribers
+type Frames struct {
+	width     int
+	height    int
+	subs      map[chan *image.RGBA]struct{}
+	observers map[int]FrameObserver
+	nextID    int
+	last      *image.RGBA
+	// lastLEDs is the last frame while it hasn't been converted to an image
+	lastLEDs []uint32
+	sync.Mutex
+}
+
+// FrameObserver is called with every frame as it is displayed
+type FrameObserver func(frame *image.RGBA, at time.Time)
+
+// NewFrames ...
+func NewFrames(width int, height int) *Frames {
+	return &Frames{
+		width:     width,
+		height:    height,
+		subs:      make(map[chan *image.RGBA]struct{}),
+		observers: make(map[int]FrameObserver),
+	}
+}
+
+// Publish sends a frame of LED colors to subscribers. Subscribers that haven't received
+// the previous frame yet have it replaced by this one.
+func (f *Frames) Publish(leds []uint32) {
+	f.Lock()
+	defer f.Unlock()
+
+	if len(f.subs) == 0 && len(f.observers) == 0 {
+		// Nobody is watching, the last frame is converted when it's asked for
+		f.lastLEDs = append(f.lastLEDs[:0], leds...)
+		f.last = nil
+		return
+	}
+
+	img := f.toImage(leds)
+	f.last = img
+	f.lastLEDs = f.lastLEDs[:0]
+
+	now := time.Now()
+	for _, o := range f.observers {
+		o(img, now)
+	}
+
+	for ch := range f.subs {
+		select {
+		case ch <- img:
+		default:
+			// Drop the stale frame in favor of the new one
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- img:
+			default:
+			}
+		}
+	}
+}
+
+func (f *Frames) toImage(leds []uint32) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, f.width, f.height))
+	for i, led := range leds {
+		if i >= f.width*f.height {
+			break
+		}
+		img.SetRGBA(i%f.width, i/f.width, color.RGBA{
+			R: uint8(led >> 16),
+			G: uint8(led >> 8),
+			B: uint8(led),
+			A: 255,
+		})
+	}
+
+	return img
+}
+
+// lastFrame returns the last frame displayed. Frames must be locked.
+func (f *Frames) lastFrame() *image.RGBA {
+	if f.last == nil && len(f.lastLEDs) > 0 {
+		f.last = f.toImage(f.lastLEDs)
+		f.lastLEDs = f.lastLEDs[:0]
+	}
+
+	return f.last
+}
+
+// Subscribe returns a channel of frames, starting with the last frame displayed, and a func to unsubscribe
+func (f *Frames) Subscribe() (<-chan *image.RGBA, func()) {
+	ch := make(chan *image.RGBA, 1)
+
+	f.Lock()
+	f.subs[ch] = struct{}{}
+	if last := f.lastFrame(); last != nil {
+		ch <- last
+	}
+	f.Unlock()
+
+	return ch, func() {
+		f.Lock()
+		defer f.Unlock()
+		delete(f.subs, ch)
+	}
+}
+
+// Last returns the last frame displayed
+func (f *Frames) Last() *image.RGBA {
+	f.Lock()
+	defer f.Unlock()
+
+	return f.lastFrame()
+}
+
+// Observe calls the observer with every frame until the returned func is called. Unlike
+// subscribers, observers never miss a frame, so they must return quickly.
+func (f *Frames) Observe(o FrameObserver) func() {
+	f.Lock()
+	defer f.Unlock()
+
+	id := f.nextID
+	f.nextID++
+	f.observers[id] = o
+
+	return func() {
+		f.Lock()
+		defer f.Unlock()
+		delete(f.observers, id)
+	}
+}
+
+// ServeMJPEG streams frames as multipart MJPEG. The "fps" query param limits the frame rate,
+// and "scale" sets the integer scale factor frames are enlarged by.
+func (f *Frames) ServeMJPEG(w http.ResponseWriter, req *http.Request) {
+	fps := defaultStreamFPS
+	if v := req.URL.Query().Get("fps"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 1 {
+			http.Error(w, fmt.Sprintf("invalid fps '%s'", v), http.StatusBadRequest)
+			return
+		}
+		fps = i
+	}
+	if fps > maxStreamFPS {
+		fps = maxStreamFPS
+	}
+
+	scale := defaultStreamWidth / f.width
+	if v := req.URL.Query().Get("scale"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 1 {
+			http.Error(w, fmt.Sprintf("invalid scale '%s'", v), http.StatusBadRequest)
+			return
+		}
+		scale = i
+	}
+	if scale < 1 {
+		scale = 1
+	}
+	if scale > maxStreamScale {
+		scale = maxStreamScale
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	frames, unsubscribe := f.Subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", fmt.Sprintf("multipart/x-mixed-replace; boundary=%s", mjpegBoundary))
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	minInterval := time.Second / time.Duration(fps)
+	var last time.Time
+	buf := &bytes.Buffer{}
+	scaled := image.NewRGBA(image.Rect(0, 0, f.width*scale, f.height*scale))
+
+	for {
+		var frame *image.RGBA
+		select {
+		case <-req.Context().Done():
+			return
+		case frame = <-frames:
+		}
+
+		if wait := minInterval - time.Since(last); wait > 0 {
+			select {
+			case <-req.Context().Done():
+				return
+			case <-time.After(wait):
+			}
+			// Send the latest frame after waiting
+			select {
+			case frame = <-frames:
+			default:
+			}
+		}
+		last = time.Now()
+
+		scaleFrame(scaled, frame, scale)
+
+		buf.Reset()
+		if err := jpeg.Encode(buf, scaled, &jpeg.Options{Quality: 90}); err != nil {
+			return
+		}
+
+		if _, err := fmt.Fprintf(w, "--%s\r\nContent-Type: image/jpeg\r\nContent-Length: %d\r\n\r\n", mjpegBoundary, buf.Len()); err != nil {
+			return
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return
+		}
+		if _, err := w.Write([]byte("\r\n")); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// scaleFrame enlarges each pixel of src into a scale x scale block of dst
+func scaleFrame(dst *image.RGBA, src *image.RGBA, scale int) {
+	b := src.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			clr := src.RGBAAt(x, y)
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					dst.SetRGBA(x*scale+dx, y*scale+dy, clr)
+				}
+			}
+		}
+	}
+}
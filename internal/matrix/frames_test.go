@@ -0,0 +1,76 @@
+package matrix
+
+import (
+	"bufio"
+	"context"
+	"image/color"
+	"image/jpeg"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrames(t *testing.T) {
+	t.Parallel()
+
+	f := NewFrames(2, 1)
+	f.Publish([]uint32{0xff0000, 0x0000ff})
+
+	frames, unsubscribe := f.Subscribe()
+	defer unsubscribe()
+
+	// The last frame is sent on subscribe
+	frame := <-frames
+	require.Equal(t, color.RGBA{255, 0, 0, 255}, frame.RGBAAt(0, 0))
+	require.Equal(t, color.RGBA{0, 0, 255, 255}, frame.RGBAAt(1, 0))
+
+	// Stale frames are replaced
+	f.Publish([]uint32{0x00ff00, 0x00ff00})
+	f.Publish([]uint32{0xffffff, 0xffffff})
+	frame = <-frames
+	require.Equal(t, color.RGBA{255, 255, 255, 255}, frame.RGBAAt(0, 0))
+
+	// Frames published without subscribers are converted when they're asked for
+	unsubscribe()
+	f.Publish([]uint32{0x00ff00, 0xff0000})
+	require.Equal(t, color.RGBA{0, 255, 0, 255}, f.Last().RGBAAt(0, 0))
+	require.Equal(t, color.RGBA{255, 0, 0, 255}, f.Last().RGBAAt(1, 0))
+}
+
+func TestServeMJPEG(t *testing.T) {
+	t.Parallel()
+
+	f := NewFrames(4, 2)
+	f.Publish(make([]uint32, 8))
+
+	server := httptest.NewServer(http.HandlerFunc(f.ServeMJPEG))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?scale=3&fps=10", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/x-mixed-replace", mediaType)
+
+	reader := multipart.NewReader(bufio.NewReader(resp.Body), params["boundary"])
+	part, err := reader.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "image/jpeg", part.Header.Get("Content-Type"))
+
+	img, err := jpeg.Decode(part)
+	require.NoError(t, err)
+	require.Equal(t, 12, img.Bounds().Dx())
+	require.Equal(t, 6, img.Bounds().Dy())
+}
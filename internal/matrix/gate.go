@@ -0,0 +1,89 @@
+package matrix
+
+import (
+	"context"
+	"sync"
+)
+
+type playGateKey struct{}
+
+// PlayGate pauses a Matrix's Play between scenes, so that a scroll can be
+// resumed from where it left off
+type PlayGate struct {
+	resume chan struct{}
+	sync.Mutex
+}
+
+// NewPlayGate ...
+func NewPlayGate() *PlayGate {
+	return &PlayGate{}
+}
+
+// Pause blocks Play before its next scene until Resume is called
+func (g *PlayGate) Pause() {
+	g.Lock()
+	defer g.Unlock()
+
+	if g.resume == nil {
+		g.resume = make(chan struct{})
+	}
+}
+
+// Resume unblocks Play
+func (g *PlayGate) Resume() {
+	g.Lock()
+	defer g.Unlock()
+
+	if g.resume != nil {
+		close(g.resume)
+		g.resume = nil
+	}
+}
+
+// Paused returns true if the gate is paused
+func (g *PlayGate) Paused() bool {
+	g.Lock()
+	defer g.Unlock()
+
+	return g.resume != nil
+}
+
+// Wait blocks while the gate is paused
+func (g *PlayGate) Wait(ctx context.Context) error {
+	g.Lock()
+	resume := g.resume
+	g.Unlock()
+
+	if resume == nil {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return context.Canceled
+	case <-resume:
+		return nil
+	}
+}
+
+// WithPlayGate returns a context that carries the given PlayGate to a Matrix's Play
+func WithPlayGate(ctx context.Context, g *PlayGate) context.Context {
+	return context.WithValue(ctx, playGateKey{}, g)
+}
+
+// WaitPlayGate blocks while the context's PlayGate is paused. It returns
+// immediately if the context has no PlayGate.
+func WaitPlayGate(ctx context.Context) error {
+	g, ok := ctx.Value(playGateKey{}).(*PlayGate)
+	if !ok || g == nil {
+		return nil
+	}
+
+	return g.Wait(ctx)
+}
+
+// PlayGateFrom returns the context's PlayGate, or nil if it has none
+func PlayGateFrom(ctx context.Context) *PlayGate {
+	g, _ := ctx.Value(playGateKey{}).(*PlayGate)
+	return g
+}
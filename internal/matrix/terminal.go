@@ -0,0 +1,111 @@
+package matrix
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// upperHalfBlock draws the top half of a cell in the foreground color and the bottom half
+	// in the background color, so each cell shows two square LEDs
+	upperHalfBlock = "▀"
+	ansiReset      = "\x1b[0m"
+	ansiHome       = "\x1b[H"
+	ansiClear      = "\x1b[2J"
+	ansiHideCursor = "\x1b[?25l"
+	ansiShowCursor = "\x1b[?25h"
+)
+
+// TerminalMatrix draws a matrix to a terminal with 24-bit color half-block characters.
+// Each LED is drawn square, so layouts keep the aspect ratio of the real panel.
+type TerminalMatrix struct {
+	*bufferedMatrix
+	out     io.Writer
+	scale   int
+	started bool
+	lock    sync.Mutex
+}
+
+// NewTerminalMatrix returns a TerminalMatrix that draws each LED as scale x scale pixels,
+// where a pixel is half of a terminal cell
+func NewTerminalMatrix(width int, height int, scale int, out io.Writer, logger *zap.Logger) *TerminalMatrix {
+	if scale < 1 {
+		scale = 1
+	}
+	t := &TerminalMatrix{
+		out:   out,
+		scale: scale,
+	}
+	t.bufferedMatrix = newBufferedMatrix(width, height, logger, t.draw)
+
+	return t
+}
+
+func (t *TerminalMatrix) draw(leds []uint32) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var buf bytes.Buffer
+	if !t.started {
+		buf.WriteString(ansiClear + ansiHideCursor)
+		t.started = true
+	}
+	buf.WriteString(ansiHome)
+
+	pixelWidth := t.width * t.scale
+	pixelHeight := t.height * t.scale
+	led := func(x int, y int) uint32 {
+		return leds[(y/t.scale)*t.width+(x/t.scale)]
+	}
+
+	for y := 0; y < pixelHeight; y += 2 {
+		var lastFg, lastBg uint32
+		first := true
+		for x := 0; x < pixelWidth; x++ {
+			fg := led(x, y)
+			if y+1 >= pixelHeight {
+				// An odd number of rows leaves the bottom half of the last cell empty
+				if first || fg != lastFg {
+					fmt.Fprintf(&buf, "\x1b[49m\x1b[38;2;%d;%d;%dm", fg>>16&0xff, fg>>8&0xff, fg&0xff)
+				}
+				lastFg = fg
+				first = false
+				buf.WriteString(upperHalfBlock)
+				continue
+			}
+
+			bg := led(x, y+1)
+			if first || fg != lastFg {
+				fmt.Fprintf(&buf, "\x1b[38;2;%d;%d;%dm", fg>>16&0xff, fg>>8&0xff, fg&0xff)
+			}
+			if first || bg != lastBg {
+				fmt.Fprintf(&buf, "\x1b[48;2;%d;%d;%dm", bg>>16&0xff, bg>>8&0xff, bg&0xff)
+			}
+			lastFg, lastBg = fg, bg
+			first = false
+			buf.WriteString(upperHalfBlock)
+		}
+		buf.WriteString(ansiReset + "\n")
+	}
+
+	_, err := t.out.Write(buf.Bytes())
+
+	return err
+}
+
+// Close restores the terminal's cursor and colors
+func (t *TerminalMatrix) Close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.started {
+		return nil
+	}
+	_, err := io.WriteString(t.out, ansiReset+ansiShowCursor)
+
+	return err
+}
@@ -0,0 +1,106 @@
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"image/color"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTerminalMatrix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		width  int
+		height int
+		scale  int
+		set    map[[2]int]color.Color
+		want   []string
+	}{
+		{
+			name:   "two rows per cell",
+			width:  2,
+			height: 2,
+			scale:  1,
+			set: map[[2]int]color.Color{
+				{0, 0}: color.RGBA{255, 0, 0, 255},
+				{1, 1}: color.RGBA{0, 0, 255, 255},
+			},
+			want: []string{
+				"\x1b[38;2;255;0;0m\x1b[48;2;0;0;0m▀\x1b[38;2;0;0;0m\x1b[48;2;0;0;255m▀" + ansiReset,
+			},
+		},
+		{
+			name:   "odd height",
+			width:  1,
+			height: 3,
+			scale:  1,
+			set: map[[2]int]color.Color{
+				{0, 2}: color.RGBA{0, 255, 0, 255},
+			},
+			want: []string{
+				"\x1b[38;2;0;0;0m\x1b[48;2;0;0;0m▀" + ansiReset,
+				"\x1b[49m\x1b[38;2;0;255;0m▀" + ansiReset,
+			},
+		},
+		{
+			name:   "scaled",
+			width:  1,
+			height: 1,
+			scale:  2,
+			set: map[[2]int]color.Color{
+				{0, 0}: color.RGBA{255, 255, 255, 255},
+			},
+			want: []string{
+				"\x1b[38;2;255;255;255m\x1b[48;2;255;255;255m▀▀" + ansiReset,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			m := NewTerminalMatrix(test.width, test.height, test.scale, &out, zap.NewNop())
+			for pt, clr := range test.set {
+				m.Set(pt[0], pt[1], clr)
+			}
+			require.NoError(t, m.Render())
+
+			rendered := strings.TrimPrefix(out.String(), ansiClear+ansiHideCursor+ansiHome)
+			require.Equal(t, strings.Join(test.want, "\n")+"\n", rendered)
+		})
+	}
+}
+
+func TestTerminalMatrixPlay(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	m := NewTerminalMatrix(1, 2, 1, &out, zap.NewNop())
+	m.SetBrightness(50)
+
+	for i := 0; i < 3; i++ {
+		m.PreLoad(&MatrixScene{
+			Index: i,
+			Points: []MatrixPoint{
+				{X: 0, Y: 0, Color: color.RGBA{200, 100, 0, 255}},
+			},
+		})
+	}
+
+	require.NoError(t, m.Play(context.Background(), time.Millisecond, nil))
+	require.Equal(t, 3, strings.Count(out.String(), ansiHome))
+	require.Contains(t, out.String(), "\x1b[38;2;100;50;0m")
+
+	require.NoError(t, m.Close())
+	require.True(t, strings.HasSuffix(out.String(), ansiShowCursor))
+}
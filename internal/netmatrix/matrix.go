@@ -0,0 +1,439 @@
+package netmatrix
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"image/color"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/matrix"
+)
+
+const (
+	dialTimeout  = 2 * time.Second
+	writeTimeout = 5 * time.Second
+	// retryInterval is how long to wait before reconnecting to a receiver that is down
+	retryInterval = 5 * time.Second
+	// gateCheckInterval is how often a playing scroll checks if it has been paused
+	gateCheckInterval = 50 * time.Millisecond
+	// stopTimeout is how long to wait for a receiver to acknowledge stopping a scroll
+	stopTimeout = 2 * time.Second
+)
+
+// Matrix is a matrix.Matrix that sends its frames to a Receiver over the network
+type Matrix struct {
+	addr        string
+	width       int
+	height      int
+	leds        []uint32
+	preload     [][]uint32
+	preloadLock sync.Mutex
+	log         *zap.Logger
+	conn        net.Conn
+	writer      *bufio.Writer
+	playDone    chan bool
+	connected   *atomic.Bool
+	// reconnect wakes the connection loop when the connection is lost
+	reconnect chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	sync.Mutex
+}
+
+// New returns a Matrix that sends to the receiver at the given address. Connecting is retried
+// in the background, so the receiver doesn't have to be up yet.
+func New(addr string, width int, height int, logger *zap.Logger) *Matrix {
+	m := &Matrix{
+		addr:      addr,
+		width:     width,
+		height:    height,
+		leds:      make([]uint32, width*height),
+		log:       logger,
+		playDone:  make(chan bool, 1),
+		connected: atomic.NewBool(false),
+		reconnect: make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+
+	go m.connectLoop()
+
+	return m
+}
+
+// Geometry ...
+func (m *Matrix) Geometry() (int, int) {
+	return m.width, m.height
+}
+
+func (m *Matrix) position(x int, y int) int {
+	if x < 0 || x >= m.width {
+		return -1
+	}
+	return x + (y * m.width)
+}
+
+// At ...
+func (m *Matrix) At(x int, y int) color.Color {
+	position := m.position(x, y)
+	if position > len(m.leds)-1 || position < 0 {
+		return color.Black
+	}
+
+	return color.RGBA{
+		R: uint8(m.leds[position] >> 16),
+		G: uint8(m.leds[position] >> 8),
+		B: uint8(m.leds[position]),
+		A: 255,
+	}
+}
+
+// Set ...
+func (m *Matrix) Set(x int, y int, clr color.Color) {
+	position := m.position(x, y)
+	if position > len(m.leds)-1 || position < 0 {
+		return
+	}
+
+	m.leds[position] = colorToUint32(clr)
+}
+
+// Render sends the frame to the receiver. Frames are dropped while the receiver is down.
+func (m *Matrix) Render() error {
+	defer func() {
+		m.leds = make([]uint32, m.width*m.height)
+	}()
+
+	frame, err := encodeFrame(m.leds)
+	if err != nil {
+		return err
+	}
+
+	m.send(msgFrame, frame)
+
+	return nil
+}
+
+// PreLoad ...
+func (m *Matrix) PreLoad(scene *matrix.MatrixScene) {
+	m.preloadLock.Lock()
+	defer m.preloadLock.Unlock()
+
+	prep := make([]uint32, m.width*m.height)
+	for _, pt := range scene.Points {
+		position := m.position(pt.X, pt.Y)
+		if position > len(prep)-1 || position < 0 {
+			continue
+		}
+		prep[position] = colorToUint32(pt.Color)
+	}
+
+	if len(m.preload) < scene.Index+1 {
+		newPreload := make([][]uint32, scene.Index+1)
+		copy(newPreload, m.preload)
+		m.preload = newPreload
+	}
+
+	m.preload[scene.Index] = prep
+}
+
+// ReversePreLoad ...
+func (m *Matrix) ReversePreLoad() {
+	m.preloadLock.Lock()
+	defer m.preloadLock.Unlock()
+
+	for i, j := 0, len(m.preload)-1; i < j; i, j = i+1, j-1 {
+		m.preload[i], m.preload[j] = m.preload[j], m.preload[i]
+	}
+}
+
+// Play sends the preloaded scenes to the receiver, which plays them with its own timing. It
+// blocks until the receiver is done. While the receiver is down, it waits as long as playing
+// would have taken.
+func (m *Matrix) Play(ctx context.Context, startInterval time.Duration, interval <-chan time.Duration) error {
+	m.preloadLock.Lock()
+	scenes := m.preload
+	m.preload = [][]uint32{}
+	m.preloadLock.Unlock()
+
+	// Clear any result of a previous Play that was abandoned
+	select {
+	case <-m.playDone:
+	default:
+	}
+
+	sent := true
+	for i, leds := range scenes {
+		if leds == nil {
+			leds = make([]uint32, m.width*m.height)
+		}
+		scene, err := encodeScene(i, leds)
+		if err != nil {
+			return err
+		}
+		if !m.send(msgScene, scene) {
+			sent = false
+			break
+		}
+	}
+	if !sent || !m.send(msgPlay, encodeDuration(startInterval)) {
+		return m.playOffline(ctx, len(scenes), startInterval, interval)
+	}
+
+	gate := matrix.PlayGateFrom(ctx)
+	paused := false
+	ticker := time.NewTicker(gateCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.stop()
+			return context.Canceled
+		case d := <-interval:
+			m.send(msgInterval, encodeDuration(d))
+		case ok := <-m.playDone:
+			if !ok {
+				return fmt.Errorf("receiver at %s failed to play scenes", m.addr)
+			}
+			return nil
+		case <-ticker.C:
+			if !m.connected.Load() {
+				return fmt.Errorf("lost connection to receiver at %s during play", m.addr)
+			}
+			if gate == nil || gate.Paused() == paused {
+				continue
+			}
+			paused = !paused
+			if paused {
+				m.send(msgPause, nil)
+			} else {
+				m.send(msgResume, nil)
+			}
+		}
+	}
+}
+
+// playOffline waits as long as playing the scenes would take
+func (m *Matrix) playOffline(ctx context.Context, scenes int, startInterval time.Duration, interval <-chan time.Duration) error {
+	waitInterval := startInterval
+	for i := 0; i < scenes; i++ {
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case waitInterval = <-interval:
+		case <-time.After(waitInterval):
+		}
+	}
+	return nil
+}
+
+// stop tells the receiver to stop playing, and waits for it to finish
+func (m *Matrix) stop() {
+	if !m.send(msgStop, nil) {
+		return
+	}
+	select {
+	case <-m.playDone:
+	case <-time.After(stopTimeout):
+		m.log.Warn("timed out waiting for network matrix receiver to stop playing",
+			zap.String("address", m.addr),
+		)
+	}
+}
+
+// SetBrightness sets the brightness of the receiver's matrix
+func (m *Matrix) SetBrightness(brightness int) {
+	m.send(msgBrightness, []byte{uint8(brightness)})
+}
+
+// Close ...
+func (m *Matrix) Close() error {
+	m.closeOnce.Do(func() {
+		close(m.done)
+	})
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.conn == nil {
+		return nil
+	}
+	err := m.conn.Close()
+	m.conn = nil
+	m.connected.Store(false)
+
+	return err
+}
+
+// send writes a message to the receiver. It returns false if the receiver is down, in which
+// case the message is dropped.
+func (m *Matrix) send(typ messageType, payload []byte) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.conn == nil {
+		return false
+	}
+
+	_ = m.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	err := writeMessage(m.writer, typ, payload)
+	if err == nil {
+		err = m.writer.Flush()
+	}
+	if err != nil {
+		m.log.Error("lost connection to network matrix receiver",
+			zap.String("address", m.addr),
+			zap.Error(err),
+		)
+		m.disconnect()
+		return false
+	}
+
+	return true
+}
+
+// connectLoop connects to the receiver until the matrix is closed, reconnecting whenever the
+// connection is lost. Connecting never holds the lock, so rendering isn't blocked by it.
+func (m *Matrix) connectLoop() {
+	for {
+		if !m.connected.Load() {
+			if err := m.connect(); err != nil {
+				m.log.Error("failed to connect to network matrix receiver",
+					zap.String("address", m.addr),
+					zap.Error(err),
+				)
+				select {
+				case <-m.done:
+					return
+				case <-time.After(retryInterval):
+				}
+				continue
+			}
+		}
+
+		select {
+		case <-m.done:
+			return
+		case <-m.reconnect:
+		}
+	}
+}
+
+func (m *Matrix) connect() error {
+	conn, err := m.dial()
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	select {
+	case <-m.done:
+		conn.Close()
+		return fmt.Errorf("matrix is closed")
+	default:
+	}
+
+	m.conn = conn
+	m.writer = bufio.NewWriter(conn)
+	m.connected.Store(true)
+	go m.read(conn)
+
+	m.log.Info("connected to network matrix receiver",
+		zap.String("address", m.addr),
+	)
+
+	return nil
+}
+
+func (m *Matrix) dial() (net.Conn, error) {
+	conn, err := net.DialTimeout("tcp", m.addr, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(dialTimeout))
+	msg, err := readMessage(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read receiver hello: %w", err)
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	if msg.typ != msgHello {
+		conn.Close()
+		return nil, fmt.Errorf("expected receiver hello, got message type %d", msg.typ)
+	}
+	h, err := decodeHello(msg.payload)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if h.version != protocolVersion {
+		conn.Close()
+		return nil, fmt.Errorf("receiver protocol version %d is not supported, expected %d", h.version, protocolVersion)
+	}
+	if h.width != m.width || h.height != m.height {
+		conn.Close()
+		return nil, fmt.Errorf("receiver is %dx%d, but the output is configured as %dx%d", h.width, h.height, m.width, m.height)
+	}
+
+	return conn, nil
+}
+
+// disconnect must be called with the lock held
+func (m *Matrix) disconnect() {
+	if m.conn != nil {
+		_ = m.conn.Close()
+	}
+	m.conn = nil
+	m.connected.Store(false)
+	select {
+	case m.reconnect <- struct{}{}:
+	default:
+	}
+}
+
+// read handles the messages from the receiver until the connection is closed
+func (m *Matrix) read(conn net.Conn) {
+	defer func() {
+		m.Lock()
+		defer m.Unlock()
+		if m.conn == conn {
+			m.disconnect()
+		}
+	}()
+
+	reader := bufio.NewReader(conn)
+	for {
+		msg, err := readMessage(reader)
+		if err != nil {
+			return
+		}
+
+		if msg.typ == msgPlayDone {
+			ok := len(msg.payload) > 0 && msg.payload[0] == 1
+			select {
+			case m.playDone <- ok:
+			default:
+			}
+		}
+	}
+}
+
+func colorToUint32(c color.Color) uint32 {
+	if c == nil {
+		return 0
+	}
+
+	// A color's RGBA method returns values in the range [0, 65535]
+	red, green, blue, _ := c.RGBA()
+	return (red>>8)<<16 | (green>>8)<<8 | blue>>8
+}
@@ -0,0 +1,179 @@
+package netmatrix
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/matrix"
+)
+
+func startReceiver(t *testing.T, ctx context.Context, width int, height int) (*matrix.ConsoleMatrix, string) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	local := matrix.NewConsoleMatrix(width, height, io.Discard, zap.NewNop())
+	r := NewReceiver(local, zap.NewNop())
+	go func() {
+		_ = r.Serve(ctx, l)
+	}()
+
+	return local, l.Addr().String()
+}
+
+func waitConnected(t *testing.T, m *Matrix) {
+	t.Helper()
+	require.Eventually(t, m.connected.Load, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local, addr := startReceiver(t, ctx, 4, 2)
+	frames, unsubscribe := local.Frames().Subscribe()
+	defer unsubscribe()
+
+	m := New(addr, 4, 2, zap.NewNop())
+	defer m.Close()
+	waitConnected(t, m)
+
+	red := color.RGBA{R: 255, A: 255}
+	m.Set(1, 1, red)
+	require.NoError(t, m.Render())
+
+	select {
+	case frame := <-frames:
+		require.Equal(t, red, frame.RGBAAt(1, 1))
+		require.Equal(t, color.RGBA{A: 255}, frame.RGBAAt(0, 0))
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for frame")
+	}
+}
+
+func TestPlay(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local, addr := startReceiver(t, ctx, 2, 1)
+	played := atomic.NewInt64(0)
+	var last *image.RGBA
+	local.Frames().Observe(func(frame *image.RGBA, at time.Time) {
+		played.Inc()
+		last = frame
+	})
+
+	m := New(addr, 2, 1, zap.NewNop())
+	defer m.Close()
+	waitConnected(t, m)
+
+	for i := 0; i < 3; i++ {
+		m.PreLoad(&matrix.MatrixScene{
+			Index: i,
+			Points: []matrix.MatrixPoint{
+				{X: 0, Y: 0, Color: color.RGBA{G: uint8(i + 1), A: 255}},
+			},
+		})
+	}
+	m.ReversePreLoad()
+
+	require.NoError(t, m.Play(ctx, time.Millisecond, nil))
+	require.Equal(t, int64(3), played.Load())
+	require.Equal(t, color.RGBA{G: 1, A: 255}, last.RGBAAt(0, 0))
+}
+
+func TestPlayCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, addr := startReceiver(t, ctx, 2, 1)
+
+	m := New(addr, 2, 1, zap.NewNop())
+	defer m.Close()
+	waitConnected(t, m)
+
+	for i := 0; i < 100; i++ {
+		m.PreLoad(&matrix.MatrixScene{Index: i})
+	}
+
+	playCtx, playCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer playCancel()
+
+	start := time.Now()
+	require.ErrorIs(t, m.Play(playCtx, 50*time.Millisecond, nil), context.Canceled)
+	require.Less(t, time.Since(start), 3*time.Second)
+}
+
+func TestReceiverDown(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	l.Close()
+
+	m := New(addr, 2, 1, zap.NewNop())
+	defer m.Close()
+	require.NoError(t, m.Render())
+
+	m.PreLoad(&matrix.MatrixScene{Index: 0})
+	m.PreLoad(&matrix.MatrixScene{Index: 1})
+
+	start := time.Now()
+	require.NoError(t, m.Play(context.Background(), 20*time.Millisecond, nil))
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestGeometryMismatch(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, addr := startReceiver(t, ctx, 4, 2)
+
+	m := New(addr, 8, 2, zap.NewNop())
+	defer m.Close()
+	_, err := m.dial()
+	require.Error(t, err)
+}
+
+func TestRenderWhileConnecting(t *testing.T) {
+	t.Parallel()
+
+	// The receiver accepts connections, but never says hello
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	m := New(l.Addr().String(), 2, 1, zap.NewNop())
+	defer m.Close()
+
+	// Frames are dropped instead of waiting for the connection
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, m.Render())
+	}
+	require.Less(t, time.Since(start), dialTimeout/2)
+}
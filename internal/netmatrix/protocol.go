@@ -0,0 +1,186 @@
+package netmatrix
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// protocolVersion is sent by the receiver when a sender connects. Senders refuse receivers
+// of a different version.
+const protocolVersion = 1
+
+// maxMessageSize protects against reading garbage as a huge message
+const maxMessageSize = 64 << 20
+
+type messageType uint8
+
+const (
+	// msgHello is sent by the receiver on connect with its version and geometry
+	msgHello messageType = iota + 1
+	// msgFrame is a compressed frame to render immediately
+	msgFrame
+	// msgScene is a compressed frame preloaded for Play, prefixed with its index
+	msgScene
+	// msgReverse reverses the order of the preloaded scenes
+	msgReverse
+	// msgPlay plays the preloaded scenes at the given interval
+	msgPlay
+	// msgInterval changes the interval of the scenes being played
+	msgInterval
+	// msgStop stops playing scenes
+	msgStop
+	// msgPause pauses playing scenes until msgResume
+	msgPause
+	msgResume
+	// msgPlayDone is sent by the receiver when it's done playing scenes
+	msgPlayDone
+	// msgBrightness sets the brightness of the receiver's matrix
+	msgBrightness
+)
+
+type message struct {
+	typ     messageType
+	payload []byte
+}
+
+// hello is the receiver's greeting
+type hello struct {
+	version uint8
+	width   int
+	height  int
+}
+
+func writeMessage(w io.Writer, typ messageType, payload []byte) error {
+	header := make([]byte, 5)
+	header[0] = byte(typ)
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+
+	if _, err := w.Write(append(header, payload...)); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	return nil
+}
+
+func readMessage(r io.Reader) (*message, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > maxMessageSize {
+		return nil, fmt.Errorf("message of %d bytes exceeds the max size", size)
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+
+	return &message{
+		typ:     messageType(header[0]),
+		payload: payload,
+	}, nil
+}
+
+func encodeHello(h *hello) []byte {
+	b := make([]byte, 5)
+	b[0] = h.version
+	binary.BigEndian.PutUint16(b[1:], uint16(h.width))
+	binary.BigEndian.PutUint16(b[3:], uint16(h.height))
+	return b
+}
+
+func decodeHello(b []byte) (*hello, error) {
+	if len(b) != 5 {
+		return nil, fmt.Errorf("invalid hello of %d bytes", len(b))
+	}
+	return &hello{
+		version: b[0],
+		width:   int(binary.BigEndian.Uint16(b[1:])),
+		height:  int(binary.BigEndian.Uint16(b[3:])),
+	}, nil
+}
+
+// encodeFrame compresses 0xRRGGBB LED colors as 3 bytes per LED
+func encodeFrame(leds []uint32) ([]byte, error) {
+	raw := make([]byte, len(leds)*3)
+	for i, led := range leds {
+		raw[i*3] = uint8(led >> 16)
+		raw[i*3+1] = uint8(led >> 8)
+		raw[i*3+2] = uint8(led)
+	}
+
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decodeFrame(b []byte, size int) ([]uint32, error) {
+	r, err := zlib.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress frame: %w", err)
+	}
+	defer r.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(r, int64(size*3)+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress frame: %w", err)
+	}
+	if len(raw) != size*3 {
+		return nil, fmt.Errorf("frame has %d LEDs, expected %d", len(raw)/3, size)
+	}
+
+	leds := make([]uint32, size)
+	for i := range leds {
+		leds[i] = uint32(raw[i*3])<<16 | uint32(raw[i*3+1])<<8 | uint32(raw[i*3+2])
+	}
+
+	return leds, nil
+}
+
+func encodeScene(index int, leds []uint32) ([]byte, error) {
+	frame, err := encodeFrame(leds)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 4, 4+len(frame))
+	binary.BigEndian.PutUint32(b, uint32(index))
+	return append(b, frame...), nil
+}
+
+func decodeScene(b []byte, size int) (int, []uint32, error) {
+	if len(b) < 4 {
+		return 0, nil, fmt.Errorf("invalid scene of %d bytes", len(b))
+	}
+	leds, err := decodeFrame(b[4:], size)
+	if err != nil {
+		return 0, nil, err
+	}
+	return int(binary.BigEndian.Uint32(b)), leds, nil
+}
+
+func encodeDuration(d time.Duration) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(d))
+	return b
+}
+
+func decodeDuration(b []byte) (time.Duration, error) {
+	if len(b) != 8 {
+		return 0, fmt.Errorf("invalid duration of %d bytes", len(b))
+	}
+	return time.Duration(binary.BigEndian.Uint64(b)), nil
+}
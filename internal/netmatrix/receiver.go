@@ -0,0 +1,263 @@
+package netmatrix
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"image/color"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/matrix"
+)
+
+// Receiver draws the frames sent by a network Matrix on a local matrix.Matrix
+type Receiver struct {
+	matrix matrix.Matrix
+	log    *zap.Logger
+	width  int
+	height int
+	// current is the connection being served. A new sender replaces it.
+	current net.Conn
+	sync.Mutex
+}
+
+// session is the state of a connection from a sender
+type session struct {
+	conn       net.Conn
+	writeLock  sync.Mutex
+	playCancel context.CancelFunc
+	playDone   chan struct{}
+	intervals  chan time.Duration
+	gate       *matrix.PlayGate
+}
+
+// NewReceiver ...
+func NewReceiver(m matrix.Matrix, logger *zap.Logger) *Receiver {
+	w, h := m.Geometry()
+	return &Receiver{
+		matrix: m,
+		log:    logger,
+		width:  w,
+		height: h,
+	}
+}
+
+// Serve accepts senders until the context is canceled. Only one sender is served at a time; a
+// new sender replaces the previous one.
+func (r *Receiver) Serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		_ = l.Close()
+	}()
+
+	r.log.Info("network matrix receiver listening",
+		zap.String("address", l.Addr().String()),
+		zap.Int("width", r.width),
+		zap.Int("height", r.height),
+	)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return context.Canceled
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return err
+			}
+			r.log.Error("failed to accept network matrix sender", zap.Error(err))
+			continue
+		}
+
+		r.Lock()
+		if r.current != nil {
+			r.log.Warn("replacing network matrix sender",
+				zap.String("previous", r.current.RemoteAddr().String()),
+				zap.String("sender", conn.RemoteAddr().String()),
+			)
+			_ = r.current.Close()
+		}
+		r.current = conn
+		r.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := r.handle(ctx, conn); err != nil {
+				r.log.Error("network matrix sender disconnected",
+					zap.String("sender", conn.RemoteAddr().String()),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+}
+
+func (r *Receiver) handle(ctx context.Context, conn net.Conn) error {
+	defer conn.Close()
+
+	r.log.Info("network matrix sender connected",
+		zap.String("sender", conn.RemoteAddr().String()),
+	)
+
+	s := &session{
+		conn: conn,
+		gate: matrix.NewPlayGate(),
+	}
+	defer s.stop()
+
+	if err := s.write(msgHello, encodeHello(&hello{
+		version: protocolVersion,
+		width:   r.width,
+		height:  r.height,
+	})); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(conn)
+	for {
+		if ctx.Err() != nil {
+			return context.Canceled
+		}
+
+		msg, err := readMessage(reader)
+		if err != nil {
+			return err
+		}
+
+		if err := r.handleMessage(ctx, s, msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *Receiver) handleMessage(ctx context.Context, s *session, msg *message) error {
+	switch msg.typ {
+	case msgFrame:
+		leds, err := decodeFrame(msg.payload, r.width*r.height)
+		if err != nil {
+			return err
+		}
+		for i, led := range leds {
+			r.matrix.Set(i%r.width, i/r.width, uint32ToColor(led))
+		}
+		return r.matrix.Render()
+	case msgScene:
+		index, leds, err := decodeScene(msg.payload, r.width*r.height)
+		if err != nil {
+			return err
+		}
+		scene := &matrix.MatrixScene{
+			Index:  index,
+			Points: make([]matrix.MatrixPoint, len(leds)),
+		}
+		for i, led := range leds {
+			scene.Points[i] = matrix.MatrixPoint{
+				X:     i % r.width,
+				Y:     i / r.width,
+				Color: uint32ToColor(led),
+			}
+		}
+		r.matrix.PreLoad(scene)
+	case msgReverse:
+		r.matrix.ReversePreLoad()
+	case msgPlay:
+		interval, err := decodeDuration(msg.payload)
+		if err != nil {
+			return err
+		}
+		s.play(ctx, r, interval)
+	case msgInterval:
+		interval, err := decodeDuration(msg.payload)
+		if err != nil {
+			return err
+		}
+		if s.intervals != nil {
+			select {
+			case s.intervals <- interval:
+			default:
+			}
+		}
+	case msgStop:
+		s.stop()
+	case msgPause:
+		s.gate.Pause()
+	case msgResume:
+		s.gate.Resume()
+	case msgBrightness:
+		if len(msg.payload) != 1 {
+			return fmt.Errorf("invalid brightness of %d bytes", len(msg.payload))
+		}
+		r.matrix.SetBrightness(int(msg.payload[0]))
+	default:
+		r.log.Warn("ignoring unknown network matrix message",
+			zap.Uint8("type", uint8(msg.typ)),
+		)
+	}
+
+	return nil
+}
+
+// play plays the preloaded scenes in the background, then tells the sender it's done
+func (s *session) play(ctx context.Context, r *Receiver, interval time.Duration) {
+	s.stop()
+
+	var playCtx context.Context
+	playCtx, s.playCancel = context.WithCancel(matrix.WithPlayGate(ctx, s.gate))
+	s.playDone = make(chan struct{})
+	s.intervals = make(chan time.Duration, 1)
+	s.gate.Resume()
+
+	done := s.playDone
+	intervals := s.intervals
+	go func() {
+		defer close(done)
+		status := uint8(1)
+		if err := r.matrix.Play(playCtx, interval, intervals); err != nil {
+			if !errors.Is(err, context.Canceled) {
+				r.log.Error("failed to play network matrix scenes", zap.Error(err))
+			}
+			status = 0
+		}
+		if err := s.write(msgPlayDone, []byte{status}); err != nil {
+			r.log.Error("failed to send play done to network matrix sender", zap.Error(err))
+		}
+	}()
+}
+
+// stop stops playing scenes and waits for Play to return
+func (s *session) stop() {
+	if s.playCancel == nil {
+		return
+	}
+	s.playCancel()
+	s.gate.Resume()
+	<-s.playDone
+	s.playCancel = nil
+	s.intervals = nil
+}
+
+func (s *session) write(typ messageType, payload []byte) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return writeMessage(s.conn, typ, payload)
+}
+
+func uint32ToColor(led uint32) color.Color {
+	return color.RGBA{
+		R: uint8(led >> 16),
+		G: uint8(led >> 8),
+		B: uint8(led),
+		A: 255,
+	}
+}
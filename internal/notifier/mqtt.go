@@ -0,0 +1,284 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/robbydyer/sports/internal/sportsmatrix"
+)
+
+// MQTT 3.1.1 control packet types
+const (
+	mqttConnect    byte = 0x10
+	mqttConnack    byte = 0x20
+	mqttPublish    byte = 0x30
+	mqttPuback     byte = 0x40
+	mqttPingreq    byte = 0xC0
+	mqttPingresp   byte = 0xD0
+	mqttDisconnect byte = 0xE0
+)
+
+const (
+	mqttTimeout   = 10 * time.Second
+	mqttKeepAlive = 60 * time.Second
+)
+
+// mqttPublisher is a minimal MQTT 3.1.1 client that only publishes at QoS 1.
+// The connection is kept open between publishes, pinged so that brokers and NAT
+// gateways don't drop it while idle, and re-established after a failure.
+type mqttPublisher struct {
+	config    *MQTTConfig
+	conn      net.Conn
+	reader    *bufio.Reader
+	packetID  uint16
+	keepAlive time.Duration
+	stopPing  chan struct{}
+	sync.Mutex
+}
+
+func newMQTTPublisher(config *MQTTConfig) *mqttPublisher {
+	return &mqttPublisher{
+		config:    config,
+		keepAlive: mqttKeepAlive,
+	}
+}
+
+func (m *mqttPublisher) name() string {
+	return "mqtt://" + m.config.Broker
+}
+
+func (m *mqttPublisher) send(ctx context.Context, event *sportsmatrix.Event, payload []byte) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.conn == nil {
+		if err := m.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := m.publish(fmt.Sprintf("%s/%s", m.config.Topic, event.Type), payload); err != nil {
+		m.close()
+		return err
+	}
+
+	return nil
+}
+
+func (m *mqttPublisher) connect(ctx context.Context) error {
+	dialer := &net.Dialer{
+		Timeout: mqttTimeout,
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", m.config.Broker)
+	if err != nil {
+		return fmt.Errorf("failed to connect to MQTT broker: %w", err)
+	}
+
+	m.conn = conn
+	m.reader = bufio.NewReader(conn)
+
+	var flags byte = 0x02 // clean session
+	body := appendMQTTString(nil, "MQTT")
+	body = append(body, 0x04) // protocol level 3.1.1
+
+	payload := appendMQTTString(nil, m.config.ClientID)
+	if m.config.Username != "" {
+		flags |= 0x80
+		payload = appendMQTTString(payload, m.config.Username)
+	}
+	// MQTT 3.1.1 doesn't allow a password without a username
+	if m.config.Username != "" && m.config.Password != "" {
+		flags |= 0x40
+		payload = appendMQTTString(payload, m.config.Password)
+	}
+	body = append(body, flags)
+	body = binary.BigEndian.AppendUint16(body, uint16(m.keepAlive/time.Second))
+	body = append(body, payload...)
+
+	if err := m.writePacket(mqttConnect, body); err != nil {
+		m.close()
+		return err
+	}
+
+	packetType, ack, err := m.readPacket()
+	if err != nil {
+		m.close()
+		return err
+	}
+	if packetType != mqttConnack || len(ack) != 2 {
+		m.close()
+		return fmt.Errorf("unexpected MQTT packet %#x while connecting", packetType)
+	}
+	if ack[1] != 0 {
+		m.close()
+		return fmt.Errorf("MQTT broker refused connection with code %d", ack[1])
+	}
+
+	m.stopPing = make(chan struct{})
+	go m.ping(conn, m.stopPing)
+
+	return nil
+}
+
+// ping sends a PINGREQ at half the keep alive interval, so the broker never
+// considers the connection idle. A failed ping closes the connection.
+func (m *mqttPublisher) ping(conn net.Conn, stop chan struct{}) {
+	ticker := time.NewTicker(m.keepAlive / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		m.Lock()
+		if m.conn != conn {
+			m.Unlock()
+			return
+		}
+		if err := m.pingOnce(); err != nil {
+			m.close()
+			m.Unlock()
+			return
+		}
+		m.Unlock()
+	}
+}
+
+func (m *mqttPublisher) pingOnce() error {
+	if err := m.writePacket(mqttPingreq, nil); err != nil {
+		return err
+	}
+
+	for {
+		packetType, _, err := m.readPacket()
+		if err != nil {
+			return err
+		}
+		if packetType == mqttPingresp {
+			return nil
+		}
+	}
+}
+
+func (m *mqttPublisher) publish(topic string, payload []byte) error {
+	m.packetID++
+	if m.packetID == 0 {
+		m.packetID = 1
+	}
+
+	header := mqttPublish | 0x02 // QoS 1
+	if m.config.Retain {
+		header |= 0x01
+	}
+
+	body := appendMQTTString(nil, topic)
+	body = binary.BigEndian.AppendUint16(body, m.packetID)
+	body = append(body, payload...)
+
+	if err := m.writePacket(header, body); err != nil {
+		return err
+	}
+
+	for {
+		packetType, ack, err := m.readPacket()
+		if err != nil {
+			return err
+		}
+		if packetType != mqttPuback || len(ack) != 2 {
+			continue
+		}
+		if binary.BigEndian.Uint16(ack) == m.packetID {
+			return nil
+		}
+	}
+}
+
+func (m *mqttPublisher) close() {
+	if m.conn == nil {
+		return
+	}
+	if m.stopPing != nil {
+		close(m.stopPing)
+		m.stopPing = nil
+	}
+	_ = m.conn.SetWriteDeadline(time.Now().Add(time.Second))
+	_, _ = m.conn.Write([]byte{mqttDisconnect, 0x00})
+	_ = m.conn.Close()
+	m.conn = nil
+	m.reader = nil
+}
+
+func (m *mqttPublisher) writePacket(header byte, body []byte) error {
+	packet := []byte{header}
+	packet = appendMQTTLength(packet, len(body))
+	packet = append(packet, body...)
+
+	if err := m.conn.SetWriteDeadline(time.Now().Add(mqttTimeout)); err != nil {
+		return err
+	}
+	_, err := m.conn.Write(packet)
+	return err
+}
+
+func (m *mqttPublisher) readPacket() (byte, []byte, error) {
+	if err := m.conn.SetReadDeadline(time.Now().Add(mqttTimeout)); err != nil {
+		return 0, nil, err
+	}
+
+	header, err := m.reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	length := 0
+	multiplier := 1
+	for i := 0; ; i++ {
+		if i > 3 {
+			return 0, nil, fmt.Errorf("malformed MQTT remaining length")
+		}
+		b, err := m.reader.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		length += int(b&0x7F) * multiplier
+		if b&0x80 == 0 {
+			break
+		}
+		multiplier *= 128
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(m.reader, body); err != nil {
+		return 0, nil, err
+	}
+
+	return header & 0xF0, body, nil
+}
+
+func appendMQTTString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+func appendMQTTLength(b []byte, length int) []byte {
+	for {
+		digit := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			digit |= 0x80
+		}
+		b = append(b, digit)
+		if length == 0 {
+			return b
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/sportsmatrix"
+)
+
+const (
+	defaultRetries    = 3
+	defaultBackoff    = 2 * time.Second
+	maxBackoff        = 2 * time.Minute
+	defaultQueueSize  = 100
+	defaultMQTTTopic  = "sportsmatrix"
+	defaultMQTTClient = "sportsmatrix"
+)
+
+// defaultEvents are sent to a target when its filter doesn't list any events
+var defaultEvents = []sportsmatrix.EventType{
+	sportsmatrix.BoardChanged,
+	sportsmatrix.ScreenOn,
+	sportsmatrix.ScreenOff,
+	sportsmatrix.GameStarted,
+	sportsmatrix.ScoreChanged,
+	sportsmatrix.GameFinal,
+}
+
+// Config ...
+type Config struct {
+	Webhooks []*WebhookConfig `json:"webhooks"`
+	MQTT     []*MQTTConfig    `json:"mqtt"`
+}
+
+// Filter limits which events are sent to a target
+type Filter struct {
+	// Events is a list of event types to send. Defaults to board changes, screen on/off
+	// and game starts, scores and finals.
+	Events []string `json:"events"`
+	// Leagues limits game events to the given leagues
+	Leagues []string `json:"leagues"`
+	// Teams limits game events to games involving the given team abbreviations
+	Teams []string `json:"teams"`
+	// AllGames sends game events for every game, not just games with a favorite team
+	AllGames bool `json:"allGames"`
+}
+
+// Delivery configures retries for a target
+type Delivery struct {
+	// Retries is how many times a failed notification is retried. Default is 3, 0 disables retries.
+	Retries *int   `json:"retries"`
+	Backoff string `json:"backoff"`
+	retries int
+	backoff time.Duration
+}
+
+// WebhookConfig ...
+type WebhookConfig struct {
+	URL      string            `json:"url"`
+	Headers  map[string]string `json:"headers"`
+	Timeout  string            `json:"timeout"`
+	Filter   *Filter           `json:"filter"`
+	Delivery *Delivery         `json:"delivery"`
+	timeout  time.Duration
+}
+
+// MQTTConfig ...
+type MQTTConfig struct {
+	// Broker is the host:port of the MQTT broker
+	Broker   string `json:"broker"`
+	ClientID string `json:"clientId"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// Topic is the prefix of the topic published to. Each event is published
+	// to <topic>/<event type>
+	Topic    string    `json:"topic"`
+	Retain   bool      `json:"retain"`
+	Filter   *Filter   `json:"filter"`
+	Delivery *Delivery `json:"delivery"`
+}
+
+// Notifier sends matrix and game events to webhooks and MQTT brokers
+type Notifier struct {
+	log     *zap.Logger
+	targets []*target
+}
+
+type sender interface {
+	name() string
+	send(ctx context.Context, event *sportsmatrix.Event, payload []byte) error
+}
+
+type target struct {
+	sender   sender
+	filter   *Filter
+	delivery *Delivery
+	queue    chan *sportsmatrix.Event
+}
+
+// SetDefaults sets config defaults
+func (d *Delivery) SetDefaults() {
+	d.retries = defaultRetries
+	if d.Retries != nil && *d.Retries >= 0 {
+		d.retries = *d.Retries
+	}
+	d.backoff = defaultBackoff
+	if d.Backoff != "" {
+		if b, err := time.ParseDuration(d.Backoff); err == nil {
+			d.backoff = b
+		}
+	}
+}
+
+// SetDefaults sets config defaults
+func (c *WebhookConfig) SetDefaults() {
+	if c.Filter == nil {
+		c.Filter = &Filter{}
+	}
+	if c.Delivery == nil {
+		c.Delivery = &Delivery{}
+	}
+	c.Delivery.SetDefaults()
+
+	c.timeout = 10 * time.Second
+	if c.Timeout != "" {
+		if t, err := time.ParseDuration(c.Timeout); err == nil {
+			c.timeout = t
+		}
+	}
+}
+
+// SetDefaults sets config defaults
+func (c *MQTTConfig) SetDefaults() {
+	if c.Filter == nil {
+		c.Filter = &Filter{}
+	}
+	if c.Delivery == nil {
+		c.Delivery = &Delivery{}
+	}
+	c.Delivery.SetDefaults()
+
+	if c.Topic == "" {
+		c.Topic = defaultMQTTTopic
+	}
+	c.Topic = strings.TrimSuffix(c.Topic, "/")
+	if c.ClientID == "" {
+		c.ClientID = defaultMQTTClient
+	}
+}
+
+// New ...
+func New(config *Config, logger *zap.Logger) (*Notifier, error) {
+	n := &Notifier{
+		log: logger,
+	}
+
+	for _, w := range config.Webhooks {
+		if w.URL == "" {
+			return nil, fmt.Errorf("webhook is missing a URL")
+		}
+		w.SetDefaults()
+		n.addTarget(newWebhook(w), w.Filter, w.Delivery)
+	}
+
+	for _, m := range config.MQTT {
+		if m.Broker == "" {
+			return nil, fmt.Errorf("MQTT config is missing a broker")
+		}
+		m.SetDefaults()
+		n.addTarget(newMQTTPublisher(m), m.Filter, m.Delivery)
+	}
+
+	return n, nil
+}
+
+func (n *Notifier) addTarget(s sender, filter *Filter, delivery *Delivery) {
+	n.targets = append(n.targets, &target{
+		sender:   s,
+		filter:   filter,
+		delivery: delivery,
+		queue:    make(chan *sportsmatrix.Event, defaultQueueSize),
+	})
+}
+
+// Run subscribes to the EventBus and delivers events until the context is canceled
+func (n *Notifier) Run(ctx context.Context, bus *sportsmatrix.EventBus) {
+	events, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+
+	for _, t := range n.targets {
+		go n.deliver(ctx, t)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			n.Notify(event)
+		}
+	}
+}
+
+// Notify queues an event for delivery to every target whose filter matches
+func (n *Notifier) Notify(event *sportsmatrix.Event) {
+	for _, t := range n.targets {
+		if !t.filter.matches(event) {
+			continue
+		}
+		select {
+		case t.queue <- event:
+		default:
+			n.log.Warn("notifier queue is full, dropping event",
+				zap.String("target", t.sender.name()),
+				zap.String("type", string(event.Type)),
+			)
+		}
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, t *target) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-t.queue:
+			if err := n.send(ctx, t, event); err != nil {
+				n.log.Error("failed to deliver notification",
+					zap.Error(err),
+					zap.String("target", t.sender.name()),
+					zap.String("type", string(event.Type)),
+				)
+			}
+		}
+	}
+}
+
+// send delivers an event, retrying with exponential backoff
+func (n *Notifier) send(ctx context.Context, t *target, event *sportsmatrix.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	backoff := t.delivery.backoff
+	tries := 0
+	for {
+		tries++
+		err := t.sender.send(ctx, event, payload)
+		if err == nil {
+			return nil
+		}
+		if tries > t.delivery.retries {
+			return fmt.Errorf("giving up after %d attempts: %w", tries, err)
+		}
+
+		n.log.Warn("notification failed, retrying",
+			zap.Error(err),
+			zap.String("target", t.sender.name()),
+			zap.Int("attempt", tries),
+			zap.Duration("backoff", backoff),
+		)
+
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+func (f *Filter) matches(event *sportsmatrix.Event) bool {
+	if !f.matchesType(event.Type) {
+		return false
+	}
+
+	if !event.IsGameEvent() {
+		return true
+	}
+
+	if !f.AllGames && !event.Favorite {
+		return false
+	}
+
+	if len(f.Leagues) > 0 && !containsFold(f.Leagues, event.League) {
+		return false
+	}
+
+	if len(f.Teams) > 0 && !containsFold(f.Teams, event.Home) && !containsFold(f.Teams, event.Away) {
+		return false
+	}
+
+	return true
+}
+
+func (f *Filter) matchesType(t sportsmatrix.EventType) bool {
+	if len(f.Events) == 0 {
+		for _, e := range defaultEvents {
+			if e == t {
+				return true
+			}
+		}
+		return false
+	}
+
+	return containsFold(f.Events, string(t))
+}
+
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+
+	return false
+}
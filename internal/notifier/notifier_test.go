@@ -0,0 +1,227 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/sportsmatrix"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		filter   *Filter
+		event    *sportsmatrix.Event
+		expected bool
+	}{
+		{
+			name:     "default matrix event",
+			filter:   &Filter{},
+			event:    &sportsmatrix.Event{Type: sportsmatrix.BoardChanged},
+			expected: true,
+		},
+		{
+			name:     "default skips period changes",
+			filter:   &Filter{},
+			event:    &sportsmatrix.Event{Type: sportsmatrix.PeriodChanged, Favorite: true},
+			expected: false,
+		},
+		{
+			name:     "default favorites only",
+			filter:   &Filter{},
+			event:    &sportsmatrix.Event{Type: sportsmatrix.ScoreChanged},
+			expected: false,
+		},
+		{
+			name:     "all games",
+			filter:   &Filter{AllGames: true},
+			event:    &sportsmatrix.Event{Type: sportsmatrix.ScoreChanged},
+			expected: true,
+		},
+		{
+			name:     "league",
+			filter:   &Filter{Leagues: []string{"nhl"}},
+			event:    &sportsmatrix.Event{Type: sportsmatrix.GameFinal, League: "NFL", Favorite: true},
+			expected: false,
+		},
+		{
+			name:     "team",
+			filter:   &Filter{Events: []string{"scorechanged"}, Teams: []string{"PIT"}, AllGames: true},
+			event:    &sportsmatrix.Event{Type: sportsmatrix.ScoreChanged, Home: "CLE", Away: "PIT"},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, test.expected, test.filter.matches(test.event))
+		})
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	received := make(chan *sportsmatrix.Event, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+		var e *sportsmatrix.Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		received <- e
+	}))
+	defer server.Close()
+
+	n, err := New(&Config{
+		Webhooks: []*WebhookConfig{
+			{
+				URL:      server.URL,
+				Headers:  map[string]string{"Authorization": "secret"},
+				Delivery: &Delivery{Backoff: "1ms"},
+			},
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, target := range n.targets {
+		go n.deliver(ctx, target)
+	}
+	n.Notify(&sportsmatrix.Event{Type: sportsmatrix.ScreenOff})
+
+	e := <-received
+	require.Equal(t, sportsmatrix.ScreenOff, e.Type)
+	require.GreaterOrEqual(t, atomic.LoadInt32(&calls), int32(2))
+}
+
+func TestMQTT(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	topics := make(chan string, 1)
+	keepAlives := make(chan int, 1)
+	pinged := make(chan struct{})
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		broker := &mqttPublisher{conn: conn, reader: bufio.NewReader(conn)}
+
+		packetType, body, err := broker.readPacket()
+		if err != nil || packetType != mqttConnect {
+			return
+		}
+		keepAlives <- int(body[8])<<8 | int(body[9])
+		if err := broker.writePacket(mqttConnack, []byte{0x00, 0x00}); err != nil {
+			return
+		}
+
+		packetType, body, err = broker.readPacket()
+		if err != nil || packetType != mqttPublish {
+			return
+		}
+		topicLen := int(body[0])<<8 | int(body[1])
+		topics <- string(body[2 : 2+topicLen])
+		_ = broker.writePacket(mqttPuback, body[2+topicLen:4+topicLen])
+
+		packetType, _, err = broker.readPacket()
+		if err != nil || packetType != mqttPingreq {
+			return
+		}
+		_ = broker.writePacket(mqttPingresp, nil)
+		close(pinged)
+		_, _ = io.Copy(io.Discard, conn)
+	}()
+
+	config := &MQTTConfig{
+		Broker: listener.Addr().String(),
+		Topic:  "home/matrix/",
+	}
+	config.SetDefaults()
+
+	m := newMQTTPublisher(config)
+	m.keepAlive = 2 * time.Second
+	require.NoError(t, m.send(context.Background(), &sportsmatrix.Event{Type: sportsmatrix.GameFinal}, []byte("{}")))
+	require.Equal(t, 2, <-keepAlives)
+	require.Equal(t, "home/matrix/GameFinal", <-topics)
+
+	// The idle connection is kept alive with pings
+	select {
+	case <-pinged:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for PINGREQ")
+	}
+
+	m.Lock()
+	require.NotNil(t, m.conn)
+	m.close()
+	m.Unlock()
+}
+
+func TestDeliveryRetries(t *testing.T) {
+	t.Parallel()
+
+	intPtr := func(i int) *int {
+		return &i
+	}
+
+	tests := []struct {
+		name     string
+		retries  *int
+		expected int
+	}{
+		{
+			name:     "default",
+			expected: defaultRetries,
+		},
+		{
+			name:     "disabled",
+			retries:  intPtr(0),
+			expected: 0,
+		},
+		{
+			name:     "configured",
+			retries:  intPtr(5),
+			expected: 5,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			d := &Delivery{Retries: test.retries}
+			d.SetDefaults()
+			require.Equal(t, test.expected, d.retries)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/robbydyer/sports/internal/sportsmatrix"
+)
+
+type webhook struct {
+	config *WebhookConfig
+	client *http.Client
+}
+
+func newWebhook(config *WebhookConfig) *webhook {
+	return &webhook{
+		config: config,
+		client: &http.Client{
+			Timeout: config.timeout,
+		},
+	}
+}
+
+func (w *webhook) name() string {
+	return w.config.URL
+}
+
+func (w *webhook) send(ctx context.Context, event *sportsmatrix.Event, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sportsmatrix-Event", string(event.Type))
+	for k, v := range w.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/netmatrix"
+)
+
+// Config defines an output the matrix is drawn to, in addition to the main matrix
+type Config struct {
+	// Name identifies the output in logs
+	Name string `json:"name"`
+	// Type is the kind of output, ie. "console". See Types() for the registered types.
+	Type   string `json:"type"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	// Boards are the names of the boards the output rotates through on its own. If empty,
+	// the output mirrors the main matrix.
+	Boards []string `json:"boards"`
+	// Options are settings specific to the Type of output
+	Options json.RawMessage `json:"options"`
+}
+
+// Factory builds the matrix.Matrix of an output
+type Factory func(cfg *Config, logger *zap.Logger) (matrix.Matrix, error)
+
+var (
+	factories    = map[string]Factory{}
+	factoryMutex sync.RWMutex
+)
+
+// networkOptions are the Options of a "network" output
+type networkOptions struct {
+	// Address is the host:port of the receiver, ie. "pizero.local:9191"
+	Address string `json:"address"`
+}
+
+// terminalOptions are the Options of a "terminal" output
+type terminalOptions struct {
+	// Scale is how many pixels, each half of a terminal cell, an LED is drawn as. Default is 1.
+	Scale int `json:"scale"`
+}
+
+// framebufferOptions are the Options of a "framebuffer" output
+type framebufferOptions struct {
+	// Device is the framebuffer device. Default is /dev/fb0.
+	Device string `json:"device"`
+}
+
+func init() {
+	Register("console", func(cfg *Config, logger *zap.Logger) (matrix.Matrix, error) {
+		return matrix.NewConsoleMatrix(cfg.Width, cfg.Height, os.Stdout, logger), nil
+	})
+	Register("terminal", func(cfg *Config, logger *zap.Logger) (matrix.Matrix, error) {
+		var opts terminalOptions
+		if err := cfg.decodeOptions(&opts); err != nil {
+			return nil, err
+		}
+		return matrix.NewTerminalMatrix(cfg.Width, cfg.Height, opts.Scale, os.Stdout, logger), nil
+	})
+	Register("framebuffer", func(cfg *Config, logger *zap.Logger) (matrix.Matrix, error) {
+		var opts framebufferOptions
+		if err := cfg.decodeOptions(&opts); err != nil {
+			return nil, err
+		}
+		if opts.Device == "" {
+			opts.Device = "/dev/fb0"
+		}
+		return matrix.NewFramebufferMatrix(cfg.Width, cfg.Height, opts.Device, logger)
+	})
+	Register("network", func(cfg *Config, logger *zap.Logger) (matrix.Matrix, error) {
+		var opts networkOptions
+		if err := cfg.decodeOptions(&opts); err != nil {
+			return nil, err
+		}
+		if opts.Address == "" {
+			return nil, fmt.Errorf("network output requires an address option")
+		}
+		return netmatrix.New(opts.Address, cfg.Width, cfg.Height, logger), nil
+	})
+}
+
+// Register registers the Factory of a type of output. Registering a type again replaces it.
+func Register(outputType string, f Factory) {
+	factoryMutex.Lock()
+	defer factoryMutex.Unlock()
+
+	factories[strings.ToLower(outputType)] = f
+}
+
+// Types returns the registered types of outputs
+func Types() []string {
+	factoryMutex.RLock()
+	defer factoryMutex.RUnlock()
+
+	types := make([]string, 0, len(factories))
+	for t := range factories {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// SetDefaults sets config defaults
+func (c *Config) SetDefaults() {
+	if c.Width == 0 {
+		c.Width = 64
+	}
+	if c.Height == 0 {
+		c.Height = 32
+	}
+	if c.Name == "" {
+		c.Name = c.Type
+	}
+}
+
+// Validate validates the config
+func (c *Config) Validate() error {
+	if strings.EqualFold(c.Type, "rgb") {
+		return fmt.Errorf("output %s: the rgb matrix library can only drive the main matrix. Chain panels in hardwareConfig, or use a network output to a receiver", c.Name)
+	}
+
+	factoryMutex.RLock()
+	_, ok := factories[strings.ToLower(c.Type)]
+	factoryMutex.RUnlock()
+	if !ok {
+		return fmt.Errorf("output %s: unknown type '%s', must be one of %s", c.Name, c.Type, strings.Join(Types(), ", "))
+	}
+
+	if c.Width < 1 || c.Height < 1 {
+		return fmt.Errorf("output %s: invalid size %dx%d", c.Name, c.Width, c.Height)
+	}
+
+	return nil
+}
+
+// decodeOptions decodes the Options of the output's Type
+func (c *Config) decodeOptions(opts interface{}) error {
+	if len(c.Options) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(c.Options, opts); err != nil {
+		return fmt.Errorf("invalid %s output options: %w", c.Type, err)
+	}
+	return nil
+}
+
+// Mirror returns true if the output mirrors the main matrix
+func (c *Config) Mirror() bool {
+	return len(c.Boards) < 1
+}
+
+// New builds the matrix.Matrix of the output
+func New(cfg *Config, logger *zap.Logger) (matrix.Matrix, error) {
+	cfg.SetDefaults()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	factoryMutex.RLock()
+	f := factories[strings.ToLower(cfg.Type)]
+	factoryMutex.RUnlock()
+
+	m, err := f(cfg, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output %s: %w", cfg.Name, err)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		cfg    *Config
+		hasErr bool
+	}{
+		{
+			name: "console",
+			cfg:  &Config{Type: "console", Width: 32, Height: 16},
+		},
+		{
+			name: "default size",
+			cfg:  &Config{Type: "Console"},
+		},
+		{
+			name:   "unknown type",
+			cfg:    &Config{Type: "hologram"},
+			hasErr: true,
+		},
+		{
+			name:   "rgb",
+			cfg:    &Config{Type: "rgb"},
+			hasErr: true,
+		},
+		{
+			name: "network",
+			cfg:  &Config{Type: "network", Options: []byte(`{"address": "127.0.0.1:9191"}`)},
+		},
+		{
+			name:   "network without address",
+			cfg:    &Config{Type: "network"},
+			hasErr: true,
+		},
+		{
+			name: "terminal",
+			cfg:  &Config{Type: "terminal", Options: []byte(`{"scale": 2}`)},
+		},
+		{
+			name:   "invalid options",
+			cfg:    &Config{Type: "terminal", Options: []byte(`{"scale": "big"}`)},
+			hasErr: true,
+		},
+		{
+			name:   "missing framebuffer",
+			cfg:    &Config{Type: "framebuffer", Options: []byte(`{"device": "/dev/nofb"}`)},
+			hasErr: true,
+		},
+		{
+			name:   "invalid size",
+			cfg:    &Config{Type: "console", Width: -1},
+			hasErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := New(test.cfg, zap.NewNop())
+			if test.hasErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			w, h := m.Geometry()
+			require.Equal(t, test.cfg.Width, w)
+			require.Equal(t, test.cfg.Height, h)
+		})
+	}
+}
@@ -0,0 +1,354 @@
+package playlist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const minutesPerDay = 24 * 60
+
+var dayNames = map[string][]time.Weekday{
+	"sun":       {time.Sunday},
+	"sunday":    {time.Sunday},
+	"mon":       {time.Monday},
+	"monday":    {time.Monday},
+	"tue":       {time.Tuesday},
+	"tuesday":   {time.Tuesday},
+	"wed":       {time.Wednesday},
+	"wednesday": {time.Wednesday},
+	"thu":       {time.Thursday},
+	"thursday":  {time.Thursday},
+	"fri":       {time.Friday},
+	"friday":    {time.Friday},
+	"sat":       {time.Saturday},
+	"saturday":  {time.Saturday},
+	"weekdays":  {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekends":  {time.Saturday, time.Sunday},
+}
+
+// Config defines a named playlist
+type Config struct {
+	Name string `json:"name"`
+	// Windows are when the playlist is automatically active. A playlist without
+	// windows is only active when it is the default or is selected manually.
+	Windows []*Window `json:"windows"`
+	Entries []*Entry  `json:"entries"`
+}
+
+// Entry is a board in a playlist
+type Entry struct {
+	// Board is the name of the board, as used when jumping to a board
+	Board string `json:"board"`
+	// Dwell is how long the board stays on screen. The board is rendered repeatedly
+	// until the dwell time is up. If empty, the board is rendered once.
+	Dwell string `json:"dwell"`
+	// Weight is how many times the board is shown in each cycle of the playlist. Defaults to 1.
+	Weight int `json:"weight"`
+	// Windows limit when the entry is shown
+	Windows []*Window `json:"windows"`
+	dwell   time.Duration
+}
+
+// Window is a time of day range on certain days of the week
+type Window struct {
+	// Days is a list of days, ie. "mon", "tuesday", "weekdays" or "weekends". Defaults to every day.
+	Days []string `json:"days"`
+	// Start is the time of day in 24 hour HH:MM format the window opens. Defaults to 00:00
+	Start string `json:"start"`
+	// End is the time of day in 24 hour HH:MM format the window closes. Defaults to 24:00.
+	// If End is before Start, the window ends the following day.
+	End   string `json:"end"`
+	days  map[time.Weekday]struct{}
+	start int
+	end   int
+}
+
+// Playlist is an ordered set of boards
+type Playlist struct {
+	Name    string
+	Entries []*Entry
+	windows []*Window
+}
+
+// Playlists chooses which Playlist is active
+type Playlists struct {
+	log             *zap.Logger
+	playlists       []*Playlist
+	defaultPlaylist string
+	override        string
+	sync.Mutex
+}
+
+// New ...
+func New(configs []*Config, defaultPlaylist string, logger *zap.Logger) (*Playlists, error) {
+	if len(configs) < 1 {
+		return nil, fmt.Errorf("no playlists configured")
+	}
+
+	p := &Playlists{
+		log:             logger,
+		defaultPlaylist: defaultPlaylist,
+	}
+
+	seen := make(map[string]struct{})
+	for _, c := range configs {
+		if c.Name == "" {
+			return nil, fmt.Errorf("playlist is missing a name")
+		}
+		if _, ok := seen[strings.ToLower(c.Name)]; ok {
+			return nil, fmt.Errorf("duplicate playlist %s", c.Name)
+		}
+		seen[strings.ToLower(c.Name)] = struct{}{}
+
+		pl := &Playlist{
+			Name:    c.Name,
+			windows: c.Windows,
+		}
+		for _, w := range c.Windows {
+			if err := w.parse(); err != nil {
+				return nil, fmt.Errorf("invalid window in playlist %s: %w", c.Name, err)
+			}
+		}
+		for _, e := range c.Entries {
+			if err := e.parse(); err != nil {
+				return nil, fmt.Errorf("invalid entry in playlist %s: %w", c.Name, err)
+			}
+			pl.Entries = append(pl.Entries, e)
+		}
+		if len(pl.Entries) < 1 {
+			return nil, fmt.Errorf("playlist %s has no entries", c.Name)
+		}
+
+		p.playlists = append(p.playlists, pl)
+	}
+
+	if defaultPlaylist != "" && p.get(defaultPlaylist) == nil {
+		return nil, fmt.Errorf("default playlist %s does not exist", defaultPlaylist)
+	}
+
+	return p, nil
+}
+
+// Names returns the names of all playlists
+func (p *Playlists) Names() []string {
+	names := []string{}
+	for _, pl := range p.playlists {
+		names = append(names, pl.Name)
+	}
+
+	return names
+}
+
+// Set overrides the schedule with the given playlist. An empty name returns to the schedule.
+func (p *Playlists) Set(name string) error {
+	if name != "" && p.get(name) == nil {
+		return fmt.Errorf("playlist %s does not exist", name)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	p.log.Info("setting playlist",
+		zap.String("playlist", name),
+	)
+	p.override = name
+
+	return nil
+}
+
+// Override returns the name of the manually selected playlist, if any
+func (p *Playlists) Override() string {
+	p.Lock()
+	defer p.Unlock()
+
+	return p.override
+}
+
+// Active returns the playlist that should be playing at the given time. A manually selected
+// playlist takes precedence, then the first playlist with a matching window, then the default.
+func (p *Playlists) Active(now time.Time) *Playlist {
+	if o := p.Override(); o != "" {
+		if pl := p.get(o); pl != nil {
+			return pl
+		}
+	}
+
+	for _, pl := range p.playlists {
+		for _, w := range pl.windows {
+			if w.contains(now) {
+				return pl
+			}
+		}
+	}
+
+	if pl := p.get(p.defaultPlaylist); pl != nil {
+		return pl
+	}
+
+	return p.playlists[0]
+}
+
+func (p *Playlists) get(name string) *Playlist {
+	for _, pl := range p.playlists {
+		if strings.EqualFold(pl.Name, name) {
+			return pl
+		}
+	}
+
+	return nil
+}
+
+// Cycle returns one cycle of the playlist's entries that are within their windows at the given time.
+// Weighted entries are spread evenly throughout the cycle.
+func (pl *Playlist) Cycle(now time.Time) []*Entry {
+	var entries []*Entry
+	total := 0
+	for _, e := range pl.Entries {
+		if !e.inWindow(now) {
+			continue
+		}
+		entries = append(entries, e)
+		total += e.Weight
+	}
+
+	// Smooth weighted round-robin
+	current := make([]int, len(entries))
+	cycle := make([]*Entry, 0, total)
+	for n := 0; n < total; n++ {
+		best := 0
+		for i, e := range entries {
+			current[i] += e.Weight
+			if current[i] > current[best] {
+				best = i
+			}
+		}
+		current[best] -= total
+		cycle = append(cycle, entries[best])
+	}
+
+	return cycle
+}
+
+// DwellTime returns how long the entry stays on screen. Zero means the board is rendered once.
+func (e *Entry) DwellTime() time.Duration {
+	return e.dwell
+}
+
+func (e *Entry) parse() error {
+	if e.Board == "" {
+		return fmt.Errorf("entry is missing a board")
+	}
+	if e.Weight < 1 {
+		e.Weight = 1
+	}
+	if e.Dwell != "" {
+		d, err := time.ParseDuration(e.Dwell)
+		if err != nil {
+			return fmt.Errorf("invalid dwell for %s: %w", e.Board, err)
+		}
+		e.dwell = d
+	}
+	for _, w := range e.Windows {
+		if err := w.parse(); err != nil {
+			return fmt.Errorf("invalid window for %s: %w", e.Board, err)
+		}
+	}
+
+	return nil
+}
+
+func (e *Entry) inWindow(now time.Time) bool {
+	if len(e.Windows) < 1 {
+		return true
+	}
+	for _, w := range e.Windows {
+		if w.contains(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *Window) parse() error {
+	w.days = make(map[time.Weekday]struct{})
+	for _, d := range w.Days {
+		days, ok := dayNames[strings.ToLower(d)]
+		if !ok {
+			return fmt.Errorf("unknown day '%s'", d)
+		}
+		for _, day := range days {
+			w.days[day] = struct{}{}
+		}
+	}
+
+	var err error
+	w.start = 0
+	if w.Start != "" {
+		w.start, err = parseClock(w.Start)
+		if err != nil {
+			return err
+		}
+	}
+	w.end = minutesPerDay
+	if w.End != "" {
+		w.end, err = parseClock(w.End)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *Window) onDay(d time.Weekday) bool {
+	if len(w.days) < 1 {
+		return true
+	}
+	_, ok := w.days[d]
+	return ok
+}
+
+func (w *Window) contains(now time.Time) bool {
+	minute := now.Hour()*60 + now.Minute()
+
+	if w.start < w.end {
+		return w.onDay(now.Weekday()) && minute >= w.start && minute < w.end
+	}
+
+	// The window wraps past midnight
+	if minute >= w.start {
+		return w.onDay(now.Weekday())
+	}
+	if minute < w.end {
+		return w.onDay(now.AddDate(0, 0, -1).Weekday())
+	}
+
+	return false
+}
+
+// parseClock returns the minutes into the day of an HH:MM time
+func parseClock(s string) (int, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid time '%s', expected HH:MM", s)
+	}
+	h, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time '%s': %w", s, err)
+	}
+	m, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time '%s': %w", s, err)
+	}
+	if h < 0 || h > 24 || m < 0 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("invalid time '%s'", s)
+	}
+
+	return h*60 + m, nil
+}
@@ -0,0 +1,108 @@
+package playlist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWindow(t *testing.T) {
+	t.Parallel()
+
+	// Saturday
+	sat := time.Date(2022, 10, 15, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		window   *Window
+		at       time.Time
+		expected bool
+	}{
+		{
+			name:     "every day",
+			window:   &Window{},
+			at:       sat.Add(13 * time.Hour),
+			expected: true,
+		},
+		{
+			name:     "weekday morning on saturday",
+			window:   &Window{Days: []string{"weekdays"}, Start: "06:00", End: "09:30"},
+			at:       sat.Add(7 * time.Hour),
+			expected: false,
+		},
+		{
+			name:     "weekends",
+			window:   &Window{Days: []string{"weekends"}, Start: "12:00"},
+			at:       sat.Add(23 * time.Hour),
+			expected: true,
+		},
+		{
+			name:     "overnight wraps",
+			window:   &Window{Days: []string{"fri"}, Start: "23:00", End: "06:00"},
+			at:       sat.Add(2 * time.Hour),
+			expected: true,
+		},
+		{
+			name:     "overnight after end",
+			window:   &Window{Days: []string{"fri"}, Start: "23:00", End: "06:00"},
+			at:       sat.Add(7 * time.Hour),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			require.NoError(t, test.window.parse())
+			require.Equal(t, test.expected, test.window.contains(test.at))
+		})
+	}
+}
+
+func TestPlaylists(t *testing.T) {
+	t.Parallel()
+
+	// Saturday afternoon
+	sat := time.Date(2022, 10, 15, 14, 0, 0, 0, time.Local)
+
+	p, err := New([]*Config{
+		{
+			Name: "everyday",
+			Entries: []*Entry{
+				{Board: "clock"},
+				{Board: "NFL", Weight: 2, Dwell: "5m"},
+				{Board: "stocks", Windows: []*Window{{Days: []string{"weekdays"}}}},
+			},
+		},
+		{
+			Name:    "game day",
+			Windows: []*Window{{Days: []string{"sat"}, Start: "12:00"}},
+			Entries: []*Entry{
+				{Board: "NCAAF"},
+			},
+		},
+	}, "everyday", zap.NewNop())
+	require.NoError(t, err)
+
+	require.Equal(t, "game day", p.Active(sat).Name)
+	require.Equal(t, "everyday", p.Active(sat.Add(-4*time.Hour)).Name)
+
+	require.NoError(t, p.Set("EVERYDAY"))
+	require.Equal(t, "everyday", p.Active(sat).Name)
+	require.Error(t, p.Set("nope"))
+	require.NoError(t, p.Set(""))
+	require.Equal(t, "game day", p.Active(sat).Name)
+
+	var boards []string
+	for _, e := range p.get("everyday").Cycle(sat) {
+		boards = append(boards, e.Board)
+	}
+	require.Equal(t, []string{"NFL", "clock", "NFL"}, boards)
+	require.Equal(t, 5*time.Minute, p.get("everyday").Entries[1].DwellTime())
+
+	_, err = New([]*Config{{Name: "bad", Entries: []*Entry{{Board: "clock", Dwell: "forever"}}}}, "", zap.NewNop())
+	require.Error(t, err)
+}
@@ -73,9 +73,10 @@ type Status struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ScreenOn       bool `protobuf:"varint,1,opt,name=screen_on,json=screenOn,proto3" json:"screen_on,omitempty"`
-	WebboardOn     bool `protobuf:"varint,2,opt,name=webboard_on,json=webboardOn,proto3" json:"webboard_on,omitempty"`
-	CombinedScroll bool `protobuf:"varint,3,opt,name=combined_scroll,json=combinedScroll,proto3" json:"combined_scroll,omitempty"`
+	ScreenOn        bool     `protobuf:"varint,1,opt,name=screen_on,json=screenOn,proto3" json:"screen_on,omitempty"`
+	WebboardOn      bool     `protobuf:"varint,2,opt,name=webboard_on,json=webboardOn,proto3" json:"webboard_on,omitempty"`
+	CombinedScroll  bool     `protobuf:"varint,3,opt,name=combined_scroll,json=combinedScroll,proto3" json:"combined_scroll,omitempty"`
+	RestartRequired []string `protobuf:"bytes,4,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
 }
 
 func (x *Status) Reset() {
@@ -131,6 +132,13 @@ func (x *Status) GetCombinedScroll() bool {
 	return false
 }
 
+func (x *Status) GetRestartRequired() []string {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return nil
+}
+
 type SetAllReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -272,6 +280,242 @@ func (x *LiveOnlyReq) GetLiveOnly() bool {
 	return false
 }
 
+type PlaylistsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Playlists []string `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	Active    string   `protobuf:"bytes,2,opt,name=active,proto3" json:"active,omitempty"`
+	Selected  string   `protobuf:"bytes,3,opt,name=selected,proto3" json:"selected,omitempty"`
+}
+
+func (x *PlaylistsResp) Reset() {
+	*x = PlaylistsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistsResp) ProtoMessage() {}
+
+func (x *PlaylistsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistsResp.ProtoReflect.Descriptor instead.
+func (*PlaylistsResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlaylistsResp) GetPlaylists() []string {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+func (x *PlaylistsResp) GetActive() string {
+	if x != nil {
+		return x.Active
+	}
+	return ""
+}
+
+func (x *PlaylistsResp) GetSelected() string {
+	if x != nil {
+		return x.Selected
+	}
+	return ""
+}
+
+type SetPlaylistReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Playlist string `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+}
+
+func (x *SetPlaylistReq) Reset() {
+	*x = SetPlaylistReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlaylistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlaylistReq) ProtoMessage() {}
+
+func (x *SetPlaylistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlaylistReq.ProtoReflect.Descriptor instead.
+func (*SetPlaylistReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetPlaylistReq) GetPlaylist() string {
+	if x != nil {
+		return x.Playlist
+	}
+	return ""
+}
+
+type BrightnessResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brightness int32  `protobuf:"varint,1,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	Auto       bool   `protobuf:"varint,2,opt,name=auto,proto3" json:"auto,omitempty"`
+	Sunrise    string `protobuf:"bytes,3,opt,name=sunrise,proto3" json:"sunrise,omitempty"`
+	Sunset     string `protobuf:"bytes,4,opt,name=sunset,proto3" json:"sunset,omitempty"`
+}
+
+func (x *BrightnessResp) Reset() {
+	*x = BrightnessResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrightnessResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrightnessResp) ProtoMessage() {}
+
+func (x *BrightnessResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrightnessResp.ProtoReflect.Descriptor instead.
+func (*BrightnessResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BrightnessResp) GetBrightness() int32 {
+	if x != nil {
+		return x.Brightness
+	}
+	return 0
+}
+
+func (x *BrightnessResp) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+func (x *BrightnessResp) GetSunrise() string {
+	if x != nil {
+		return x.Sunrise
+	}
+	return ""
+}
+
+func (x *BrightnessResp) GetSunset() string {
+	if x != nil {
+		return x.Sunset
+	}
+	return ""
+}
+
+type SetBrightnessReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brightness int32 `protobuf:"varint,1,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	Auto       bool  `protobuf:"varint,2,opt,name=auto,proto3" json:"auto,omitempty"`
+}
+
+func (x *SetBrightnessReq) Reset() {
+	*x = SetBrightnessReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBrightnessReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBrightnessReq) ProtoMessage() {}
+
+func (x *SetBrightnessReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBrightnessReq.ProtoReflect.Descriptor instead.
+func (*SetBrightnessReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetBrightnessReq) GetBrightness() int32 {
+	if x != nil {
+		return x.Brightness
+	}
+	return 0
+}
+
+func (x *SetBrightnessReq) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
 var File_sportsmatrix_sportsmatrix_proto protoreflect.FileDescriptor
 
 var file_sportsmatrix_sportsmatrix_proto_rawDesc = []byte{
@@ -282,70 +526,111 @@ var file_sportsmatrix_sportsmatrix_proto_rawDesc = []byte{
 	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x27, 0x0a, 0x0b, 0x56, 0x65, 0x72,
 	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x77, 0x65, 0x62, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x63, 0x72,
-	0x6f, 0x6c, 0x6c, 0x22, 0x25, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x07, 0x4a, 0x75,
-	0x6d, 0x70, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x2a, 0x0a, 0x0b, 0x4c,
-	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69,
-	0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c,
-	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x32, 0xd3, 0x05, 0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x39, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6d, 0x61,
-	0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x08, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x3b, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65,
+	0x62, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x77, 0x65, 0x62, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x63,
+	0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22,
+	0x25, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x07, 0x4a, 0x75, 0x6d, 0x70, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x2a, 0x0a, 0x0b, 0x4c, 0x69, 0x76, 0x65, 0x4f,
+	0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x4f,
+	0x6e, 0x6c, 0x79, 0x22, 0x61, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x0e, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x22, 0x46, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x61, 0x75, 0x74, 0x6f, 0x32, 0xe1, 0x07, 0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x6d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x39, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3a, 0x0a, 0x08, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
 	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x06,
-	0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x04, 0x4a, 0x75, 0x6d, 0x70, 0x12, 0x12, 0x2e, 0x6d,
-	0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x69,
-	0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x53, 0x70, 0x65, 0x65, 0x64, 0x55,
-	0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11,
+	0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11,
+	0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x53, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x3a, 0x0a, 0x08, 0x53, 0x6c, 0x6f, 0x77, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x16, 0x2e,
+	0x79, 0x12, 0x32, 0x0a, 0x04, 0x4a, 0x75, 0x6d, 0x70, 0x12, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x39, 0x5a,
-	0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x62, 0x62,
-	0x79, 0x64, 0x79, 0x65, 0x72, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x4f,
+	0x6e, 0x6c, 0x79, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x53, 0x70, 0x65, 0x65, 0x64, 0x55, 0x70, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a,
+	0x0a, 0x08, 0x53, 0x6c, 0x6f, 0x77, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0b,
+	0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x44, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x62, 0x62, 0x79, 0x64, 0x79, 0x65, 0x72,
+	0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x6d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -360,42 +645,54 @@ func file_sportsmatrix_sportsmatrix_proto_rawDescGZIP() []byte {
 	return file_sportsmatrix_sportsmatrix_proto_rawDescData
 }
 
-var file_sportsmatrix_sportsmatrix_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_sportsmatrix_sportsmatrix_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_sportsmatrix_sportsmatrix_proto_goTypes = []interface{}{
-	(*VersionResp)(nil), // 0: matrix.v1.VersionResp
-	(*Status)(nil),      // 1: matrix.v1.Status
-	(*SetAllReq)(nil),   // 2: matrix.v1.SetAllReq
-	(*JumpReq)(nil),     // 3: matrix.v1.JumpReq
-	(*LiveOnlyReq)(nil), // 4: matrix.v1.LiveOnlyReq
-	(*empty.Empty)(nil), // 5: google.protobuf.Empty
+	(*VersionResp)(nil),      // 0: matrix.v1.VersionResp
+	(*Status)(nil),           // 1: matrix.v1.Status
+	(*SetAllReq)(nil),        // 2: matrix.v1.SetAllReq
+	(*JumpReq)(nil),          // 3: matrix.v1.JumpReq
+	(*LiveOnlyReq)(nil),      // 4: matrix.v1.LiveOnlyReq
+	(*PlaylistsResp)(nil),    // 5: matrix.v1.PlaylistsResp
+	(*SetPlaylistReq)(nil),   // 6: matrix.v1.SetPlaylistReq
+	(*BrightnessResp)(nil),   // 7: matrix.v1.BrightnessResp
+	(*SetBrightnessReq)(nil), // 8: matrix.v1.SetBrightnessReq
+	(*empty.Empty)(nil),      // 9: google.protobuf.Empty
 }
 var file_sportsmatrix_sportsmatrix_proto_depIdxs = []int32{
-	5,  // 0: matrix.v1.Sportsmatrix.Version:input_type -> google.protobuf.Empty
-	5,  // 1: matrix.v1.Sportsmatrix.ScreenOn:input_type -> google.protobuf.Empty
-	5,  // 2: matrix.v1.Sportsmatrix.ScreenOff:input_type -> google.protobuf.Empty
-	5,  // 3: matrix.v1.Sportsmatrix.GetStatus:input_type -> google.protobuf.Empty
+	9,  // 0: matrix.v1.Sportsmatrix.Version:input_type -> google.protobuf.Empty
+	9,  // 1: matrix.v1.Sportsmatrix.ScreenOn:input_type -> google.protobuf.Empty
+	9,  // 2: matrix.v1.Sportsmatrix.ScreenOff:input_type -> google.protobuf.Empty
+	9,  // 3: matrix.v1.Sportsmatrix.GetStatus:input_type -> google.protobuf.Empty
 	1,  // 4: matrix.v1.Sportsmatrix.SetStatus:input_type -> matrix.v1.Status
 	2,  // 5: matrix.v1.Sportsmatrix.SetAll:input_type -> matrix.v1.SetAllReq
 	3,  // 6: matrix.v1.Sportsmatrix.Jump:input_type -> matrix.v1.JumpReq
-	5,  // 7: matrix.v1.Sportsmatrix.NextBoard:input_type -> google.protobuf.Empty
-	5,  // 8: matrix.v1.Sportsmatrix.RestartService:input_type -> google.protobuf.Empty
+	9,  // 7: matrix.v1.Sportsmatrix.NextBoard:input_type -> google.protobuf.Empty
+	9,  // 8: matrix.v1.Sportsmatrix.RestartService:input_type -> google.protobuf.Empty
 	4,  // 9: matrix.v1.Sportsmatrix.SetLiveOnly:input_type -> matrix.v1.LiveOnlyReq
-	5,  // 10: matrix.v1.Sportsmatrix.SpeedUp:input_type -> google.protobuf.Empty
-	5,  // 11: matrix.v1.Sportsmatrix.SlowDown:input_type -> google.protobuf.Empty
-	0,  // 12: matrix.v1.Sportsmatrix.Version:output_type -> matrix.v1.VersionResp
-	5,  // 13: matrix.v1.Sportsmatrix.ScreenOn:output_type -> google.protobuf.Empty
-	5,  // 14: matrix.v1.Sportsmatrix.ScreenOff:output_type -> google.protobuf.Empty
-	1,  // 15: matrix.v1.Sportsmatrix.GetStatus:output_type -> matrix.v1.Status
-	5,  // 16: matrix.v1.Sportsmatrix.SetStatus:output_type -> google.protobuf.Empty
-	5,  // 17: matrix.v1.Sportsmatrix.SetAll:output_type -> google.protobuf.Empty
-	5,  // 18: matrix.v1.Sportsmatrix.Jump:output_type -> google.protobuf.Empty
-	5,  // 19: matrix.v1.Sportsmatrix.NextBoard:output_type -> google.protobuf.Empty
-	5,  // 20: matrix.v1.Sportsmatrix.RestartService:output_type -> google.protobuf.Empty
-	5,  // 21: matrix.v1.Sportsmatrix.SetLiveOnly:output_type -> google.protobuf.Empty
-	5,  // 22: matrix.v1.Sportsmatrix.SpeedUp:output_type -> google.protobuf.Empty
-	5,  // 23: matrix.v1.Sportsmatrix.SlowDown:output_type -> google.protobuf.Empty
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
+	9,  // 10: matrix.v1.Sportsmatrix.SpeedUp:input_type -> google.protobuf.Empty
+	9,  // 11: matrix.v1.Sportsmatrix.SlowDown:input_type -> google.protobuf.Empty
+	9,  // 12: matrix.v1.Sportsmatrix.GetPlaylists:input_type -> google.protobuf.Empty
+	6,  // 13: matrix.v1.Sportsmatrix.SetPlaylist:input_type -> matrix.v1.SetPlaylistReq
+	9,  // 14: matrix.v1.Sportsmatrix.GetBrightness:input_type -> google.protobuf.Empty
+	8,  // 15: matrix.v1.Sportsmatrix.SetBrightness:input_type -> matrix.v1.SetBrightnessReq
+	0,  // 16: matrix.v1.Sportsmatrix.Version:output_type -> matrix.v1.VersionResp
+	9,  // 17: matrix.v1.Sportsmatrix.ScreenOn:output_type -> google.protobuf.Empty
+	9,  // 18: matrix.v1.Sportsmatrix.ScreenOff:output_type -> google.protobuf.Empty
+	1,  // 19: matrix.v1.Sportsmatrix.GetStatus:output_type -> matrix.v1.Status
+	9,  // 20: matrix.v1.Sportsmatrix.SetStatus:output_type -> google.protobuf.Empty
+	9,  // 21: matrix.v1.Sportsmatrix.SetAll:output_type -> google.protobuf.Empty
+	9,  // 22: matrix.v1.Sportsmatrix.Jump:output_type -> google.protobuf.Empty
+	9,  // 23: matrix.v1.Sportsmatrix.NextBoard:output_type -> google.protobuf.Empty
+	9,  // 24: matrix.v1.Sportsmatrix.RestartService:output_type -> google.protobuf.Empty
+	9,  // 25: matrix.v1.Sportsmatrix.SetLiveOnly:output_type -> google.protobuf.Empty
+	9,  // 26: matrix.v1.Sportsmatrix.SpeedUp:output_type -> google.protobuf.Empty
+	9,  // 27: matrix.v1.Sportsmatrix.SlowDown:output_type -> google.protobuf.Empty
+	5,  // 28: matrix.v1.Sportsmatrix.GetPlaylists:output_type -> matrix.v1.PlaylistsResp
+	9,  // 29: matrix.v1.Sportsmatrix.SetPlaylist:output_type -> google.protobuf.Empty
+	7,  // 30: matrix.v1.Sportsmatrix.GetBrightness:output_type -> matrix.v1.BrightnessResp
+	9,  // 31: matrix.v1.Sportsmatrix.SetBrightness:output_type -> google.protobuf.Empty
+	16, // [16:32] is the sub-list for method output_type
+	0,  // [0:16] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -467,6 +764,54 @@ func file_sportsmatrix_sportsmatrix_proto_init() {
 				return nil
 			}
 		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlaylistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrightnessResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBrightnessReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -474,7 +819,7 @@ func file_sportsmatrix_sportsmatrix_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sportsmatrix_sportsmatrix_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
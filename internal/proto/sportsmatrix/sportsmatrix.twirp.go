@@ -57,6 +57,14 @@ type Sportsmatrix interface {
 	SpeedUp(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
 
 	SlowDown(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+
+	GetPlaylists(context.Context, *google_protobuf.Empty) (*PlaylistsResp, error)
+
+	SetPlaylist(context.Context, *SetPlaylistReq) (*google_protobuf.Empty, error)
+
+	GetBrightness(context.Context, *google_protobuf.Empty) (*BrightnessResp, error)
+
+	SetBrightness(context.Context, *SetBrightnessReq) (*google_protobuf.Empty, error)
 }
 
 // ============================
@@ -65,7 +73,7 @@ type Sportsmatrix interface {
 
 type sportsmatrixProtobufClient struct {
 	client      HTTPClient
-	urls        [12]string
+	urls        [16]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -93,7 +101,7 @@ func NewSportsmatrixProtobufClient(baseURL string, client HTTPClient, opts ...tw
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Sportsmatrix")
-	urls := [12]string{
+	urls := [16]string{
 		serviceURL + "Version",
 		serviceURL + "ScreenOn",
 		serviceURL + "ScreenOff",
@@ -106,6 +114,10 @@ func NewSportsmatrixProtobufClient(baseURL string, client HTTPClient, opts ...tw
 		serviceURL + "SetLiveOnly",
 		serviceURL + "SpeedUp",
 		serviceURL + "SlowDown",
+		serviceURL + "GetPlaylists",
+		serviceURL + "SetPlaylist",
+		serviceURL + "GetBrightness",
+		serviceURL + "SetBrightness",
 	}
 
 	return &sportsmatrixProtobufClient{
@@ -668,13 +680,197 @@ func (c *sportsmatrixProtobufClient) callSlowDown(ctx context.Context, in *googl
 	return out, nil
 }
 
+func (c *sportsmatrixProtobufClient) GetPlaylists(ctx context.Context, in *google_protobuf.Empty) (*PlaylistsResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	caller := c.callGetPlaylists
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*PlaylistsResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PlaylistsResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PlaylistsResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixProtobufClient) callGetPlaylists(ctx context.Context, in *google_protobuf.Empty) (*PlaylistsResp, error) {
+	out := new(PlaylistsResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixProtobufClient) SetPlaylist(ctx context.Context, in *SetPlaylistReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlaylist")
+	caller := c.callSetPlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPlaylistReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPlaylistReq) when calling interceptor")
+					}
+					return c.callSetPlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixProtobufClient) callSetPlaylist(ctx context.Context, in *SetPlaylistReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixProtobufClient) GetBrightness(ctx context.Context, in *google_protobuf.Empty) (*BrightnessResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBrightness")
+	caller := c.callGetBrightness
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*BrightnessResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetBrightness(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*BrightnessResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*BrightnessResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixProtobufClient) callGetBrightness(ctx context.Context, in *google_protobuf.Empty) (*BrightnessResp, error) {
+	out := new(BrightnessResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixProtobufClient) SetBrightness(ctx context.Context, in *SetBrightnessReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetBrightness")
+	caller := c.callSetBrightness
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetBrightnessReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetBrightnessReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetBrightnessReq) when calling interceptor")
+					}
+					return c.callSetBrightness(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixProtobufClient) callSetBrightness(ctx context.Context, in *SetBrightnessReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ========================
 // Sportsmatrix JSON Client
 // ========================
 
 type sportsmatrixJSONClient struct {
 	client      HTTPClient
-	urls        [12]string
+	urls        [16]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -702,7 +898,7 @@ func NewSportsmatrixJSONClient(baseURL string, client HTTPClient, opts ...twirp.
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Sportsmatrix")
-	urls := [12]string{
+	urls := [16]string{
 		serviceURL + "Version",
 		serviceURL + "ScreenOn",
 		serviceURL + "ScreenOff",
@@ -715,6 +911,10 @@ func NewSportsmatrixJSONClient(baseURL string, client HTTPClient, opts ...twirp.
 		serviceURL + "SetLiveOnly",
 		serviceURL + "SpeedUp",
 		serviceURL + "SlowDown",
+		serviceURL + "GetPlaylists",
+		serviceURL + "SetPlaylist",
+		serviceURL + "GetBrightness",
+		serviceURL + "SetBrightness",
 	}
 
 	return &sportsmatrixJSONClient{
@@ -1277,74 +1477,258 @@ func (c *sportsmatrixJSONClient) callSlowDown(ctx context.Context, in *google_pr
 	return out, nil
 }
 
-// ===========================
-// Sportsmatrix Server Handler
-// ===========================
-
-type sportsmatrixServer struct {
-	Sportsmatrix
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *sportsmatrixJSONClient) GetPlaylists(ctx context.Context, in *google_protobuf.Empty) (*PlaylistsResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	caller := c.callGetPlaylists
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*PlaylistsResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PlaylistsResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PlaylistsResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewSportsmatrixServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewSportsmatrixServer(svc Sportsmatrix, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *sportsmatrixJSONClient) callGetPlaylists(ctx context.Context, in *google_protobuf.Empty) (*PlaylistsResp, error) {
+	out := new(PlaylistsResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &sportsmatrixServer{
-		Sportsmatrix:     svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *sportsmatrixServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *sportsmatrixServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
-		return
-	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
-		return
+func (c *sportsmatrixJSONClient) SetPlaylist(ctx context.Context, in *SetPlaylistReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlaylist")
+	caller := c.callSetPlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPlaylistReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPlaylistReq) when calling interceptor")
+					}
+					return c.callSetPlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+	return caller(ctx, in)
 }
 
-// SportsmatrixPathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const SportsmatrixPathPrefix = "/twirp/matrix.v1.Sportsmatrix/"
-
-func (s *sportsmatrixServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+func (c *sportsmatrixJSONClient) callSetPlaylist(ctx context.Context, in *SetPlaylistReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) GetBrightness(ctx context.Context, in *google_protobuf.Empty) (*BrightnessResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBrightness")
+	caller := c.callGetBrightness
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*BrightnessResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetBrightness(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*BrightnessResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*BrightnessResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callGetBrightness(ctx context.Context, in *google_protobuf.Empty) (*BrightnessResp, error) {
+	out := new(BrightnessResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) SetBrightness(ctx context.Context, in *SetBrightnessReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetBrightness")
+	caller := c.callSetBrightness
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetBrightnessReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetBrightnessReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetBrightnessReq) when calling interceptor")
+					}
+					return c.callSetBrightness(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callSetBrightness(ctx context.Context, in *SetBrightnessReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// Sportsmatrix Server Handler
+// ===========================
+
+type sportsmatrixServer struct {
+	Sportsmatrix
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewSportsmatrixServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewSportsmatrixServer(svc Sportsmatrix, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &sportsmatrixServer{
+		Sportsmatrix:     svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *sportsmatrixServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *sportsmatrixServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// SportsmatrixPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const SportsmatrixPathPrefix = "/twirp/matrix.v1.Sportsmatrix/"
+
+func (s *sportsmatrixServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
 	ctx = ctxsetters.WithResponseWriter(ctx, resp)
 
 	var err error
@@ -1410,6 +1794,18 @@ func (s *sportsmatrixServer) ServeHTTP(resp http.ResponseWriter, req *http.Reque
 	case "SlowDown":
 		s.serveSlowDown(ctx, resp, req)
 		return
+	case "GetPlaylists":
+		s.serveGetPlaylists(ctx, resp, req)
+		return
+	case "SetPlaylist":
+		s.serveSetPlaylist(ctx, resp, req)
+		return
+	case "GetBrightness":
+		s.serveGetBrightness(ctx, resp, req)
+		return
+	case "SetBrightness":
+		s.serveSetBrightness(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -2032,7 +2428,727 @@ func (s *sportsmatrixServer) serveGetStatusJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Status and nil error while calling GetStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Status and nil error while calling GetStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.GetStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*Status, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.GetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Status)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Status) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Status
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Status and nil error while calling GetStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(Status)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.SetStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Status)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(Status)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.SetStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Status)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetAll(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetAllJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetAllProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveSetAllJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetAllReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.SetAll
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAllReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetAll. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetAllProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetAllReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.SetAll
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAllReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetAll. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveJump(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveJumpJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveJumpProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveJumpJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Jump")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(JumpReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.Jump
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JumpReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.Jump(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Jump. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveJumpProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Jump")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(JumpReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.Jump
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JumpReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.Jump(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Jump. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveNextBoard(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveNextBoardJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveNextBoardProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.NextBoard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.NextBoard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling NextBoard. nil responses are not supported"))
 		return
 	}
 
@@ -2058,9 +3174,9 @@ func (s *sportsmatrixServer) serveGetStatusJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2078,22 +3194,22 @@ func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp ht
 		return
 	}
 
-	handler := s.Sportsmatrix.GetStatus
+	handler := s.Sportsmatrix.NextBoard
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*Status, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.GetStatus(ctx, typedReq)
+					return s.Sportsmatrix.NextBoard(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Status)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Status) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2102,7 +3218,7 @@ func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *Status
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2113,7 +3229,7 @@ func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Status and nil error while calling GetStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling NextBoard. nil responses are not supported"))
 		return
 	}
 
@@ -2137,7 +3253,7 @@ func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveRestartService(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2145,9 +3261,9 @@ func (s *sportsmatrixServer) serveSetStatus(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetStatusJSON(ctx, resp, req)
+		s.serveRestartServiceJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetStatusProtobuf(ctx, resp, req)
+		s.serveRestartServiceProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2155,9 +3271,9 @@ func (s *sportsmatrixServer) serveSetStatus(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2170,23 +3286,23 @@ func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(Status)
+	reqContent := new(google_protobuf.Empty)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.SetStatus
+	handler := s.Sportsmatrix.RestartService
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Status)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetStatus(ctx, typedReq)
+					return s.Sportsmatrix.RestartService(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2212,7 +3328,7 @@ func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RestartService. nil responses are not supported"))
 		return
 	}
 
@@ -2238,9 +3354,9 @@ func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2252,22 +3368,22 @@ func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(Status)
+	reqContent := new(google_protobuf.Empty)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.SetStatus
+	handler := s.Sportsmatrix.RestartService
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Status)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetStatus(ctx, typedReq)
+					return s.Sportsmatrix.RestartService(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2293,7 +3409,7 @@ func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RestartService. nil responses are not supported"))
 		return
 	}
 
@@ -2317,7 +3433,7 @@ func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetAll(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetLiveOnly(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2325,9 +3441,9 @@ func (s *sportsmatrixServer) serveSetAll(ctx context.Context, resp http.Response
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetAllJSON(ctx, resp, req)
+		s.serveSetLiveOnlyJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetAllProtobuf(ctx, resp, req)
+		s.serveSetLiveOnlyProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2335,9 +3451,9 @@ func (s *sportsmatrixServer) serveSetAll(ctx context.Context, resp http.Response
 	}
 }
 
-func (s *sportsmatrixServer) serveSetAllJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
+	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2350,23 +3466,23 @@ func (s *sportsmatrixServer) serveSetAllJSON(ctx context.Context, resp http.Resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(SetAllReq)
+	reqContent := new(LiveOnlyReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.SetAll
+	handler := s.Sportsmatrix.SetLiveOnly
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetAllReq)
+					typedReq, ok := req.(*LiveOnlyReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetAll(ctx, typedReq)
+					return s.Sportsmatrix.SetLiveOnly(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2392,7 +3508,7 @@ func (s *sportsmatrixServer) serveSetAllJSON(ctx context.Context, resp http.Resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetAll. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetLiveOnly. nil responses are not supported"))
 		return
 	}
 
@@ -2418,9 +3534,9 @@ func (s *sportsmatrixServer) serveSetAllJSON(ctx context.Context, resp http.Resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetAllProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
+	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2432,22 +3548,22 @@ func (s *sportsmatrixServer) serveSetAllProtobuf(ctx context.Context, resp http.
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(SetAllReq)
+	reqContent := new(LiveOnlyReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.SetAll
+	handler := s.Sportsmatrix.SetLiveOnly
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetAllReq)
+					typedReq, ok := req.(*LiveOnlyReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetAll(ctx, typedReq)
+					return s.Sportsmatrix.SetLiveOnly(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2473,7 +3589,7 @@ func (s *sportsmatrixServer) serveSetAllProtobuf(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetAll. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetLiveOnly. nil responses are not supported"))
 		return
 	}
 
@@ -2497,7 +3613,7 @@ func (s *sportsmatrixServer) serveSetAllProtobuf(ctx context.Context, resp http.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveJump(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSpeedUp(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2505,9 +3621,9 @@ func (s *sportsmatrixServer) serveJump(ctx context.Context, resp http.ResponseWr
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveJumpJSON(ctx, resp, req)
+		s.serveSpeedUpJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveJumpProtobuf(ctx, resp, req)
+		s.serveSpeedUpProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2515,9 +3631,9 @@ func (s *sportsmatrixServer) serveJump(ctx context.Context, resp http.ResponseWr
 	}
 }
 
-func (s *sportsmatrixServer) serveJumpJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSpeedUpJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Jump")
+	ctx = ctxsetters.WithMethodName(ctx, "SpeedUp")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2530,23 +3646,23 @@ func (s *sportsmatrixServer) serveJumpJSON(ctx context.Context, resp http.Respon
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(JumpReq)
+	reqContent := new(google_protobuf.Empty)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.Jump
+	handler := s.Sportsmatrix.SpeedUp
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*JumpReq)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.Jump(ctx, typedReq)
+					return s.Sportsmatrix.SpeedUp(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2572,7 +3688,7 @@ func (s *sportsmatrixServer) serveJumpJSON(ctx context.Context, resp http.Respon
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Jump. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SpeedUp. nil responses are not supported"))
 		return
 	}
 
@@ -2598,9 +3714,9 @@ func (s *sportsmatrixServer) serveJumpJSON(ctx context.Context, resp http.Respon
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveJumpProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSpeedUpProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Jump")
+	ctx = ctxsetters.WithMethodName(ctx, "SpeedUp")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2612,22 +3728,22 @@ func (s *sportsmatrixServer) serveJumpProtobuf(ctx context.Context, resp http.Re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(JumpReq)
+	reqContent := new(google_protobuf.Empty)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.Jump
+	handler := s.Sportsmatrix.SpeedUp
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*JumpReq)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.Jump(ctx, typedReq)
+					return s.Sportsmatrix.SpeedUp(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2653,7 +3769,7 @@ func (s *sportsmatrixServer) serveJumpProtobuf(ctx context.Context, resp http.Re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Jump. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SpeedUp. nil responses are not supported"))
 		return
 	}
 
@@ -2677,7 +3793,7 @@ func (s *sportsmatrixServer) serveJumpProtobuf(ctx context.Context, resp http.Re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveNextBoard(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSlowDown(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2685,9 +3801,9 @@ func (s *sportsmatrixServer) serveNextBoard(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveNextBoardJSON(ctx, resp, req)
+		s.serveSlowDownJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveNextBoardProtobuf(ctx, resp, req)
+		s.serveSlowDownProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2695,9 +3811,9 @@ func (s *sportsmatrixServer) serveNextBoard(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSlowDownJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
+	ctx = ctxsetters.WithMethodName(ctx, "SlowDown")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2717,7 +3833,7 @@ func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.R
 		return
 	}
 
-	handler := s.Sportsmatrix.NextBoard
+	handler := s.Sportsmatrix.SlowDown
 	if s.interceptor != nil {
 		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
@@ -2726,7 +3842,7 @@ func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.R
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.NextBoard(ctx, typedReq)
+					return s.Sportsmatrix.SlowDown(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2752,7 +3868,7 @@ func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling NextBoard. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SlowDown. nil responses are not supported"))
 		return
 	}
 
@@ -2778,9 +3894,9 @@ func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSlowDownProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
+	ctx = ctxsetters.WithMethodName(ctx, "SlowDown")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2798,7 +3914,7 @@ func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp ht
 		return
 	}
 
-	handler := s.Sportsmatrix.NextBoard
+	handler := s.Sportsmatrix.SlowDown
 	if s.interceptor != nil {
 		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
@@ -2807,7 +3923,7 @@ func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp ht
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.NextBoard(ctx, typedReq)
+					return s.Sportsmatrix.SlowDown(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2833,7 +3949,7 @@ func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling NextBoard. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SlowDown. nil responses are not supported"))
 		return
 	}
 
@@ -2857,7 +3973,7 @@ func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveRestartService(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetPlaylists(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2865,9 +3981,9 @@ func (s *sportsmatrixServer) serveRestartService(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveRestartServiceJSON(ctx, resp, req)
+		s.serveGetPlaylistsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveRestartServiceProtobuf(ctx, resp, req)
+		s.serveGetPlaylistsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2875,9 +3991,9 @@ func (s *sportsmatrixServer) serveRestartService(ctx context.Context, resp http.
 	}
 }
 
-func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetPlaylistsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2897,22 +4013,22 @@ func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp h
 		return
 	}
 
-	handler := s.Sportsmatrix.RestartService
+	handler := s.Sportsmatrix.GetPlaylists
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*PlaylistsResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.RestartService(ctx, typedReq)
+					return s.Sportsmatrix.GetPlaylists(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*PlaylistsResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PlaylistsResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2921,7 +4037,7 @@ func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *PlaylistsResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2932,7 +4048,7 @@ func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RestartService. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PlaylistsResp and nil error while calling GetPlaylists. nil responses are not supported"))
 		return
 	}
 
@@ -2958,9 +4074,9 @@ func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetPlaylistsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2978,22 +4094,22 @@ func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, re
 		return
 	}
 
-	handler := s.Sportsmatrix.RestartService
+	handler := s.Sportsmatrix.GetPlaylists
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*PlaylistsResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.RestartService(ctx, typedReq)
+					return s.Sportsmatrix.GetPlaylists(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*PlaylistsResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PlaylistsResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3002,7 +4118,7 @@ func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *PlaylistsResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3013,7 +4129,7 @@ func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RestartService. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PlaylistsResp and nil error while calling GetPlaylists. nil responses are not supported"))
 		return
 	}
 
@@ -3037,7 +4153,7 @@ func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetLiveOnly(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetPlaylist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3045,9 +4161,9 @@ func (s *sportsmatrixServer) serveSetLiveOnly(ctx context.Context, resp http.Res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetLiveOnlyJSON(ctx, resp, req)
+		s.serveSetPlaylistJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetLiveOnlyProtobuf(ctx, resp, req)
+		s.serveSetPlaylistProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3055,9 +4171,9 @@ func (s *sportsmatrixServer) serveSetLiveOnly(ctx context.Context, resp http.Res
 	}
 }
 
-func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetPlaylistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlaylist")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3070,23 +4186,23 @@ func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(LiveOnlyReq)
+	reqContent := new(SetPlaylistReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.SetLiveOnly
+	handler := s.Sportsmatrix.SetPlaylist
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *SetPlaylistReq) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*LiveOnlyReq)
+					typedReq, ok := req.(*SetPlaylistReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SetPlaylistReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetLiveOnly(ctx, typedReq)
+					return s.Sportsmatrix.SetPlaylist(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3112,7 +4228,7 @@ func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetLiveOnly. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPlaylist. nil responses are not supported"))
 		return
 	}
 
@@ -3138,9 +4254,9 @@ func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetPlaylistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlaylist")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3152,22 +4268,22 @@ func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(LiveOnlyReq)
+	reqContent := new(SetPlaylistReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.SetLiveOnly
+	handler := s.Sportsmatrix.SetPlaylist
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *SetPlaylistReq) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*LiveOnlyReq)
+					typedReq, ok := req.(*SetPlaylistReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SetPlaylistReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetLiveOnly(ctx, typedReq)
+					return s.Sportsmatrix.SetPlaylist(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3193,7 +4309,7 @@ func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetLiveOnly. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPlaylist. nil responses are not supported"))
 		return
 	}
 
@@ -3217,7 +4333,7 @@ func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSpeedUp(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetBrightness(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3225,9 +4341,9 @@ func (s *sportsmatrixServer) serveSpeedUp(ctx context.Context, resp http.Respons
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSpeedUpJSON(ctx, resp, req)
+		s.serveGetBrightnessJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSpeedUpProtobuf(ctx, resp, req)
+		s.serveGetBrightnessProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3235,9 +4351,9 @@ func (s *sportsmatrixServer) serveSpeedUp(ctx context.Context, resp http.Respons
 	}
 }
 
-func (s *sportsmatrixServer) serveSpeedUpJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetBrightnessJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SpeedUp")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBrightness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3257,22 +4373,22 @@ func (s *sportsmatrixServer) serveSpeedUpJSON(ctx context.Context, resp http.Res
 		return
 	}
 
-	handler := s.Sportsmatrix.SpeedUp
+	handler := s.Sportsmatrix.GetBrightness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*BrightnessResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.SpeedUp(ctx, typedReq)
+					return s.Sportsmatrix.GetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*BrightnessResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*BrightnessResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3281,7 +4397,7 @@ func (s *sportsmatrixServer) serveSpeedUpJSON(ctx context.Context, resp http.Res
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *BrightnessResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3292,7 +4408,7 @@ func (s *sportsmatrixServer) serveSpeedUpJSON(ctx context.Context, resp http.Res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SpeedUp. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *BrightnessResp and nil error while calling GetBrightness. nil responses are not supported"))
 		return
 	}
 
@@ -3318,9 +4434,9 @@ func (s *sportsmatrixServer) serveSpeedUpJSON(ctx context.Context, resp http.Res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSpeedUpProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetBrightnessProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SpeedUp")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBrightness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3338,22 +4454,22 @@ func (s *sportsmatrixServer) serveSpeedUpProtobuf(ctx context.Context, resp http
 		return
 	}
 
-	handler := s.Sportsmatrix.SpeedUp
+	handler := s.Sportsmatrix.GetBrightness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*BrightnessResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.SpeedUp(ctx, typedReq)
+					return s.Sportsmatrix.GetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*BrightnessResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*BrightnessResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3362,7 +4478,7 @@ func (s *sportsmatrixServer) serveSpeedUpProtobuf(ctx context.Context, resp http
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *BrightnessResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3373,7 +4489,7 @@ func (s *sportsmatrixServer) serveSpeedUpProtobuf(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SpeedUp. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *BrightnessResp and nil error while calling GetBrightness. nil responses are not supported"))
 		return
 	}
 
@@ -3397,7 +4513,7 @@ func (s *sportsmatrixServer) serveSpeedUpProtobuf(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSlowDown(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetBrightness(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3405,9 +4521,9 @@ func (s *sportsmatrixServer) serveSlowDown(ctx context.Context, resp http.Respon
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSlowDownJSON(ctx, resp, req)
+		s.serveSetBrightnessJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSlowDownProtobuf(ctx, resp, req)
+		s.serveSetBrightnessProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3415,9 +4531,9 @@ func (s *sportsmatrixServer) serveSlowDown(ctx context.Context, resp http.Respon
 	}
 }
 
-func (s *sportsmatrixServer) serveSlowDownJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetBrightnessJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SlowDown")
+	ctx = ctxsetters.WithMethodName(ctx, "SetBrightness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3430,23 +4546,23 @@ func (s *sportsmatrixServer) serveSlowDownJSON(ctx context.Context, resp http.Re
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(google_protobuf.Empty)
+	reqContent := new(SetBrightnessReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.SlowDown
+	handler := s.Sportsmatrix.SetBrightness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *SetBrightnessReq) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*SetBrightnessReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SetBrightnessReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SlowDown(ctx, typedReq)
+					return s.Sportsmatrix.SetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3472,7 +4588,7 @@ func (s *sportsmatrixServer) serveSlowDownJSON(ctx context.Context, resp http.Re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SlowDown. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetBrightness. nil responses are not supported"))
 		return
 	}
 
@@ -3498,9 +4614,9 @@ func (s *sportsmatrixServer) serveSlowDownJSON(ctx context.Context, resp http.Re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSlowDownProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetBrightnessProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SlowDown")
+	ctx = ctxsetters.WithMethodName(ctx, "SetBrightness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3512,22 +4628,22 @@ func (s *sportsmatrixServer) serveSlowDownProtobuf(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(google_protobuf.Empty)
+	reqContent := new(SetBrightnessReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.SlowDown
+	handler := s.Sportsmatrix.SetBrightness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *SetBrightnessReq) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*SetBrightnessReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SetBrightnessReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SlowDown(ctx, typedReq)
+					return s.Sportsmatrix.SetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3553,7 +4669,7 @@ func (s *sportsmatrixServer) serveSlowDownProtobuf(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SlowDown. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetBrightness. nil responses are not supported"))
 		return
 	}
 
@@ -4158,34 +5274,47 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x51, 0x8b, 0xd3, 0x40,
-	0x10, 0xe6, 0xd4, 0x6b, 0x9b, 0xa9, 0x9c, 0xb8, 0x1c, 0x47, 0xb9, 0x3e, 0x54, 0x02, 0x72, 0xe2,
-	0x43, 0x82, 0x27, 0x9c, 0x54, 0x11, 0xf4, 0x50, 0x04, 0x11, 0x0b, 0x09, 0xfa, 0xe0, 0x4b, 0xc9,
-	0x26, 0xd3, 0x1a, 0xd8, 0xec, 0xc6, 0xdd, 0x6d, 0x7a, 0xf9, 0x9d, 0xfe, 0x21, 0xd9, 0x64, 0x57,
-	0x56, 0xbc, 0x1e, 0xe4, 0xf1, 0x9b, 0xf9, 0xbe, 0x9d, 0xe1, 0xfb, 0x86, 0x85, 0x85, 0xaa, 0x85,
-	0xd4, 0xaa, 0xca, 0xb4, 0x2c, 0x6f, 0x62, 0x1f, 0x44, 0xb5, 0x14, 0x5a, 0x90, 0xc0, 0xa2, 0xe6,
-	0xc5, 0xf9, 0x7c, 0x2b, 0xc4, 0x96, 0x61, 0xdc, 0x35, 0xe8, 0x6e, 0x13, 0x63, 0x55, 0xeb, 0xb6,
-	0xe7, 0x85, 0x17, 0x30, 0xfd, 0x8e, 0x52, 0x95, 0x82, 0x27, 0xa8, 0x6a, 0x32, 0x83, 0x71, 0xd3,
-	0xc3, 0xd9, 0xd1, 0x93, 0xa3, 0x67, 0x41, 0xe2, 0x60, 0x28, 0x60, 0x94, 0xea, 0x4c, 0xef, 0x14,
-	0x99, 0x43, 0xa0, 0x72, 0x89, 0xc8, 0xd7, 0x96, 0x35, 0x49, 0x26, 0x7d, 0x61, 0xc5, 0xc9, 0x02,
-	0xa6, 0x7b, 0xa4, 0x54, 0x64, 0xb2, 0x30, 0xed, 0x7b, 0x5d, 0x1b, 0x5c, 0x69, 0xc5, 0xc9, 0x05,
-	0x3c, 0xca, 0x45, 0x45, 0x4b, 0x8e, 0xc5, 0x5a, 0xe5, 0x52, 0x30, 0x36, 0xbb, 0xdf, 0x91, 0x4e,
-	0x5c, 0x39, 0xed, 0xaa, 0xe1, 0x53, 0x08, 0x52, 0xd4, 0xef, 0x19, 0x4b, 0xf0, 0x97, 0xd9, 0x0b,
-	0x79, 0x46, 0x19, 0x16, 0x76, 0xa2, 0x83, 0xe1, 0x02, 0xc6, 0x9f, 0x77, 0x55, 0x6d, 0x48, 0xa7,
-	0x70, 0xdc, 0x4d, 0xb1, 0xab, 0xf7, 0x20, 0x7c, 0x0e, 0xd3, 0x2f, 0x65, 0x83, 0x2b, 0xce, 0x5a,
-	0x43, 0x9a, 0x43, 0xc0, 0xca, 0x06, 0xd7, 0x82, 0xb3, 0xd6, 0x6d, 0xcf, 0x6c, 0xff, 0xf2, 0xf7,
-	0x31, 0x3c, 0x4c, 0x3d, 0x33, 0xc9, 0x12, 0xc6, 0xd6, 0x1e, 0x72, 0x16, 0xf5, 0x3e, 0x46, 0xce,
-	0xc7, 0xe8, 0xa3, 0xf1, 0xf1, 0xfc, 0x2c, 0xfa, 0x6b, 0x75, 0xe4, 0x5b, 0xf9, 0x1a, 0x26, 0xa9,
-	0x73, 0xe5, 0xb0, 0xf6, 0xd6, 0x3a, 0x79, 0x03, 0x81, 0xd5, 0x6e, 0x36, 0x83, 0xc5, 0x57, 0x10,
-	0x7c, 0x42, 0x6d, 0xc3, 0x3a, 0x24, 0x7e, 0xec, 0x6d, 0x6d, 0xa9, 0x57, 0x9d, 0xe1, 0x16, 0xfc,
-	0xdf, 0xbf, 0x63, 0xde, 0xa8, 0x0f, 0x8a, 0x9c, 0xfa, 0x22, 0x97, 0xdd, 0x41, 0xdd, 0x25, 0x3c,
-	0x30, 0xc9, 0x11, 0xe2, 0xa9, 0x6c, 0x94, 0x77, 0x19, 0xf3, 0x15, 0x6f, 0xf4, 0xb5, 0x49, 0x76,
-	0xb0, 0x31, 0xef, 0xe0, 0x24, 0x41, 0xa5, 0x33, 0xa9, 0x53, 0x94, 0x4d, 0x99, 0xe3, 0xe0, 0x17,
-	0xde, 0xc2, 0x34, 0x45, 0xed, 0xce, 0x89, 0xf8, 0xd1, 0x7b, 0x37, 0x76, 0x50, 0xbe, 0x84, 0x71,
-	0x5a, 0x23, 0x16, 0xdf, 0xea, 0xc1, 0x93, 0xcd, 0x35, 0x31, 0xb1, 0xff, 0x20, 0xf6, 0x83, 0xaf,
-	0xe9, 0x7a, 0xf9, 0xe3, 0xd5, 0xb6, 0xd4, 0x3f, 0x77, 0x34, 0xca, 0x45, 0x15, 0x4b, 0x41, 0x69,
-	0x5b, 0xb4, 0x28, 0xed, 0xb7, 0x11, 0x97, 0x5c, 0xa3, 0xe4, 0x19, 0xeb, 0x3f, 0x88, 0x7f, 0x3e,
-	0x13, 0x3a, 0xea, 0x6a, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xdc, 0xb9, 0x6b, 0x70,
-	0x04, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xe1, 0x6a, 0xdb, 0x3c,
+	0x14, 0x25, 0x5f, 0xd3, 0x24, 0xbe, 0x69, 0xd3, 0x7e, 0xa2, 0x94, 0x2c, 0x19, 0x6b, 0x31, 0x8c,
+	0x76, 0x63, 0x24, 0xac, 0x83, 0x8e, 0x6e, 0x0c, 0xba, 0xd0, 0xad, 0x30, 0xc6, 0x3a, 0x6c, 0xb6,
+	0x1f, 0xfb, 0x13, 0xec, 0xe4, 0x36, 0x35, 0x28, 0x92, 0x2b, 0xc9, 0x69, 0xf3, 0x2a, 0x7b, 0xbb,
+	0xbd, 0xc9, 0xb0, 0x2c, 0xa5, 0xca, 0x36, 0x17, 0xd2, 0x7f, 0x3e, 0x47, 0xf7, 0x5a, 0xe7, 0xca,
+	0xe7, 0x58, 0xb0, 0x27, 0x53, 0x2e, 0x94, 0x9c, 0x46, 0x4a, 0x24, 0xb7, 0x7d, 0x17, 0xf4, 0x52,
+	0xc1, 0x15, 0x27, 0x9e, 0x41, 0xb3, 0x97, 0x9d, 0xee, 0x84, 0xf3, 0x09, 0xc5, 0xbe, 0x5e, 0x88,
+	0xb3, 0xcb, 0x3e, 0x4e, 0x53, 0x35, 0x2f, 0xea, 0xfc, 0x03, 0x68, 0x7e, 0x47, 0x21, 0x13, 0xce,
+	0x02, 0x94, 0x29, 0x69, 0x43, 0x7d, 0x56, 0xc0, 0x76, 0x65, 0xbf, 0x72, 0xe8, 0x05, 0x16, 0xfa,
+	0x3f, 0x2b, 0x50, 0x0b, 0x55, 0xa4, 0x32, 0x49, 0xba, 0xe0, 0xc9, 0x91, 0x40, 0x64, 0x43, 0x53,
+	0xd6, 0x08, 0x1a, 0x05, 0x71, 0xc1, 0xc8, 0x1e, 0x34, 0x6f, 0x30, 0x8e, 0x79, 0x24, 0xc6, 0xf9,
+	0xf2, 0x7f, 0x7a, 0x19, 0x2c, 0x75, 0xc1, 0xc8, 0x01, 0x6c, 0x8d, 0xf8, 0x34, 0x4e, 0x18, 0x8e,
+	0x87, 0x72, 0x24, 0x38, 0xa5, 0xed, 0x35, 0x5d, 0xd4, 0xb2, 0x74, 0xa8, 0x59, 0xf2, 0x0c, 0xb6,
+	0x05, 0x4a, 0x15, 0x09, 0x35, 0x14, 0x78, 0x9d, 0x25, 0x02, 0xc7, 0xed, 0xea, 0xfe, 0xda, 0xa1,
+	0x17, 0x6c, 0x19, 0x3e, 0x30, 0xb4, 0xff, 0x14, 0xbc, 0x10, 0xd5, 0x7b, 0x4a, 0x03, 0xbc, 0xce,
+	0x67, 0x40, 0x16, 0xc5, 0x14, 0xc7, 0x46, 0x9c, 0x85, 0xfe, 0x1e, 0xd4, 0x3f, 0x65, 0xd3, 0x34,
+	0x2f, 0xda, 0x81, 0x75, 0x2d, 0xc8, 0x8c, 0x59, 0x00, 0xff, 0x39, 0x34, 0x3f, 0x27, 0x33, 0xbc,
+	0x60, 0x74, 0x9e, 0x17, 0x75, 0xc1, 0xa3, 0xc9, 0x0c, 0x87, 0x9c, 0xd1, 0xb9, 0x1d, 0x94, 0x9a,
+	0x75, 0x3f, 0x82, 0xcd, 0xaf, 0x34, 0x9a, 0xd3, 0x44, 0x2a, 0xa9, 0xcf, 0xee, 0x31, 0x78, 0xa9,
+	0x25, 0xda, 0x15, 0x2d, 0xf4, 0x8e, 0x20, 0xbb, 0x50, 0x8b, 0x46, 0x2a, 0x99, 0xa1, 0x3e, 0x12,
+	0x2f, 0x30, 0x88, 0x74, 0xa0, 0x21, 0x91, 0xe2, 0x48, 0xe1, 0x58, 0x9f, 0x83, 0x17, 0x2c, 0xb0,
+	0xff, 0x02, 0x5a, 0x21, 0x2a, 0xbb, 0x4b, 0xae, 0xa8, 0x03, 0x0d, 0xfb, 0x4a, 0xa3, 0x7c, 0x81,
+	0xfd, 0x19, 0xb4, 0x06, 0x22, 0x99, 0x5c, 0x29, 0x86, 0xb2, 0x50, 0xf4, 0x04, 0x20, 0x5e, 0x30,
+	0xba, 0x7e, 0x3d, 0x70, 0x18, 0x42, 0xa0, 0x1a, 0x65, 0x8a, 0x9b, 0x8f, 0xa4, 0x9f, 0xf3, 0xd3,
+	0x93, 0x19, 0x13, 0x89, 0x44, 0x23, 0xc7, 0xc2, 0x7c, 0x02, 0x99, 0x31, 0x89, 0xaa, 0x5d, 0x2d,
+	0x26, 0x28, 0x90, 0xff, 0x11, 0xb6, 0x43, 0x54, 0xee, 0xd6, 0xd7, 0x0f, 0xd9, 0xf9, 0xe8, 0x57,
+	0x1d, 0x36, 0x42, 0xc7, 0xc9, 0xe4, 0x04, 0xea, 0xc6, 0x9b, 0x64, 0xb7, 0x57, 0x98, 0xb8, 0x67,
+	0x4d, 0xdc, 0xfb, 0x90, 0x9b, 0xb8, 0xb3, 0xdb, 0x5b, 0xf8, 0xbc, 0xe7, 0xfa, 0xf8, 0x0d, 0x34,
+	0x42, 0xeb, 0xc8, 0xf2, 0xde, 0x7f, 0xf2, 0xe4, 0x2d, 0x78, 0xa6, 0xf7, 0xf2, 0x72, 0xe5, 0xe6,
+	0x63, 0xf0, 0xce, 0x51, 0x99, 0xa0, 0x94, 0x35, 0xff, 0xef, 0xa8, 0x36, 0xa5, 0xc7, 0xda, 0xc1,
+	0x06, 0xfc, 0xbd, 0x7e, 0xcf, 0x7e, 0xb5, 0xc2, 0xf9, 0x64, 0xc7, 0x6d, 0xb2, 0x61, 0x28, 0xed,
+	0x3b, 0x82, 0x6a, 0x1e, 0x05, 0x42, 0x9c, 0x2e, 0x93, 0x8d, 0xfb, 0x0e, 0xe6, 0x0b, 0xde, 0xaa,
+	0x41, 0x1e, 0x95, 0x95, 0x0f, 0xe6, 0x14, 0x5a, 0x41, 0x91, 0xda, 0x10, 0xc5, 0x2c, 0x19, 0xe1,
+	0xca, 0x6f, 0x78, 0x07, 0xcd, 0x10, 0x95, 0xcd, 0x27, 0x71, 0x3f, 0xbd, 0x13, 0xda, 0xd2, 0xf6,
+	0x13, 0xa8, 0x87, 0x29, 0xe2, 0xf8, 0x5b, 0xba, 0xf2, 0xce, 0xb9, 0x9b, 0x28, 0xbf, 0x39, 0xe3,
+	0x37, 0xec, 0x01, 0x73, 0x6f, 0x9c, 0xdf, 0x65, 0xb8, 0xdc, 0x13, 0x6d, 0x67, 0x9c, 0xe5, 0xff,
+	0xca, 0xa9, 0x9e, 0xdb, 0x72, 0xe4, 0xd1, 0xf2, 0x77, 0x76, 0xfe, 0x0e, 0xa5, 0x1a, 0x06, 0xb0,
+	0x79, 0xee, 0x26, 0xb4, 0x54, 0x84, 0xfb, 0xee, 0x3f, 0xfe, 0x25, 0x67, 0xb0, 0xb9, 0x94, 0x72,
+	0xd2, 0x5d, 0xd6, 0xb1, 0x94, 0xff, 0x32, 0x25, 0x83, 0x93, 0x1f, 0xaf, 0x27, 0x89, 0xba, 0xca,
+	0xe2, 0xde, 0x88, 0x4f, 0xfb, 0x82, 0xc7, 0xf1, 0x7c, 0x3c, 0x47, 0x61, 0x6e, 0xb0, 0x7e, 0xc2,
+	0x14, 0x0a, 0x16, 0xd1, 0xe2, 0xae, 0x5a, 0xba, 0xd7, 0xe2, 0x9a, 0xe6, 0x5e, 0xfd, 0x1e, 0x00,
+	0xfc, 0x0c, 0xb0, 0x5f, 0xfb, 0x06, 0x00, 0x00,
 }
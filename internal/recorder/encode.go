@@ -0,0 +1,286 @@
+package recorder
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"math"
+	"time"
+)
+
+// Format is an output format for a recording
+type Format string
+
+const (
+	// GIF is an animated GIF
+	GIF Format = "gif"
+	// APNG is an animated PNG
+	APNG Format = "apng"
+	// PNGFrames is a zip of PNG frames with an ffmpeg concat file of their durations,
+	// for converting to video, ie. "ffmpeg -f concat -i frames.ffconcat out.mp4"
+	PNGFrames Format = "frames"
+)
+
+// minFrameDelay is the shortest delay between frames most GIF viewers honor
+const minFrameDelay = 20 * time.Millisecond
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case GIF:
+		return "image/gif"
+	case APNG:
+		return "image/apng"
+	case PNGFrames:
+		return "application/zip"
+	}
+
+	return "application/octet-stream"
+}
+
+// Extension returns the file extension of the format
+func (f Format) Extension() string {
+	switch f {
+	case APNG:
+		return "png"
+	case PNGFrames:
+		return "zip"
+	}
+
+	return string(f)
+}
+
+// Encode writes the frames in the given format
+func Encode(w io.Writer, frames []*Frame, format Format) error {
+	if len(frames) < 1 {
+		return fmt.Errorf("no frames recorded")
+	}
+
+	switch format {
+	case GIF:
+		return encodeGIF(w, frames)
+	case APNG:
+		return encodeAPNG(w, frames)
+	case PNGFrames:
+		return encodePNGFrames(w, frames)
+	}
+
+	return fmt.Errorf("unsupported recording format '%s'", format)
+}
+
+func frameDelay(f *Frame) time.Duration {
+	if f.Duration < minFrameDelay {
+		return minFrameDelay
+	}
+
+	return f.Duration
+}
+
+func encodeGIF(w io.Writer, frames []*Frame) error {
+	g := &gif.GIF{}
+	for _, f := range frames {
+		g.Image = append(g.Image, paletted(f.Image))
+		g.Delay = append(g.Delay, int(frameDelay(f)/(10*time.Millisecond)))
+	}
+
+	return gif.EncodeAll(w, g)
+}
+
+// paletted converts a frame to a paletted image. Frames with 256 or fewer colors,
+// which is typical for the matrix, keep their exact colors.
+func paletted(img *image.RGBA) *image.Paletted {
+	seen := make(map[color.RGBA]struct{})
+	var pal color.Palette
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y && len(pal) <= 256; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			if _, ok := seen[c]; ok {
+				continue
+			}
+			seen[c] = struct{}{}
+			pal = append(pal, c)
+		}
+	}
+
+	if len(pal) > 256 {
+		p := image.NewPaletted(b, palette.Plan9)
+		draw.FloydSteinberg.Draw(p, b, img, b.Min)
+		return p
+	}
+
+	p := image.NewPaletted(b, pal)
+	draw.Draw(p, b, img, b.Min, draw.Src)
+	return p
+}
+
+// encodeAPNG builds an animated PNG from the IDAT data of each frame encoded as a PNG
+func encodeAPNG(w io.Writer, frames []*Frame) error {
+	var ihdr []byte
+	seq := uint32(0)
+	body := &bytes.Buffer{}
+
+	for i, f := range frames {
+		buf := &bytes.Buffer{}
+		if err := png.Encode(buf, f.Image); err != nil {
+			return err
+		}
+		chunks, err := readChunks(buf.Bytes())
+		if err != nil {
+			return err
+		}
+
+		var data []byte
+		for _, c := range chunks {
+			switch c.typ {
+			case "IHDR":
+				if ihdr == nil {
+					ihdr = c.data
+				}
+			case "IDAT":
+				data = append(data, c.data...)
+			}
+		}
+
+		b := f.Image.Bounds()
+		delay := frameDelay(f)
+		fctl := make([]byte, 26)
+		binary.BigEndian.PutUint32(fctl[0:], seq)
+		binary.BigEndian.PutUint32(fctl[4:], uint32(b.Dx()))
+		binary.BigEndian.PutUint32(fctl[8:], uint32(b.Dy()))
+		// x and y offsets are zero
+		num, den := delay.Milliseconds(), int64(1000)
+		if num > math.MaxUint16 {
+			num, den = num/10, 100
+		}
+		if num > math.MaxUint16 {
+			num = math.MaxUint16
+		}
+		binary.BigEndian.PutUint16(fctl[20:], uint16(num))
+		binary.BigEndian.PutUint16(fctl[22:], uint16(den))
+		// dispose and blend ops are zero, APNG_DISPOSE_OP_NONE and APNG_BLEND_OP_SOURCE
+		seq++
+		if err := writeChunk(body, "fcTL", fctl); err != nil {
+			return err
+		}
+
+		if i == 0 {
+			if err := writeChunk(body, "IDAT", data); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fdat := make([]byte, 4, 4+len(data))
+		binary.BigEndian.PutUint32(fdat, seq)
+		seq++
+		if err := writeChunk(body, "fdAT", append(fdat, data...)); err != nil {
+			return err
+		}
+	}
+
+	actl := make([]byte, 8)
+	binary.BigEndian.PutUint32(actl[0:], uint32(len(frames)))
+	// Zero plays loop forever
+
+	if _, err := w.Write([]byte("\x89PNG\r\n\x1a\n")); err != nil {
+		return err
+	}
+	if err := writeChunk(w, "IHDR", ihdr); err != nil {
+		return err
+	}
+	if err := writeChunk(w, "acTL", actl); err != nil {
+		return err
+	}
+	if _, err := w.Write(body.Bytes()); err != nil {
+		return err
+	}
+
+	return writeChunk(w, "IEND", nil)
+}
+
+type chunk struct {
+	typ  string
+	data []byte
+}
+
+func readChunks(b []byte) ([]*chunk, error) {
+	const sigLen = 8
+	if len(b) < sigLen {
+		return nil, fmt.Errorf("invalid png")
+	}
+	b = b[sigLen:]
+
+	var chunks []*chunk
+	for len(b) >= 12 {
+		l := int(binary.BigEndian.Uint32(b[0:4]))
+		if len(b) < 12+l {
+			return nil, fmt.Errorf("truncated png chunk")
+		}
+		chunks = append(chunks, &chunk{
+			typ:  string(b[4:8]),
+			data: b[8 : 8+l],
+		})
+		b = b[12+l:]
+	}
+
+	return chunks, nil
+}
+
+func writeChunk(w io.Writer, typ string, data []byte) error {
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header[0:], uint32(len(data)))
+	copy(header[4:], typ)
+
+	crc := crc32.NewIEEE()
+	_, _ = crc.Write(header[4:])
+	_, _ = crc.Write(data)
+	footer := make([]byte, 4)
+	binary.BigEndian.PutUint32(footer, crc.Sum32())
+
+	for _, b := range [][]byte{header, data, footer} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodePNGFrames(w io.Writer, frames []*Frame) error {
+	z := zip.NewWriter(w)
+
+	concat := &bytes.Buffer{}
+	fmt.Fprintln(concat, "ffconcat version 1.0")
+
+	for i, f := range frames {
+		name := fmt.Sprintf("frame-%05d.png", i)
+		fw, err := z.Create(name)
+		if err != nil {
+			return err
+		}
+		if err := png.Encode(fw, f.Image); err != nil {
+			return err
+		}
+		fmt.Fprintf(concat, "file %s\nduration %.3f\n", name, frameDelay(f).Seconds())
+	}
+
+	fw, err := z.Create("frames.ffconcat")
+	if err != nil {
+		return err
+	}
+	if _, err := fw.Write(concat.Bytes()); err != nil {
+		return err
+	}
+
+	return z.Close()
+}
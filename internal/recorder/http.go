@@ -0,0 +1,86 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+type status struct {
+	Recording bool   `json:"recording"`
+	Frames    int    `json:"frames"`
+	Duration  string `json:"duration"`
+}
+
+// GetHTTPHandlers returns handlers to start and stop recording and to download the recording.
+// Downloads take a "format" query param of gif, apng or frames.
+func (r *Recorder) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return []*board.HTTPHandler{
+		{
+			Path: "/api/recorder/start",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				if err := r.Start(); err != nil {
+					http.Error(w, err.Error(), http.StatusConflict)
+					return
+				}
+				r.writeStatus(w)
+			},
+		},
+		{
+			Path: "/api/recorder/stop",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				r.Stop()
+				r.writeStatus(w)
+			},
+		},
+		{
+			Path: "/api/recorder/status",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				r.writeStatus(w)
+			},
+		},
+		{
+			Path:    "/api/recorder/download",
+			Handler: r.download,
+		},
+	}, nil
+}
+
+func (r *Recorder) writeStatus(w http.ResponseWriter) {
+	recording, frames, duration := r.Status()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&status{
+		Recording: recording,
+		Frames:    frames,
+		Duration:  duration.Round(time.Millisecond).String(),
+	}); err != nil {
+		r.log.Error("failed to write recorder status", zap.Error(err))
+	}
+}
+
+func (r *Recorder) download(w http.ResponseWriter, req *http.Request) {
+	format := Format(req.URL.Query().Get("format"))
+	if format == "" {
+		format = GIF
+	}
+
+	buf := &bytes.Buffer{}
+	if err := Encode(buf, r.Recorded(), format); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"sportsmatrix-%s.%s\"", time.Now().Format("20060102-150405"), format.Extension()),
+	)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		r.log.Error("failed to write recording", zap.Error(err))
+	}
+}
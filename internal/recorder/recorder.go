@@ -0,0 +1,245 @@
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/matrix"
+)
+
+const (
+	defaultMaxDuration = 5 * time.Minute
+	// defaultMaxFrames is a minute of scrolling at 30fps. A 128x64 frame is 32KB, so this holds
+	// under 60MB. Static boards only add a frame when the screen changes, so they record for longer.
+	defaultMaxFrames = 1800
+)
+
+// Recorder is a matrix.Matrix that records the frames displayed on the matrix it wraps,
+// including scroll frames played through Play
+type Recorder struct {
+	matrix.Matrix
+	log         *zap.Logger
+	frames      []*Frame
+	recording   bool
+	start       time.Time
+	stop        time.Time
+	maxDuration time.Duration
+	maxFrames   int
+	unobserve   func()
+	sync.Mutex
+}
+
+// Frame is a recorded frame and the time it was displayed
+type Frame struct {
+	Image *image.RGBA
+	At    time.Time
+	// Duration is how long the frame was displayed
+	Duration time.Duration
+}
+
+// OptionFunc provides options to the Recorder
+type OptionFunc func(*Recorder) error
+
+// New returns a Recorder wrapping the given matrix
+func New(m matrix.Matrix, logger *zap.Logger, opts ...OptionFunc) (*Recorder, error) {
+	r := &Recorder{
+		Matrix:      m,
+		log:         logger,
+		maxDuration: defaultMaxDuration,
+		maxFrames:   defaultMaxFrames,
+	}
+
+	for _, f := range opts {
+		if err := f(r); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// WithMaxDuration sets the longest a recording can run before it is stopped
+func WithMaxDuration(d time.Duration) OptionFunc {
+	return func(r *Recorder) error {
+		r.maxDuration = d
+		return nil
+	}
+}
+
+// WithMaxFrames sets the most frames a recording can hold before it is stopped
+func WithMaxFrames(n int) OptionFunc {
+	return func(r *Recorder) error {
+		r.maxFrames = n
+		return nil
+	}
+}
+
+// Frames returns the wrapped matrix's frame broadcaster, if it has one
+func (r *Recorder) Frames() *matrix.Frames {
+	if src, ok := r.Matrix.(matrix.FrameSource); ok {
+		return src.Frames()
+	}
+
+	return nil
+}
+
+// Render records the frame if the wrapped matrix doesn't broadcast its own frames
+func (r *Recorder) Render() error {
+	if r.Frames() == nil && r.Recording() {
+		w, h := r.Geometry()
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		for x := 0; x < w; x++ {
+			for y := 0; y < h; y++ {
+				red, green, blue, _ := r.At(x, y).RGBA()
+				img.SetRGBA(x, y, color.RGBA{uint8(red >> 8), uint8(green >> 8), uint8(blue >> 8), 255})
+			}
+		}
+		r.record(img, time.Now())
+	}
+
+	return r.Matrix.Render()
+}
+
+// Start starts a new recording, discarding any previous one
+func (r *Recorder) Start() error {
+	r.Lock()
+	if r.recording {
+		r.Unlock()
+		return fmt.Errorf("already recording")
+	}
+
+	r.log.Info("starting matrix recording")
+
+	r.frames = nil
+	r.recording = true
+	r.start = time.Now()
+	r.stop = time.Time{}
+	r.Unlock()
+
+	f := r.Frames()
+	if f == nil {
+		return nil
+	}
+
+	// Start with whatever is on screen now, as a static board may not render again for a while
+	if last := f.Last(); last != nil {
+		r.record(last, r.start)
+	}
+
+	// Frames are recorded while the frame broadcaster is locked, so the recorder
+	// must not be locked while observing
+	unobserve := f.Observe(r.record)
+
+	r.Lock()
+	defer r.Unlock()
+	if !r.recording {
+		go unobserve()
+		return nil
+	}
+	r.unobserve = unobserve
+
+	return nil
+}
+
+// Stop stops recording
+func (r *Recorder) Stop() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.stopLocked()
+}
+
+func (r *Recorder) stopLocked() {
+	if !r.recording {
+		return
+	}
+
+	r.log.Info("stopping matrix recording",
+		zap.Int("frames", len(r.frames)),
+	)
+
+	r.recording = false
+	r.stop = time.Now()
+	if r.unobserve != nil {
+		// This may be called while the frame broadcaster is locked, so unobserve asynchronously
+		go r.unobserve()
+		r.unobserve = nil
+	}
+	if len(r.frames) > 0 {
+		last := r.frames[len(r.frames)-1]
+		last.Duration = r.stop.Sub(last.At)
+	}
+}
+
+// Recording returns whether a recording is in progress
+func (r *Recorder) Recording() bool {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.recording
+}
+
+// Recorded returns the frames of the last recording. Consecutive identical frames are combined.
+func (r *Recorder) Recorded() []*Frame {
+	r.Lock()
+	defer r.Unlock()
+
+	frames := make([]*Frame, len(r.frames))
+	copy(frames, r.frames)
+
+	return frames
+}
+
+// Status returns whether a recording is in progress, how many frames it has and how long it is
+func (r *Recorder) Status() (bool, int, time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+
+	end := r.stop
+	if r.recording {
+		end = time.Now()
+	}
+	if r.start.IsZero() {
+		return false, 0, 0
+	}
+
+	return r.recording, len(r.frames), end.Sub(r.start)
+}
+
+func (r *Recorder) record(img *image.RGBA, at time.Time) {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.recording {
+		return
+	}
+
+	if at.Sub(r.start) > r.maxDuration || len(r.frames) >= r.maxFrames {
+		r.log.Warn("matrix recording limit reached",
+			zap.Duration("max duration", r.maxDuration),
+			zap.Int("max frames", r.maxFrames),
+		)
+		r.stopLocked()
+		return
+	}
+
+	if len(r.frames) > 0 {
+		prev := r.frames[len(r.frames)-1]
+		prev.Duration = at.Sub(prev.At)
+		// Identical frames extend the duration of the previous frame
+		if bytes.Equal(prev.Image.Pix, img.Pix) {
+			return
+		}
+	}
+
+	r.frames = append(r.frames, &Frame{
+		Image: img,
+		At:    at,
+	})
+}
@@ -0,0 +1,75 @@
+package recorder
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/matrix"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	m := matrix.NewConsoleMatrix(4, 2, io.Discard, zap.NewNop())
+	r, err := New(m, zap.NewNop())
+	require.NoError(t, err)
+
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+
+	require.NoError(t, r.Start())
+	require.Error(t, r.Start())
+
+	r.Set(0, 0, red)
+	require.NoError(t, r.Render())
+	r.Set(0, 0, red)
+	require.NoError(t, r.Render())
+
+	// Scroll frames played through Play are recorded too
+	for i := 0; i < 2; i++ {
+		r.PreLoad(&matrix.MatrixScene{
+			Index:  i,
+			Points: []matrix.MatrixPoint{{X: i + 1, Y: 1, Color: blue}},
+		})
+	}
+	require.NoError(t, r.Play(context.Background(), time.Millisecond, make(chan time.Duration)))
+
+	r.Stop()
+	require.False(t, r.Recording())
+
+	frames := r.Recorded()
+	// The identical frames are combined
+	require.Len(t, frames, 3)
+	require.Equal(t, red, frames[0].Image.RGBAAt(0, 0))
+	require.Equal(t, blue, frames[2].Image.RGBAAt(2, 1))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Encode(buf, frames, GIF))
+	g, err := gif.DecodeAll(buf)
+	require.NoError(t, err)
+	require.Len(t, g.Image, 3)
+
+	buf.Reset()
+	require.NoError(t, Encode(buf, frames, APNG))
+	img, err := png.Decode(buf)
+	require.NoError(t, err)
+	require.Equal(t, 4, img.Bounds().Dx())
+
+	buf.Reset()
+	require.NoError(t, Encode(buf, frames, PNGFrames))
+	z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, z.File, 4)
+
+	require.Error(t, Encode(buf, nil, GIF))
+}
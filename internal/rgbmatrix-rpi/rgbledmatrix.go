@@ -279,6 +279,7 @@ type RGBLedMatrix struct {
 	preload     [][]C.uint32_t
 	closed      *atomic.Bool
 	log         *zap.Logger
+	frames      *matrix.Frames
 	preloadLock sync.Mutex
 	sync.Mutex
 }
@@ -306,6 +307,7 @@ func NewRGBLedMatrix(config *HardwareConfig, rtOptions *RuntimeOptions, logger *
 		leds:   make([]C.uint32_t, w*h),
 		closed: atomic.NewBool(false),
 		log:    logger,
+		frames: matrix.NewFrames(w, h),
 	}
 	if m == nil {
 		return nil, fmt.Errorf("unable to allocate memory")
@@ -351,9 +353,16 @@ func (c *RGBLedMatrix) render(leds []C.uint32_t) error {
 		(*C.uint32_t)(unsafe.Pointer(&leds[0])),
 	)
 
+	c.frames.Publish(*(*[]uint32)(unsafe.Pointer(&leds)))
+
 	return nil
 }
 
+// Frames returns the broadcaster of the frames displayed on the matrix
+func (c *RGBLedMatrix) Frames() *matrix.Frames {
+	return c.frames
+}
+
 // At return an Color which allows access to the LED display data as
 // if it were a sequence of 24-bit RGB values.
 func (c *RGBLedMatrix) At(x int, y int) color.Color {
@@ -422,6 +431,10 @@ func (c *RGBLedMatrix) Play(ctx context.Context, startInterval time.Duration, in
 		case <-time.After(waitInterval):
 		}
 
+		if err := matrix.WaitPlayGate(ctx); err != nil {
+			return err
+		}
+
 		if err := c.render(leds); err != nil {
 			return err
 		}
@@ -0,0 +1,49 @@
+package rgbrender
+
+import (
+	"fmt"
+	"image"
+	"sync"
+)
+
+// TextWriters caches a TextWriter for each canvas size
+type TextWriters struct {
+	writers map[string]*TextWriter
+	setup   func(writer *TextWriter, bounds image.Rectangle)
+	sync.Mutex
+}
+
+// NewTextWriters returns a TextWriters cache. setup adjusts each new DefaultTextWriter
+// to the zeroed bounds of its canvas, ie. scaling the font of large canvases.
+func NewTextWriters(setup func(writer *TextWriter, bounds image.Rectangle)) *TextWriters {
+	return &TextWriters{
+		writers: make(map[string]*TextWriter),
+		setup:   setup,
+	}
+}
+
+// Get returns the TextWriter for a canvas
+func (t *TextWriters) Get(bounds image.Rectangle) (*TextWriter, error) {
+	t.Lock()
+	defer t.Unlock()
+
+	bounds = ZeroedBounds(bounds)
+
+	k := fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())
+	if w, ok := t.writers[k]; ok {
+		return w, nil
+	}
+
+	writer, err := DefaultTextWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.setup != nil {
+		t.setup(writer, bounds)
+	}
+
+	t.writers[k] = writer
+
+	return writer, nil
+}
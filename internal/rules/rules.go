@@ -0,0 +1,272 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+// Config defines when a board is shown
+type Config struct {
+	// Board is the name of the board the rule applies to
+	Board string `json:"board"`
+	// When is an expression of the board's facts, ie. "watchedGames > 0" or
+	// "precipChance > 40 and hour >= 6". Conditions are joined with "and" and "or",
+	// where "and" binds tighter.
+	When string `json:"when"`
+}
+
+// Rules decides whether boards should be shown
+type Rules struct {
+	log   *zap.Logger
+	rules map[string][]*rule
+	now   func() time.Time
+}
+
+// rule is a set of alternatives, any of which must match
+type rule struct {
+	expr         string
+	alternatives [][]*condition
+}
+
+type condition struct {
+	fact  string
+	op    string
+	value string
+}
+
+var ops = []string{">=", "<=", "!=", "==", ">", "<", "="}
+
+// New ...
+func New(configs []*Config, logger *zap.Logger) (*Rules, error) {
+	r := &Rules{
+		log:   logger,
+		rules: make(map[string][]*rule),
+		now:   time.Now,
+	}
+
+	for _, c := range configs {
+		if c.Board == "" {
+			return nil, fmt.Errorf("rule is missing a board")
+		}
+		rl, err := parse(c.When)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule for %s: %w", c.Board, err)
+		}
+		key := strings.ToLower(c.Board)
+		r.rules[key] = append(r.rules[key], rl)
+	}
+
+	return r, nil
+}
+
+// Allow returns whether the board should be shown. Boards without rules are always shown.
+// If a rule can't be evaluated, the board is shown and an error is returned.
+func (r *Rules) Allow(ctx context.Context, b board.Board) (bool, error) {
+	rules := r.rules[strings.ToLower(b.Name())]
+	if len(rules) < 1 {
+		return true, nil
+	}
+
+	facts := r.builtinFacts()
+	if p, ok := b.(board.FactProvider); ok {
+		boardFacts, err := p.Facts(ctx)
+		if err != nil {
+			return true, fmt.Errorf("failed to get facts for %s: %w", b.Name(), err)
+		}
+		for k, v := range boardFacts {
+			facts[k] = v
+		}
+	}
+
+	for _, rl := range rules {
+		match, err := rl.eval(facts)
+		if err != nil {
+			return true, fmt.Errorf("failed to evaluate rule '%s' for %s: %w", rl.expr, b.Name(), err)
+		}
+		if !match {
+			r.log.Debug("board rule did not match",
+				zap.String("board", b.Name()),
+				zap.String("rule", rl.expr),
+			)
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// builtinFacts are available to every board's rules
+func (r *Rules) builtinFacts() board.Facts {
+	now := r.now()
+	return board.Facts{
+		"hour":    now.Hour(),
+		"minute":  now.Minute(),
+		"weekday": strings.ToLower(now.Weekday().String()[0:3]),
+	}
+}
+
+func parse(expr string) (*rule, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, fmt.Errorf("rule is empty")
+	}
+
+	rl := &rule{
+		expr: expr,
+	}
+	for _, alt := range splitWord(expr, "or") {
+		var all []*condition
+		for _, c := range splitWord(alt, "and") {
+			cond, err := parseCondition(c)
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, cond)
+		}
+		rl.alternatives = append(rl.alternatives, all)
+	}
+
+	return rl, nil
+}
+
+// splitWord splits an expression on a case-insensitive keyword surrounded by whitespace
+func splitWord(expr string, word string) []string {
+	fields := strings.Fields(expr)
+	var parts []string
+	var cur []string
+	for _, f := range fields {
+		if strings.EqualFold(f, word) {
+			parts = append(parts, strings.Join(cur, " "))
+			cur = nil
+			continue
+		}
+		cur = append(cur, f)
+	}
+
+	return append(parts, strings.Join(cur, " "))
+}
+
+func parseCondition(s string) (*condition, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("empty condition")
+	}
+
+	for _, op := range ops {
+		idx := strings.Index(s, op)
+		if idx < 0 {
+			continue
+		}
+		c := &condition{
+			fact:  strings.TrimSpace(s[:idx]),
+			op:    op,
+			value: strings.Trim(strings.TrimSpace(s[idx+len(op):]), `"'`),
+		}
+		if c.op == "=" {
+			c.op = "=="
+		}
+		if c.fact == "" || c.value == "" {
+			return nil, fmt.Errorf("invalid condition '%s'", s)
+		}
+		return c, nil
+	}
+
+	// A bare fact, ie. "tradingOpen", or a negated one, ie. "!tradingOpen"
+	if strings.ContainsAny(s, " \t") {
+		return nil, fmt.Errorf("invalid condition '%s'", s)
+	}
+	if strings.HasPrefix(s, "!") {
+		return &condition{fact: s[1:], op: "==", value: "false"}, nil
+	}
+
+	return &condition{fact: s, op: "==", value: "true"}, nil
+}
+
+func (rl *rule) eval(facts board.Facts) (bool, error) {
+	for _, all := range rl.alternatives {
+		match := true
+		for _, c := range all {
+			ok, err := c.eval(facts)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c *condition) eval(facts board.Facts) (bool, error) {
+	v, ok := facts[c.fact]
+	if !ok {
+		return false, fmt.Errorf("unknown fact '%s'", c.fact)
+	}
+
+	switch val := v.(type) {
+	case bool:
+		want, err := strconv.ParseBool(c.value)
+		if err != nil {
+			return false, fmt.Errorf("fact '%s' is a bool: %w", c.fact, err)
+		}
+		switch c.op {
+		case "==":
+			return val == want, nil
+		case "!=":
+			return val != want, nil
+		}
+		return false, fmt.Errorf("operator %s is not valid for bool fact '%s'", c.op, c.fact)
+	case int:
+		return c.compareNumber(float64(val))
+	case int64:
+		return c.compareNumber(float64(val))
+	case float64:
+		return c.compareNumber(val)
+	case string:
+		switch c.op {
+		case "==":
+			return strings.EqualFold(val, c.value), nil
+		case "!=":
+			return !strings.EqualFold(val, c.value), nil
+		}
+		return false, fmt.Errorf("operator %s is not valid for string fact '%s'", c.op, c.fact)
+	}
+
+	return false, fmt.Errorf("fact '%s' has unsupported type %T", c.fact, v)
+}
+
+func (c *condition) compareNumber(val float64) (bool, error) {
+	want, err := strconv.ParseFloat(c.value, 64)
+	if err != nil {
+		return false, fmt.Errorf("fact '%s' is a number: %w", c.fact, err)
+	}
+
+	switch c.op {
+	case "==":
+		return val == want, nil
+	case "!=":
+		return val != want, nil
+	case ">":
+		return val > want, nil
+	case ">=":
+		return val >= want, nil
+	case "<":
+		return val < want, nil
+	case "<=":
+		return val <= want, nil
+	}
+
+	return false, fmt.Errorf("unknown operator %s", c.op)
+}
@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+type factBoard struct {
+	facts board.Facts
+}
+
+func (b *factBoard) Name() string {
+	return "Weather"
+}
+
+func (b *factBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	return nil
+}
+
+func (b *factBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	return nil, nil
+}
+
+func (b *factBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+func (b *factBoard) ScrollMode() bool {
+	return false
+}
+
+func (b *factBoard) GetRPCHandler() (string, http.Handler) {
+	return "", nil
+}
+
+func (b *factBoard) InBetween() bool {
+	return false
+}
+
+func (b *factBoard) Enabler() board.Enabler {
+	return nil
+}
+
+func (b *factBoard) Facts(ctx context.Context) (board.Facts, error) {
+	return b.facts, nil
+}
+
+func TestAllow(t *testing.T) {
+	t.Parallel()
+
+	b := &factBoard{
+		facts: board.Facts{
+			"precipChance": 60,
+			"temperature":  71.5,
+			"raining":      false,
+			"condition":    "clouds",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		when     string
+		expected bool
+		err      bool
+	}{
+		{
+			name:     "greater than",
+			when:     "precipChance > 40",
+			expected: true,
+		},
+		{
+			name:     "and",
+			when:     "precipChance > 40 and temperature >= 80",
+			expected: false,
+		},
+		{
+			name:     "or binds looser than and",
+			when:     "precipChance > 90 or temperature < 80 and condition == Clouds",
+			expected: true,
+		},
+		{
+			name:     "bare fact",
+			when:     "raining",
+			expected: false,
+		},
+		{
+			name:     "negated fact",
+			when:     "!raining",
+			expected: true,
+		},
+		{
+			name:     "builtin fact",
+			when:     "weekday = sat and hour >= 14",
+			expected: true,
+		},
+		{
+			name:     "unknown fact shows the board",
+			when:     "snowChance > 10",
+			expected: true,
+			err:      true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := New([]*Config{{Board: "weather", When: test.when}}, zap.NewNop())
+			require.NoError(t, err)
+			r.now = func() time.Time {
+				return time.Date(2022, 10, 15, 14, 30, 0, 0, time.Local)
+			}
+
+			allow, err := r.Allow(context.Background(), b)
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.expected, allow)
+		})
+	}
+
+	_, err := New([]*Config{{Board: "weather", When: "precipChance >"}}, zap.NewNop())
+	require.Error(t, err)
+}
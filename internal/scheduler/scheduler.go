@@ -0,0 +1,184 @@
+package scheduler
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+// Scheduler runs every scheduled job of the matrix from a single cron, such as
+// screen on/off times and board on/off times
+type Scheduler struct {
+	cron *cron.Cron
+	log  *zap.Logger
+	jobs []*Job
+	sync.Mutex
+}
+
+// Job is a scheduled func
+type Job struct {
+	Name string
+	Spec string
+	id   cron.EntryID
+	// board is set for board on/off jobs. Board names aren't unique, ie. every
+	// headline board is named Texts, so board jobs are removed by identity.
+	board board.Board
+}
+
+// New ...
+func New(logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		cron: cron.New(),
+		log:  logger,
+	}
+}
+
+// Start starts running jobs
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop stops running jobs
+func (s *Scheduler) Stop() {
+	s.cron.Stop()
+}
+
+// Add schedules a func to run at each of the given cron specs
+func (s *Scheduler) Add(name string, specs []string, f func()) error {
+	return s.add(name, nil, specs, f)
+}
+
+func (s *Scheduler) add(name string, b board.Board, specs []string, f func()) error {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, spec := range specs {
+		id, err := s.cron.AddFunc(spec, f)
+		if err != nil {
+			return fmt.Errorf("failed to schedule %s at '%s': %w", name, spec, err)
+		}
+		s.log.Info("scheduled job",
+			zap.String("job", name),
+			zap.String("spec", spec),
+		)
+		s.jobs = append(s.jobs, &Job{
+			Name:  name,
+			Spec:  spec,
+			id:    id,
+			board: b,
+		})
+	}
+
+	return nil
+}
+
+// Remove unschedules every job with the given name
+func (s *Scheduler) Remove(name string) {
+	s.remove(func(j *Job) bool {
+		return j.board == nil && j.Name == name
+	})
+}
+
+func (s *Scheduler) remove(match func(j *Job) bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	jobs := []*Job{}
+	for _, j := range s.jobs {
+		if !match(j) {
+			jobs = append(jobs, j)
+			continue
+		}
+		s.cron.Remove(j.id)
+		s.log.Info("unscheduled job",
+			zap.String("job", j.Name),
+			zap.String("spec", j.Spec),
+		)
+	}
+	s.jobs = jobs
+}
+
+// Swap replaces the jobs with the given names by the jobs scheduled by add. The old jobs
+// are only unscheduled once add succeeds. If it fails, the jobs it added are unscheduled
+// and the old jobs are kept.
+func (s *Scheduler) Swap(names []string, add func() error) error {
+	named := func(j *Job) bool {
+		if j.board != nil {
+			return false
+		}
+		for _, name := range names {
+			if j.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	s.Lock()
+	old := make(map[*Job]struct{})
+	for _, j := range s.jobs {
+		if named(j) {
+			old[j] = struct{}{}
+		}
+	}
+	s.Unlock()
+
+	if err := add(); err != nil {
+		s.remove(func(j *Job) bool {
+			_, ok := old[j]
+			return named(j) && !ok
+		})
+		return err
+	}
+
+	s.remove(func(j *Job) bool {
+		_, ok := old[j]
+		return ok
+	})
+
+	return nil
+}
+
+// AddBoard schedules a board's on and off times, if it has any
+func (s *Scheduler) AddBoard(b board.Board) error {
+	sched, ok := b.(board.Scheduled)
+	if !ok {
+		return nil
+	}
+
+	onTimes, offTimes := sched.EnableTimes()
+
+	if err := s.add(fmt.Sprintf("%s on", b.Name()), b, onTimes, func() {
+		s.log.Info("board turning on", zap.String("board", b.Name()))
+		b.Enabler().Enable()
+	}); err != nil {
+		return err
+	}
+
+	return s.add(fmt.Sprintf("%s off", b.Name()), b, offTimes, func() {
+		s.log.Info("board turning off", zap.String("board", b.Name()))
+		b.Enabler().Disable()
+	})
+}
+
+// RemoveBoard unschedules a board's on and off times
+func (s *Scheduler) RemoveBoard(b board.Board) {
+	s.remove(func(j *Job) bool {
+		return j.board == b
+	})
+}
+
+// Jobs returns all of the scheduled jobs
+func (s *Scheduler) Jobs() []*Job {
+	s.Lock()
+	defer s.Unlock()
+
+	jobs := make([]*Job, len(s.jobs))
+	copy(jobs, s.jobs)
+
+	return jobs
+}
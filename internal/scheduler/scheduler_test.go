@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+type scheduledBoard struct {
+	board.Board
+	on  string
+	off string
+}
+
+func (b *scheduledBoard) Name() string {
+	return "Texts"
+}
+
+func (b *scheduledBoard) EnableTimes() ([]string, []string) {
+	return []string{b.on}, []string{b.off}
+}
+
+func TestRemoveBoard(t *testing.T) {
+	t.Parallel()
+
+	s := New(zap.NewNop())
+
+	// Both boards have the same name
+	first := &scheduledBoard{on: "0 7 * * *", off: "0 8 * * *"}
+	second := &scheduledBoard{on: "0 9 * * *", off: "0 10 * * *"}
+	require.NoError(t, s.AddBoard(first))
+	require.NoError(t, s.AddBoard(second))
+	require.NoError(t, s.Add("Texts on", []string{"0 11 * * *"}, func() {}))
+
+	specs := func() []string {
+		var specs []string
+		for _, j := range s.Jobs() {
+			specs = append(specs, j.Name+" "+j.Spec)
+		}
+		return specs
+	}
+	require.Equal(t, []string{
+		"Texts on 0 7 * * *",
+		"Texts off 0 8 * * *",
+		"Texts on 0 9 * * *",
+		"Texts off 0 10 * * *",
+		"Texts on 0 11 * * *",
+	}, specs())
+
+	s.RemoveBoard(first)
+	require.Equal(t, []string{
+		"Texts on 0 9 * * *",
+		"Texts off 0 10 * * *",
+		"Texts on 0 11 * * *",
+	}, specs())
+
+	s.Remove("Texts on")
+	require.Equal(t, []string{
+		"Texts on 0 9 * * *",
+		"Texts off 0 10 * * *",
+	}, specs())
+}
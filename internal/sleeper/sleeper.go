@@ -0,0 +1,365 @@
+package sleeper
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	fantasyboard "github.com/robbydyer/sports/internal/board/fantasy"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+const (
+	baseURL      = "https://api.sleeper.app"
+	avatarURL    = "https://sleepercdn.com/avatars/thumbs"
+	logoCacheDir = "/tmp/sportsmatrix_logos/sleeper"
+)
+
+//go:embed assets/mock
+var mockAssets embed.FS
+
+// API is a client of the Sleeper fantasy football API, which doesn't need authentication
+type API struct {
+	log      *zap.Logger
+	leagueID string
+	mockData map[string][]byte
+	avatars  map[string]*logo.Logo
+	sync.Mutex
+}
+
+// Option is an option for the Sleeper API
+type Option func(a *API) error
+
+type nflState struct {
+	Week       int    `json:"week"`
+	Season     string `json:"season"`
+	SeasonType string `json:"season_type"`
+}
+
+type league struct {
+	Name            string             `json:"name"`
+	Season          string             `json:"season"`
+	ScoringSettings map[string]float64 `json:"scoring_settings"`
+}
+
+type user struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	Avatar      string `json:"avatar"`
+	Metadata    struct {
+		TeamName string `json:"team_name"`
+		// Avatar is the URL of a custom team avatar
+		Avatar string `json:"avatar"`
+	} `json:"metadata"`
+}
+
+type roster struct {
+	RosterID int    `json:"roster_id"`
+	OwnerID  string `json:"owner_id"`
+}
+
+type matchup struct {
+	RosterID  int      `json:"roster_id"`
+	MatchupID *int     `json:"matchup_id"`
+	Points    float64  `json:"points"`
+	Starters  []string `json:"starters"`
+}
+
+type projection struct {
+	PlayerID string             `json:"player_id"`
+	Stats    map[string]float64 `json:"stats"`
+}
+
+// New ...
+func New(leagueID string, logger *zap.Logger, opts ...Option) (*API, error) {
+	if leagueID == "" {
+		return nil, fmt.Errorf("must pass a Sleeper league ID")
+	}
+
+	a := &API{
+		log:      logger,
+		leagueID: leagueID,
+		avatars:  make(map[string]*logo.Logo),
+	}
+
+	for _, f := range opts {
+		if err := f(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+// WithMockData serves API responses from the given data, keyed by API path, instead of the Sleeper API
+func WithMockData(data map[string][]byte) Option {
+	return func(a *API) error {
+		a.mockData = data
+		return nil
+	}
+}
+
+// MockData returns the bundled mock league, keyed by API path. Its league ID is "mock".
+func MockData() (map[string][]byte, error) {
+	files := map[string]string{
+		"/v1/state/nfl":                               "state.json",
+		"/v1/league/mock":                             "league.json",
+		"/v1/league/mock/users":                       "users.json",
+		"/v1/league/mock/rosters":                     "rosters.json",
+		"/v1/league/mock/matchups/5":                  "matchups.json",
+		"/projections/nfl/2023/5?season_type=regular": "projections.json",
+	}
+
+	data := make(map[string][]byte, len(files))
+	for p, f := range files {
+		dat, err := mockAssets.ReadFile(path.Join("assets", "mock", f))
+		if err != nil {
+			return nil, err
+		}
+		data[p] = dat
+	}
+
+	return data, nil
+}
+
+// Provider ...
+func (a *API) Provider() string {
+	return "sleeper"
+}
+
+// HTTPPathPrefix ...
+func (a *API) HTTPPathPrefix() string {
+	return "sleeper"
+}
+
+// GetMatchups returns the league's matchups for the current NFL week
+func (a *API) GetMatchups(ctx context.Context, avatarBounds image.Rectangle) ([]*fantasyboard.Matchup, error) {
+	var state *nflState
+	if err := a.get(ctx, "/v1/state/nfl", &state); err != nil {
+		return nil, err
+	}
+	if state.Week < 1 {
+		return nil, fmt.Errorf("no current NFL week")
+	}
+
+	var l *league
+	if err := a.get(ctx, fmt.Sprintf("/v1/league/%s", a.leagueID), &l); err != nil {
+		return nil, err
+	}
+
+	var users []*user
+	if err := a.get(ctx, fmt.Sprintf("/v1/league/%s/users", a.leagueID), &users); err != nil {
+		return nil, err
+	}
+
+	var rosters []*roster
+	if err := a.get(ctx, fmt.Sprintf("/v1/league/%s/rosters", a.leagueID), &rosters); err != nil {
+		return nil, err
+	}
+
+	var matchups []*matchup
+	if err := a.get(ctx, fmt.Sprintf("/v1/league/%s/matchups/%d", a.leagueID, state.Week), &matchups); err != nil {
+		return nil, err
+	}
+
+	// Projections aren't part of the documented API, so matchups are still shown without them
+	projected := make(map[string]float64)
+	var projections []*projection
+	if err := a.get(ctx, fmt.Sprintf("/projections/nfl/%s/%d?season_type=%s", l.Season, state.Week, state.SeasonType), &projections); err != nil {
+		a.log.Warn("failed to get sleeper projections",
+			zap.Error(err),
+		)
+	}
+	key := projectionKey(l.ScoringSettings)
+	for _, p := range projections {
+		projected[p.PlayerID] = p.Stats[key]
+	}
+
+	usersByID := make(map[string]*user, len(users))
+	for _, u := range users {
+		usersByID[u.UserID] = u
+	}
+	ownerByRoster := make(map[int]*user, len(rosters))
+	for _, r := range rosters {
+		if u, ok := usersByID[r.OwnerID]; ok {
+			ownerByRoster[r.RosterID] = u
+		}
+	}
+
+	live := isGameDay(time.Now()) && state.SeasonType != "off"
+
+	byMatchup := make(map[int][]*fantasyboard.Team)
+	for _, m := range matchups {
+		if m.MatchupID == nil {
+			continue
+		}
+
+		team := &fantasyboard.Team{
+			ID:     fmt.Sprintf("%d", m.RosterID),
+			Name:   fmt.Sprintf("Team %d", m.RosterID),
+			Points: m.Points,
+		}
+		for _, s := range m.Starters {
+			team.Projected += projected[s]
+		}
+		if u, ok := ownerByRoster[m.RosterID]; ok {
+			team.Owner = u.DisplayName
+			team.Name = u.DisplayName
+			if u.Metadata.TeamName != "" {
+				team.Name = u.Metadata.TeamName
+			}
+			team.Avatar = a.getAvatar(u, avatarBounds)
+		}
+
+		byMatchup[*m.MatchupID] = append(byMatchup[*m.MatchupID], team)
+	}
+
+	ids := make([]int, 0, len(byMatchup))
+	for id := range byMatchup {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var fantasyMatchups []*fantasyboard.Matchup
+	for _, id := range ids {
+		teams := byMatchup[id]
+		if len(teams) != 2 {
+			continue
+		}
+		fantasyMatchups = append(fantasyMatchups, &fantasyboard.Matchup{
+			Week: state.Week,
+			Live: live && (teams[0].Points > 0 || teams[1].Points > 0),
+			Away: teams[0],
+			Home: teams[1],
+		})
+	}
+
+	return fantasyMatchups, nil
+}
+
+func (a *API) get(ctx context.Context, apiPath string, v interface{}) error {
+	if a.mockData != nil {
+		dat, ok := a.mockData[apiPath]
+		if !ok {
+			return fmt.Errorf("no mock data for %s", apiPath)
+		}
+		return json.Unmarshal(dat, v)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", baseURL, apiPath), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to GET %s: %w", apiPath, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to GET %s: http status %s", apiPath, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to unmarshal %s JSON: %w", apiPath, err)
+	}
+
+	return nil
+}
+
+// getAvatar returns the user's custom team avatar, or their user avatar
+func (a *API) getAvatar(u *user, bounds image.Rectangle) *logo.Logo {
+	url := u.Metadata.Avatar
+	if url == "" && u.Avatar != "" {
+		url = fmt.Sprintf("%s/%s", avatarURL, u.Avatar)
+	}
+	if url == "" {
+		return nil
+	}
+
+	a.Lock()
+	defer a.Unlock()
+
+	key := fmt.Sprintf("%s_%dx%d", u.UserID, bounds.Dx(), bounds.Dy())
+	if l, ok := a.avatars[key]; ok {
+		return l
+	}
+
+	l := logo.New(key,
+		func(ctx context.Context) (image.Image, error) {
+			return pullImage(ctx, url)
+		},
+		logoCacheDir,
+		bounds,
+		&logo.Config{
+			Abbrev: key,
+			XSize:  bounds.Dx(),
+			YSize:  bounds.Dy(),
+			Pt: &logo.Pt{
+				Zoom: 1,
+			},
+		},
+	)
+	l.SetLogger(a.log)
+
+	a.avatars[key] = l
+
+	return l
+}
+
+// projectionKey is the projected points stat for the league's points per reception
+func projectionKey(scoring map[string]float64) string {
+	switch scoring["rec"] {
+	case 1:
+		return "pts_ppr"
+	case 0.5:
+		return "pts_half_ppr"
+	}
+	return "pts_std"
+}
+
+// isGameDay is true from Thursday through Monday, when NFL games are played
+func isGameDay(t time.Time) bool {
+	return t.Weekday() != time.Tuesday && t.Weekday() != time.Wednesday
+}
+
+func pullImage(ctx context.Context, url string) (image.Image, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to pull avatar from %s: http status %s", url, resp.Status)
+	}
+
+	img, _, err := image.Decode(resp.Body)
+
+	return img, err
+}
@@ -0,0 +1,41 @@
+package sleeper
+
+import (
+	"context"
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetMatchups(t *testing.T) {
+	t.Parallel()
+
+	mock, err := MockData()
+	require.NoError(t, err)
+
+	api, err := New("mock", zap.NewNop(), WithMockData(mock))
+	require.NoError(t, err)
+
+	matchups, err := api.GetMatchups(context.Background(), image.Rect(0, 0, 16, 16))
+	require.NoError(t, err)
+	require.Len(t, matchups, 2)
+
+	m := matchups[0]
+	require.Equal(t, 5, m.Week)
+	require.Equal(t, "Beet Farmers", m.Away.Name)
+	require.Equal(t, "dwight", m.Away.Owner)
+	require.Equal(t, 98.5, m.Away.Points)
+	require.InDelta(t, 36.0, m.Away.Projected, 0.001)
+	require.NotNil(t, m.Away.Avatar)
+	require.Equal(t, "Big Tuna", m.Home.Name)
+	require.Nil(t, m.Home.Avatar)
+
+	// Teams without a team name use the owner's name
+	m = matchups[1]
+	require.Equal(t, "pam", m.Away.Name)
+	require.False(t, m.Live)
+	require.InDelta(t, 16.3, m.Home.Projected, 0.001)
+	require.NotNil(t, m.Home.Avatar)
+}
@@ -0,0 +1,72 @@
+package sportlive
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+// BasketballGame is a live basketball game with timeout and foul details
+type BasketballGame interface {
+	Game
+	GetTimeouts(ctx context.Context) (int, int, error)
+	GetFouls(ctx context.Context) (int, int, error)
+	// GetBonus returns whether the home and away teams are in the bonus
+	GetBonus(ctx context.Context) (bool, bool, error)
+}
+
+// BasketballLive renders a detailed live basketball game
+type BasketballLive struct {
+	renderer
+}
+
+// NewBasketballLive ...
+func NewBasketballLive(logger *zap.Logger) *BasketballLive {
+	return &BasketballLive{
+		renderer: renderer{
+			log: logger,
+		},
+	}
+}
+
+// RenderLive shows the timeouts left, team fouls and which teams are in the bonus
+func (b *BasketballLive) RenderLive(ctx context.Context, canvas board.Canvas, game BasketballGame, homeLogo *logo.Logo, awayLogo *logo.Logo) error {
+	return b.render(ctx, canvas, game, homeLogo, awayLogo, noSide, b.situation(ctx, game))
+}
+
+// situation returns the lines of the situation half
+func (b *BasketballLive) situation(ctx context.Context, game BasketballGame) []*line {
+	lines := []*line{
+		{text: periodClock(game, "Q", 4)},
+	}
+
+	if home, away, err := game.GetTimeouts(ctx); err == nil {
+		lines = append(lines, &line{text: pair("TO", away, home)})
+	} else {
+		lines = append(lines, nil)
+	}
+
+	if home, away, err := game.GetFouls(ctx); err == nil {
+		lines = append(lines, &line{text: pair("F", away, home)})
+	} else {
+		lines = append(lines, nil)
+	}
+
+	home, away, err := game.GetBonus(ctx)
+	if err == nil && (home || away) {
+		var teams []string
+		if away {
+			teams = append(teams, game.AwayAbbrev())
+		}
+		if home {
+			teams = append(teams, game.HomeAbbrev())
+		}
+		lines = append(lines, &line{text: "BON " + strings.Join(teams, " "), clr: fillColor})
+	}
+
+	return lines
+}
@@ -0,0 +1,78 @@
+package sportlive
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+// FootballGame is a live football game with down and distance details
+type FootballGame interface {
+	Game
+	// GetDownDistance is the down and distance, ie. "2nd & 7"
+	GetDownDistance(ctx context.Context) (string, error)
+	// GetBallPosition is the yard line the ball is on, ie. "BUF 35"
+	GetBallPosition(ctx context.Context) (string, error)
+	// GetPossession is the abbreviation of the team with the ball
+	GetPossession(ctx context.Context) (string, error)
+	IsRedZone(ctx context.Context) bool
+	GetTimeouts(ctx context.Context) (int, int, error)
+}
+
+// FootballLive renders a detailed live football game
+type FootballLive struct {
+	renderer
+}
+
+// NewFootballLive ...
+func NewFootballLive(logger *zap.Logger) *FootballLive {
+	return &FootballLive{
+		renderer: renderer{
+			log: logger,
+		},
+	}
+}
+
+// RenderLive shows the down and distance, ball position and possession
+func (f *FootballLive) RenderLive(ctx context.Context, canvas board.Canvas, game FootballGame, homeLogo *logo.Logo, awayLogo *logo.Logo) error {
+	lines, marked := f.situation(ctx, game)
+
+	return f.render(ctx, canvas, game, homeLogo, awayLogo, marked, lines)
+}
+
+// situation returns the lines of the situation half and the side with possession
+func (f *FootballLive) situation(ctx context.Context, game FootballGame) ([]*line, side) {
+	lines := []*line{
+		{text: periodClock(game, "Q", 4)},
+	}
+
+	downClr := fillColor
+	if game.IsRedZone(ctx) {
+		downClr = highlightColor
+	}
+	if dd, err := game.GetDownDistance(ctx); err == nil {
+		lines = append(lines, &line{text: dd, clr: downClr})
+	} else {
+		lines = append(lines, nil)
+	}
+
+	if pos, err := game.GetBallPosition(ctx); err == nil {
+		lines = append(lines, &line{text: pos})
+	} else {
+		lines = append(lines, nil)
+	}
+
+	if home, away, err := game.GetTimeouts(ctx); err == nil {
+		lines = append(lines, &line{text: pair("TO", away, home)})
+	}
+
+	possession, err := game.GetPossession(ctx)
+	if err != nil {
+		possession = ""
+	}
+
+	return lines, markedSide(game, possession)
+}
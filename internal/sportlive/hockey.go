@@ -0,0 +1,62 @@
+package sportlive
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+// HockeyGame is a live hockey game with power play and shot details
+type HockeyGame interface {
+	Game
+	GetShotsOnGoal(ctx context.Context) (int, int, error)
+	// GetPowerPlay is the abbreviation of the team on the power play, empty at even strength
+	GetPowerPlay(ctx context.Context) (string, error)
+}
+
+// HockeyLive renders a detailed live hockey game
+type HockeyLive struct {
+	renderer
+}
+
+// NewHockeyLive ...
+func NewHockeyLive(logger *zap.Logger) *HockeyLive {
+	return &HockeyLive{
+		renderer: renderer{
+			log: logger,
+		},
+	}
+}
+
+// RenderLive shows the shots on goal and which team is on the power play
+func (h *HockeyLive) RenderLive(ctx context.Context, canvas board.Canvas, game HockeyGame, homeLogo *logo.Logo, awayLogo *logo.Logo) error {
+	lines, marked := h.situation(ctx, game)
+
+	return h.render(ctx, canvas, game, homeLogo, awayLogo, marked, lines)
+}
+
+// situation returns the lines of the situation half and the side on the power play
+func (h *HockeyLive) situation(ctx context.Context, game HockeyGame) ([]*line, side) {
+	lines := []*line{
+		{text: periodClock(game, "P", 3)},
+	}
+
+	if home, away, err := game.GetShotsOnGoal(ctx); err == nil {
+		lines = append(lines, &line{text: pair("SOG", away, home)})
+	} else {
+		lines = append(lines, nil)
+	}
+
+	pp, err := game.GetPowerPlay(ctx)
+	if err != nil {
+		pp = ""
+	}
+	if pp != "" {
+		lines = append(lines, &line{text: "PP " + pp, clr: highlightColor})
+	}
+
+	return lines, markedSide(game, pp)
+}
@@ -0,0 +1,267 @@
+package sportlive
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/logo"
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+var (
+	fillColor      = color.RGBA{255, 255, 0, 255}
+	highlightColor = color.RGBA{255, 0, 0, 255}
+)
+
+// Game is the data shown by every detailed live layout
+type Game interface {
+	GetHomeScore(ctx context.Context) (int, error)
+	GetAwayScore(ctx context.Context) (int, error)
+	HomeAbbrev() string
+	AwayAbbrev() string
+	HomeColor() (*color.RGBA, *color.RGBA, error)
+	AwayColor() (*color.RGBA, *color.RGBA, error)
+	GetQuarter() (string, error)
+	GetClock() (string, error)
+}
+
+// line is a line of text in the situation half of a layout
+type line struct {
+	text string
+	clr  color.Color
+}
+
+// side of the scoreboard that is marked, ie. the team with possession
+type side int
+
+const (
+	noSide side = iota
+	homeSide
+	awaySide
+)
+
+type renderer struct {
+	log    *zap.Logger
+	writer *rgbrender.TextWriter
+	sync.Mutex
+}
+
+func getCanvasWidth(width int, height int) int {
+	// Return the highest X that maintains a 2:1 aspect ratio
+	if width/height == 2 {
+		return width
+	}
+	return height * 2
+}
+
+// render draws the team logos and scores on the left half of the canvas, a marker next
+// to the score of the marked side, and the given lines on the right half.
+func (r *renderer) render(ctx context.Context, canvas board.Canvas, game Game, homeLogo *logo.Logo, awayLogo *logo.Logo, marked side, lines []*line) error {
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+	midX := zeroed.Max.X / 2
+
+	canvasWidth := getCanvasWidth(zeroed.Dx(), zeroed.Dy())
+	quarterW := canvasWidth / 4
+
+	awayLogoBounds := image.Rect(midX-(canvasWidth/2), zeroed.Min.Y, midX-(quarterW), zeroed.Max.Y/2)
+	awayScoreBounds := image.Rect(awayLogoBounds.Max.X, zeroed.Min.Y+1, midX, (zeroed.Max.Y / 2))
+
+	homeLogoBounds := image.Rect(midX-(canvasWidth/2), zeroed.Max.Y/2, midX-(quarterW), zeroed.Max.Y)
+	homeScoreBounds := image.Rect(homeLogoBounds.Max.X, (zeroed.Max.Y / 2), midX, zeroed.Max.Y-1)
+
+	situationBounds := image.Rect(midX, zeroed.Min.Y, midX+(canvasWidth/2), zeroed.Max.Y)
+
+	awayLogoImg, err := awayLogo.GetThumbnail(ctx, awayLogoBounds.Bounds())
+	if err != nil {
+		return err
+	}
+	homeLogoImg, err := homeLogo.GetThumbnail(ctx, homeLogoBounds.Bounds())
+	if err != nil {
+		return err
+	}
+
+	writer, err := r.getWriter(image.Rect(0, 0, canvasWidth, zeroed.Dy()))
+	if err != nil {
+		return err
+	}
+
+	homeScore, err := game.GetHomeScore(ctx)
+	if err != nil {
+		return err
+	}
+	awayScore, err := game.GetAwayScore(ctx)
+	if err != nil {
+		return err
+	}
+
+	draw.Draw(canvas, awayLogoBounds, awayLogoImg, image.Point{}, draw.Over)
+	draw.Draw(canvas, homeLogoBounds, homeLogoImg, image.Point{}, draw.Over)
+
+	var homeClr color.Color
+	var awayClr color.Color
+	homeClr, _, err = game.HomeColor()
+	if err != nil {
+		homeClr = color.White
+	}
+	awayClr, _, err = game.AwayColor()
+	if err != nil {
+		awayClr = color.White
+	}
+
+	img := rgbrender.GradientXRectangle(awayScoreBounds, 0.0, awayClr, r.log)
+	draw.Draw(canvas, img.Bounds(), img, image.Pt(img.Bounds().Min.X, img.Bounds().Min.Y), draw.Over)
+	img = rgbrender.GradientXRectangle(homeScoreBounds, 0.0, homeClr, r.log)
+	draw.Draw(canvas, img.Bounds(), img, image.Pt(img.Bounds().Min.X, img.Bounds().Min.Y), draw.Over)
+
+	origX := writer.XStartCorrection
+	writer.XStartCorrection = 2
+	writeScore(canvas, awayScoreBounds, writer, game.AwayAbbrev(), awayScore)
+	writeScore(canvas, homeScoreBounds, writer, game.HomeAbbrev(), homeScore)
+	writer.XStartCorrection = origX
+
+	markerSize := zeroed.Dy() / 16
+	if markerSize < 2 {
+		markerSize = 2
+	}
+	switch marked {
+	case awaySide:
+		rgbrender.DrawSquare(canvas, image.Pt(awayScoreBounds.Max.X-markerSize-1, awayScoreBounds.Min.Y+1), markerSize, fillColor, fillColor)
+	case homeSide:
+		rgbrender.DrawSquare(canvas, image.Pt(homeScoreBounds.Max.X-markerSize-1, homeScoreBounds.Min.Y+1), markerSize, fillColor, fillColor)
+	}
+
+	if len(lines) < 1 {
+		return nil
+	}
+
+	lineHeight := situationBounds.Dy() / len(lines)
+	for i, l := range lines {
+		if l == nil || l.text == "" {
+			continue
+		}
+		clr := l.clr
+		if clr == nil {
+			clr = color.White
+		}
+		y := situationBounds.Min.Y + (i * lineHeight)
+		if err := writer.WriteAligned(
+			rgbrender.CenterCenter,
+			canvas,
+			image.Rect(situationBounds.Min.X, y, situationBounds.Max.X, y+lineHeight),
+			[]string{l.text},
+			clr,
+		); err != nil {
+			r.log.Error("failed to write live game situation",
+				zap.String("text", l.text),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (r *renderer) getWriter(canvasBounds image.Rectangle) (*rgbrender.TextWriter, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.writer != nil {
+		return r.writer, nil
+	}
+
+	w, err := rgbrender.DefaultTextWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	if canvasBounds.Dy() <= 256 {
+		w.FontSize = 8.0
+		w.YStartCorrection = -2
+	} else {
+		w.FontSize = 0.25 * float64(canvasBounds.Dy())
+		w.YStartCorrection = -1 * ((canvasBounds.Dy() / 32) + 1)
+	}
+
+	r.writer = w
+
+	return w, nil
+}
+
+func writeScore(canvas draw.Image, bounds image.Rectangle, writer *rgbrender.TextWriter, abbrev string, score int) {
+	scoreStr := strconv.Itoa(score)
+	clrs := make([]color.Color, len(abbrev))
+	for x := range clrs {
+		clrs[x] = color.White
+	}
+	scrClrs := make([]color.Color, len(scoreStr))
+	for x := range scrClrs {
+		scrClrs[x] = color.White
+	}
+
+	_ = writer.WriteColorCodes(
+		canvas,
+		bounds,
+		&rgbrender.ColorChar{
+			Lines: []*rgbrender.ColorCharLine{
+				{
+					Chars: strings.Split(abbrev, ""),
+					Clrs:  clrs,
+				},
+				{
+					Chars: strings.Split(scoreStr, ""),
+					Clrs:  scrClrs,
+				},
+			},
+		},
+	)
+}
+
+// markedSide returns the side of the team with the given abbreviation
+func markedSide(game Game, abbrev string) side {
+	switch {
+	case abbrev == "":
+		return noSide
+	case strings.EqualFold(abbrev, game.HomeAbbrev()):
+		return homeSide
+	case strings.EqualFold(abbrev, game.AwayAbbrev()):
+		return awaySide
+	}
+	return noSide
+}
+
+// periodClock formats the period and clock, ie. "Q2 5:32". Periods past the
+// regulation number are shown as overtime.
+func periodClock(game Game, prefix string, regulation int) string {
+	period, err := game.GetQuarter()
+	if err != nil {
+		period = ""
+	}
+	clock, err := game.GetClock()
+	if err != nil {
+		clock = ""
+	}
+
+	if p, err := strconv.Atoi(period); err == nil {
+		if p > regulation {
+			period = "OT"
+		} else {
+			period = fmt.Sprintf("%s%d", prefix, p)
+		}
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%s %s", period, clock))
+}
+
+// pair formats the away and home values in the order the scoreboard shows them
+func pair(label string, away int, home int) string {
+	return fmt.Sprintf("%s %d-%d", label, away, home)
+}
@@ -0,0 +1,347 @@
+package sportlive
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"path/filepath"
+	"testing"
+
+	"github.com/disintegration/imaging"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/imgcanvas"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+// testGame implements FootballGame, HockeyGame and BasketballGame. Nil or empty
+// situation fields return errors, like a game missing its situation data.
+type testGame struct {
+	quarter      string
+	clock        string
+	downDistance string
+	ballPosition string
+	possession   string
+	redZone      bool
+	timeouts     []int
+	shots        []int
+	powerPlay    string
+	fouls        []int
+	bonus        []bool
+}
+
+func (g *testGame) GetHomeScore(ctx context.Context) (int, error) { return 14, nil }
+func (g *testGame) GetAwayScore(ctx context.Context) (int, error) { return 10, nil }
+func (g *testGame) HomeAbbrev() string                            { return "BUF" }
+func (g *testGame) AwayAbbrev() string                            { return "MIA" }
+func (g *testGame) HomeColor() (*color.RGBA, *color.RGBA, error) {
+	return &color.RGBA{0, 0, 255, 255}, nil, nil
+}
+
+func (g *testGame) AwayColor() (*color.RGBA, *color.RGBA, error) {
+	return nil, nil, fmt.Errorf("no color")
+}
+func (g *testGame) GetQuarter() (string, error) { return g.quarter, nil }
+func (g *testGame) GetClock() (string, error)   { return g.clock, nil }
+
+func (g *testGame) GetDownDistance(ctx context.Context) (string, error) {
+	if g.downDistance == "" {
+		return "", fmt.Errorf("no down and distance")
+	}
+	return g.downDistance, nil
+}
+
+func (g *testGame) GetBallPosition(ctx context.Context) (string, error) {
+	if g.ballPosition == "" {
+		return "", fmt.Errorf("no ball position")
+	}
+	return g.ballPosition, nil
+}
+
+func (g *testGame) GetPossession(ctx context.Context) (string, error) {
+	if g.possession == "" {
+		return "", fmt.Errorf("no possession")
+	}
+	return g.possession, nil
+}
+
+func (g *testGame) IsRedZone(ctx context.Context) bool { return g.redZone }
+
+func (g *testGame) GetTimeouts(ctx context.Context) (int, int, error) {
+	if g.timeouts == nil {
+		return 0, 0, fmt.Errorf("no timeouts")
+	}
+	return g.timeouts[0], g.timeouts[1], nil
+}
+
+func (g *testGame) GetShotsOnGoal(ctx context.Context) (int, int, error) {
+	if g.shots == nil {
+		return 0, 0, fmt.Errorf("no shots")
+	}
+	return g.shots[0], g.shots[1], nil
+}
+
+func (g *testGame) GetPowerPlay(ctx context.Context) (string, error) { return g.powerPlay, nil }
+
+func (g *testGame) GetFouls(ctx context.Context) (int, int, error) {
+	if g.fouls == nil {
+		return 0, 0, fmt.Errorf("no fouls")
+	}
+	return g.fouls[0], g.fouls[1], nil
+}
+
+func (g *testGame) GetBonus(ctx context.Context) (bool, bool, error) {
+	if g.bonus == nil {
+		return false, false, fmt.Errorf("no bonus")
+	}
+	return g.bonus[0], g.bonus[1], nil
+}
+
+// texts returns the text of each line, empty for a skipped line
+func texts(lines []*line) []string {
+	t := make([]string, len(lines))
+	for i, l := range lines {
+		if l != nil {
+			t[i] = l.text
+		}
+	}
+	return t
+}
+
+func TestFootballSituation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		game    *testGame
+		expect  []string
+		marked  side
+		downClr color.Color
+	}{
+		{
+			name: "down and distance",
+			game: &testGame{
+				quarter:      "2",
+				clock:        "5:32",
+				downDistance: "2nd & 7",
+				ballPosition: "BUF 35",
+				possession:   "MIA",
+				timeouts:     []int{3, 1},
+			},
+			expect:  []string{"Q2 5:32", "2nd & 7", "BUF 35", "TO 1-3"},
+			marked:  awaySide,
+			downClr: fillColor,
+		},
+		{
+			name: "red zone overtime",
+			game: &testGame{
+				quarter:      "5",
+				clock:        "1:02",
+				downDistance: "1st & Goal",
+				ballPosition: "MIA 4",
+				possession:   "buf",
+				redZone:      true,
+			},
+			expect:  []string{"OT 1:02", "1st & Goal", "MIA 4"},
+			marked:  homeSide,
+			downClr: highlightColor,
+		},
+		{
+			name: "no situation",
+			game: &testGame{
+				quarter: "1",
+				clock:   "15:00",
+			},
+			expect: []string{"Q1 15:00", "", ""},
+			marked: noSide,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			lines, marked := NewFootballLive(zap.NewNop()).situation(context.Background(), test.game)
+			require.Equal(t, test.expect, texts(lines))
+			require.Equal(t, test.marked, marked)
+			if test.downClr != nil {
+				require.Equal(t, test.downClr, lines[1].clr)
+			}
+		})
+	}
+}
+
+func TestHockeySituation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		game   *testGame
+		expect []string
+		marked side
+	}{
+		{
+			name: "power play",
+			game: &testGame{
+				quarter:   "3",
+				clock:     "12:10",
+				shots:     []int{31, 24},
+				powerPlay: "MIA",
+			},
+			expect: []string{"P3 12:10", "SOG 24-31", "PP MIA"},
+			marked: awaySide,
+		},
+		{
+			name: "even strength overtime",
+			game: &testGame{
+				quarter: "4",
+				clock:   "3:00",
+				shots:   []int{40, 38},
+			},
+			expect: []string{"OT 3:00", "SOG 38-40"},
+			marked: noSide,
+		},
+		{
+			name: "no shots",
+			game: &testGame{
+				quarter:   "1",
+				clock:     "20:00",
+				powerPlay: "BUF",
+			},
+			expect: []string{"P1 20:00", "", "PP BUF"},
+			marked: homeSide,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			lines, marked := NewHockeyLive(zap.NewNop()).situation(context.Background(), test.game)
+			require.Equal(t, test.expect, texts(lines))
+			require.Equal(t, test.marked, marked)
+		})
+	}
+}
+
+func TestBasketballSituation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		game   *testGame
+		expect []string
+	}{
+		{
+			name: "home bonus",
+			game: &testGame{
+				quarter:  "4",
+				clock:    "2:15",
+				timeouts: []int{2, 4},
+				fouls:    []int{6, 3},
+				bonus:    []bool{true, false},
+			},
+			expect: []string{"Q4 2:15", "TO 4-2", "F 3-6", "BON BUF"},
+		},
+		{
+			name: "both bonus",
+			game: &testGame{
+				quarter:  "5",
+				clock:    "0:40",
+				timeouts: []int{1, 0},
+				fouls:    []int{5, 5},
+				bonus:    []bool{true, true},
+			},
+			expect: []string{"OT 0:40", "TO 0-1", "F 5-5", "BON MIA BUF"},
+		},
+		{
+			name: "no bonus",
+			game: &testGame{
+				quarter:  "1",
+				clock:    "9:00",
+				timeouts: []int{7, 7},
+				fouls:    []int{1, 2},
+				bonus:    []bool{false, false},
+			},
+			expect: []string{"Q1 9:00", "TO 7-7", "F 2-1"},
+		},
+		{
+			name: "no fouls",
+			game: &testGame{
+				quarter: "2",
+				clock:   "1:00",
+			},
+			expect: []string{"Q2 1:00", "", ""},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			lines := NewBasketballLive(zap.NewNop()).situation(context.Background(), test.game)
+			require.Equal(t, test.expect, texts(lines))
+		})
+	}
+}
+
+func TestRenderLive(t *testing.T) {
+	t.Parallel()
+
+	// Logos are read from saved thumbnails
+	getLogo := func(t *testing.T, key string) *logo.Logo {
+		t.Helper()
+		dir := t.TempDir()
+		l := logo.New(key, nil, dir, image.Rect(0, 0, 128, 64), &logo.Config{Abbrev: key})
+		thumb := imaging.New(32, 32, color.RGBA{255, 0, 0, 255})
+		require.NoError(t, imaging.Save(thumb, filepath.Join(dir, key+".tiff")))
+		return l
+	}
+
+	game := &testGame{
+		quarter:      "2",
+		clock:        "5:32",
+		downDistance: "2nd & 7",
+		possession:   "BUF",
+		shots:        []int{31, 24},
+		fouls:        []int{6, 3},
+		bonus:        []bool{true, false},
+	}
+	logger := zap.NewNop()
+
+	tests := []struct {
+		name   string
+		render func(context.Context, *imgcanvas.ImgCanvas, *logo.Logo, *logo.Logo) error
+	}{
+		{
+			name: "football",
+			render: func(ctx context.Context, c *imgcanvas.ImgCanvas, h *logo.Logo, a *logo.Logo) error {
+				return NewFootballLive(logger).RenderLive(ctx, c, game, h, a)
+			},
+		},
+		{
+			name: "hockey",
+			render: func(ctx context.Context, c *imgcanvas.ImgCanvas, h *logo.Logo, a *logo.Logo) error {
+				return NewHockeyLive(logger).RenderLive(ctx, c, game, h, a)
+			},
+		},
+		{
+			name: "basketball",
+			render: func(ctx context.Context, c *imgcanvas.ImgCanvas, h *logo.Logo, a *logo.Logo) error {
+				return NewBasketballLive(logger).RenderLive(ctx, c, game, h, a)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			canvas := imgcanvas.New(128, 64, logger)
+			require.NoError(t, test.render(context.Background(), canvas, getLogo(t, "BUF"), getLogo(t, "MIA")))
+
+			// The away logo is drawn in the top left quarter
+			r, _, _, _ := canvas.At(5, 5).RGBA()
+			require.NotZero(t, r)
+		})
+	}
+}
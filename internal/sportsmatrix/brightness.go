@@ -0,0 +1,80 @@
+package sportsmatrix
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/brightness"
+)
+
+// scheduledFade is how long scheduled brightness changes take
+const scheduledFade = 5 * time.Second
+
+// SetBrightnessControl manages the brightness of the given matrix with the configured
+// brightness schedules and automatic dimming
+func (s *SportsMatrix) SetBrightnessControl(ctx context.Context, setter brightness.Setter) error {
+	controller, err := brightness.New(setter, s.cfg.Brightness, s.cfg.HardwareConfig.Brightness, s.log)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.brightness = controller
+	if err := s.scheduleBrightness(s.cfg); err != nil {
+		return err
+	}
+
+	go controller.Run(ctx)
+
+	return nil
+}
+
+// scheduleBrightness schedules the brightness changes of the config. Scheduled changes are
+// skipped while automatic dimming is on.
+func (s *SportsMatrix) scheduleBrightness(cfg *Config) error {
+	if s.brightness == nil || cfg.Brightness == nil {
+		return nil
+	}
+
+	for _, sched := range cfg.Brightness.Schedules {
+		level := sched.Brightness
+		if err := s.scheduler.Add("brightness", sched.Times, func() {
+			if s.brightness.Auto() {
+				s.log.Info("skipping scheduled brightness, automatic brightness is on",
+					zap.Int("brightness", level),
+				)
+				return
+			}
+			s.log.Info("setting scheduled brightness",
+				zap.Int("brightness", level),
+			)
+			if err := s.brightness.FadeTo(level, scheduledFade); err != nil {
+				s.log.Error("failed to set scheduled brightness",
+					zap.Error(err),
+				)
+			}
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateBrightness schedules the brightness changes of a reloaded config, then applies its
+// brightness settings. The previous brightness jobs are left for the caller to remove.
+func (s *SportsMatrix) updateBrightness(cfg *Config) error {
+	if s.brightness == nil {
+		return nil
+	}
+
+	if err := s.scheduleBrightness(cfg); err != nil {
+		return err
+	}
+
+	return s.brightness.Reconfigure(cfg.Brightness)
+}
@@ -0,0 +1,269 @@
+package sportsmatrix
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+)
+
+// EventType is the kind of an Event
+type EventType string
+
+const (
+	// GameStarted is published when a game goes live
+	GameStarted EventType = "GameStarted"
+	// ScoreChanged is published when a team's score changes
+	ScoreChanged EventType = "ScoreChanged"
+	// PeriodChanged is published when a live game moves to a new period, quarter or inning
+	PeriodChanged EventType = "PeriodChanged"
+	// GameFinal is published when a game is complete
+	GameFinal EventType = "GameFinal"
+	// Postponed is published when a game is postponed or canceled
+	Postponed EventType = "Postponed"
+	// BoardChanged is published when the matrix starts rendering a different board
+	BoardChanged EventType = "BoardChanged"
+	// ScreenOn is published when the matrix is turned on
+	ScreenOn EventType = "ScreenOn"
+	// ScreenOff is published when the matrix is turned off
+	ScreenOff EventType = "ScreenOff"
+)
+
+const defaultSubscriberBuffer = 100
+
+// Event describes a change in a game or in the matrix state
+type Event struct {
+	Type      EventType `json:"type"`
+	Time      time.Time `json:"time"`
+	Board     string    `json:"board,omitempty"`
+	League    string    `json:"league,omitempty"`
+	GameID    int       `json:"gameId,omitempty"`
+	Home      string    `json:"home,omitempty"`
+	Away      string    `json:"away,omitempty"`
+	HomeScore int       `json:"homeScore"`
+	AwayScore int       `json:"awayScore"`
+	Period    string    `json:"period,omitempty"`
+	Favorite  bool      `json:"favorite"`
+	// Team is the abbreviation of the scoring team for ScoreChanged events
+	Team  string `json:"team,omitempty"`
+	Delta int    `json:"delta,omitempty"`
+}
+
+// IsGameEvent returns true if the event is about a game rather than the matrix
+func (e *Event) IsGameEvent() bool {
+	switch e.Type {
+	case BoardChanged, ScreenOn, ScreenOff:
+		return false
+	}
+	return true
+}
+
+// EventBus publishes game and matrix events to subscribers
+type EventBus struct {
+	log         *zap.Logger
+	subscribers map[int]*subscriber
+	nextID      int
+	sync.RWMutex
+}
+
+type subscriber struct {
+	ch    chan *Event
+	types map[EventType]struct{}
+}
+
+// NewEventBus ...
+func NewEventBus(logger *zap.Logger) *EventBus {
+	return &EventBus{
+		log:         logger,
+		subscribers: make(map[int]*subscriber),
+	}
+}
+
+// Subscribe returns a channel that receives events of the given types, or all events
+// if no types are given. The returned func unsubscribes and closes the channel.
+func (b *EventBus) Subscribe(types ...EventType) (<-chan *Event, func()) {
+	b.Lock()
+	defer b.Unlock()
+
+	sub := &subscriber{
+		ch:    make(chan *Event, defaultSubscriberBuffer),
+		types: make(map[EventType]struct{}),
+	}
+	for _, t := range types {
+		sub.types[t] = struct{}{}
+	}
+
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = sub
+
+	once := sync.Once{}
+
+	return sub.ch, func() {
+		once.Do(func() {
+			b.Lock()
+			defer b.Unlock()
+			delete(b.subscribers, id)
+			close(sub.ch)
+		})
+	}
+}
+
+// Publish sends an event to all subscribers. Slow subscribers whose buffer is full
+// miss the event rather than block the publisher.
+func (b *EventBus) Publish(event *Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	b.RLock()
+	defer b.RUnlock()
+
+	for _, sub := range b.subscribers {
+		if len(sub.types) > 0 {
+			if _, ok := sub.types[event.Type]; !ok {
+				continue
+			}
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			b.log.Warn("event subscriber is full, dropping event",
+				zap.String("type", string(event.Type)),
+				zap.String("league", event.League),
+				zap.Int("game ID", event.GameID),
+			)
+		}
+	}
+}
+
+// GameUpdated compares two states of a game and publishes events for what changed.
+// It implements sportboard.GameUpdateNotifier
+func (b *EventBus) GameUpdated(league string, favorite bool, previous sportboard.Game, current sportboard.Game) {
+	events, err := GameEvents(league, previous, current)
+	if err != nil {
+		b.log.Error("failed to determine game events",
+			zap.Error(err),
+			zap.String("league", league),
+		)
+		return
+	}
+
+	for _, event := range events {
+		event.Favorite = favorite
+		b.Publish(event)
+	}
+}
+
+// GameEvents returns the events that happened between two states of a game
+func GameEvents(league string, previous sportboard.Game, current sportboard.Game) ([]*Event, error) {
+	if previous == nil || current == nil {
+		return nil, nil
+	}
+
+	prev, err := snapshotGame(previous)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := snapshotGame(current)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvent := func(t EventType) *Event {
+		return &Event{
+			Type:      t,
+			League:    league,
+			GameID:    current.GetID(),
+			Home:      cur.home,
+			Away:      cur.away,
+			HomeScore: cur.homeScore,
+			AwayScore: cur.awayScore,
+			Period:    cur.period,
+		}
+	}
+
+	var events []*Event
+
+	if !prev.postponed && cur.postponed {
+		events = append(events, newEvent(Postponed))
+	}
+
+	if !prev.live && !prev.complete && cur.live {
+		events = append(events, newEvent(GameStarted))
+	}
+
+	if delta := cur.homeScore - prev.homeScore; delta != 0 {
+		e := newEvent(ScoreChanged)
+		e.Team = cur.home
+		e.Delta = delta
+		events = append(events, e)
+	}
+	if delta := cur.awayScore - prev.awayScore; delta != 0 {
+		e := newEvent(ScoreChanged)
+		e.Team = cur.away
+		e.Delta = delta
+		events = append(events, e)
+	}
+
+	if prev.live && cur.live && prev.period != cur.period {
+		events = append(events, newEvent(PeriodChanged))
+	}
+
+	if !prev.complete && cur.complete {
+		events = append(events, newEvent(GameFinal))
+	}
+
+	return events, nil
+}
+
+type gameSnapshot struct {
+	home      string
+	away      string
+	homeScore int
+	awayScore int
+	period    string
+	live      bool
+	complete  bool
+	postponed bool
+}
+
+func snapshotGame(game sportboard.Game) (*gameSnapshot, error) {
+	snap := &gameSnapshot{}
+
+	home, err := game.HomeTeam()
+	if err != nil {
+		return nil, err
+	}
+	away, err := game.AwayTeam()
+	if err != nil {
+		return nil, err
+	}
+	snap.home = home.GetAbbreviation()
+	snap.away = away.GetAbbreviation()
+	snap.homeScore = home.Score()
+	snap.awayScore = away.Score()
+
+	snap.live, err = game.IsLive()
+	if err != nil {
+		return nil, err
+	}
+	snap.complete, err = game.IsComplete()
+	if err != nil {
+		return nil, err
+	}
+	snap.postponed, err = game.IsPostponed()
+	if err != nil {
+		return nil, err
+	}
+	if snap.live {
+		snap.period, err = game.GetQuarter()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snap, nil
+}
@@ -0,0 +1,185 @@
+package sportsmatrix
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+)
+
+type testTeam struct {
+	abbrev string
+	score  int
+}
+
+func (t *testTeam) GetID() string {
+	return t.abbrev
+}
+
+func (t *testTeam) GetName() string {
+	return t.abbrev
+}
+
+func (t *testTeam) GetAbbreviation() string {
+	return t.abbrev
+}
+
+func (t *testTeam) GetDisplayName() string {
+	return t.abbrev
+}
+
+func (t *testTeam) Score() int {
+	return t.score
+}
+
+func (t *testTeam) ConferenceName() string {
+	return ""
+}
+
+type testGame struct {
+	home      int
+	away      int
+	period    string
+	live      bool
+	complete  bool
+	postponed bool
+}
+
+func (g *testGame) GetID() int {
+	return 1
+}
+
+func (g *testGame) IsLive() (bool, error) {
+	return g.live, nil
+}
+
+func (g *testGame) IsComplete() (bool, error) {
+	return g.complete, nil
+}
+
+func (g *testGame) IsPostponed() (bool, error) {
+	return g.postponed, nil
+}
+
+func (g *testGame) GetQuarter() (string, error) {
+	return g.period, nil
+}
+
+func (g *testGame) GetLink() (string, error) {
+	return "", nil
+}
+
+func (g *testGame) GetClock() (string, error) {
+	return "", nil
+}
+
+func (g *testGame) GetOdds() (string, string, error) {
+	return "", "", nil
+}
+
+func (g *testGame) HomeTeam() (sportboard.Team, error) {
+	return &testTeam{abbrev: "HOM", score: g.home}, nil
+}
+
+func (g *testGame) AwayTeam() (sportboard.Team, error) {
+	return &testTeam{abbrev: "AWY", score: g.away}, nil
+}
+
+func (g *testGame) GetUpdate(ctx context.Context) (sportboard.Game, error) {
+	return g, nil
+}
+
+func (g *testGame) GetStartTime(ctx context.Context) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func TestGameEvents(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		previous *testGame
+		current  *testGame
+		expected []EventType
+	}{
+		{
+			name:     "no change",
+			previous: &testGame{live: true, period: "1"},
+			current:  &testGame{live: true, period: "1"},
+			expected: nil,
+		},
+		{
+			name:     "started",
+			previous: &testGame{},
+			current:  &testGame{live: true, period: "1"},
+			expected: []EventType{GameStarted},
+		},
+		{
+			name:     "both scored and period change",
+			previous: &testGame{live: true, period: "1"},
+			current:  &testGame{live: true, period: "2", home: 7, away: 3},
+			expected: []EventType{ScoreChanged, ScoreChanged, PeriodChanged},
+		},
+		{
+			name:     "final",
+			previous: &testGame{live: true, period: "4", home: 7},
+			current:  &testGame{complete: true, home: 7},
+			expected: []EventType{GameFinal},
+		},
+		{
+			name:     "postponed",
+			previous: &testGame{},
+			current:  &testGame{postponed: true},
+			expected: []EventType{Postponed},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			events, err := GameEvents("NFL", test.previous, test.current)
+			require.NoError(t, err)
+
+			var types []EventType
+			for _, e := range events {
+				require.Equal(t, "NFL", e.League)
+				types = append(types, e.Type)
+			}
+			require.Equal(t, test.expected, types)
+		})
+	}
+}
+
+func TestEventBus(t *testing.T) {
+	t.Parallel()
+
+	bus := NewEventBus(zap.NewNop())
+
+	all, unsubAll := bus.Subscribe()
+	scores, unsubScores := bus.Subscribe(ScoreChanged)
+	defer unsubScores()
+
+	bus.GameUpdated("NFL", true, &testGame{live: true, period: "1"}, &testGame{live: true, period: "1", away: 3})
+	bus.Publish(&Event{Type: GameFinal})
+
+	e := <-scores
+	require.Equal(t, ScoreChanged, e.Type)
+	require.Equal(t, "AWY", e.Team)
+	require.Equal(t, 3, e.Delta)
+	require.True(t, e.Favorite)
+	require.Len(t, scores, 0)
+
+	require.Equal(t, ScoreChanged, (<-all).Type)
+	require.Equal(t, GameFinal, (<-all).Type)
+
+	unsubAll()
+	unsubAll()
+	_, ok := <-all
+	require.False(t, ok)
+}
@@ -67,35 +67,20 @@ func (s *SportsMatrix) startHTTP() chan error {
 		register("sportsmatrix", h)
 	}
 
-	allBoards := append(s.boards, s.betweenBoards...)
-
-	rpcPaths := make(map[string]struct{})
-
-	for _, b := range allBoards {
-		s.log.Info("register HTTP/RPC handlers for board",
-			zap.String("board", b.Name()),
-		)
-		handlers, err := b.GetHTTPHandlers()
-		if err != nil {
-			errChan <- err
-			return errChan
-		}
-		for _, h := range handlers {
-			register(b.Name(), h)
-		}
-
-		// RPC handlers
-		if path, h := b.GetRPCHandler(); h != nil && path != "" {
-			if _, ok := rpcPaths[path]; !ok {
-				s.log.Info("register RPC Handler",
-					zap.String("path", path),
-					zap.String("board", b.Name()),
-				)
-				router.PathPrefix(path).Handler(h)
-				rpcPaths[path] = struct{}{}
-			}
-		}
+	// Board handlers are served from their own router, so that they can be
+	// replaced when boards are rebuilt
+	boardRouter, endpoints, err := s.boardRouter(append(s.boards, s.betweenBoards...))
+	if err != nil {
+		errChan <- err
+		return errChan
 	}
+	s.setBoardRouter(boardRouter, endpoints)
+	s.httpEndpoints = append(s.httpEndpoints, endpoints...)
+	router.MatcherFunc(func(req *http.Request, match *mux.RouteMatch) bool {
+		return s.getBoardRouter().Match(req, &mux.RouteMatch{})
+	}).HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		s.getBoardRouter().ServeHTTP(w, req)
+	})
 
 	for _, c := range s.canvases {
 		handlers, err := c.GetHTTPHandlers()
@@ -163,6 +148,67 @@ func (s *SportsMatrix) startHTTP() chan error {
 	return errChan
 }
 
+// boardRouter returns a router of the given boards' HTTP and RPC handlers and the HTTP endpoints registered
+func (s *SportsMatrix) boardRouter(boards []board.Board) (*mux.Router, []string, error) {
+	router := mux.NewRouter()
+	endpoints := []string{}
+	registeredPaths := make(map[string]struct{})
+	rpcPaths := make(map[string]struct{})
+
+	for _, b := range boards {
+		s.log.Info("register HTTP/RPC handlers for board",
+			zap.String("board", b.Name()),
+		)
+		handlers, err := b.GetHTTPHandlers()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, h := range handlers {
+			if _, ok := registeredPaths[h.Path]; ok {
+				// This path already registered
+				continue
+			}
+			registeredPaths[h.Path] = struct{}{}
+
+			if !strings.HasPrefix(h.Path, "/api") {
+				h.Path = filepath.Join("/api", h.Path)
+			}
+			s.log.Info("registering http handler", zap.String("name", b.Name()), zap.String("path", h.Path))
+			router.HandleFunc(h.Path, h.Handler)
+			endpoints = append(endpoints, h.Path)
+		}
+
+		// RPC handlers
+		if path, h := b.GetRPCHandler(); h != nil && path != "" {
+			if _, ok := rpcPaths[path]; !ok {
+				s.log.Info("register RPC Handler",
+					zap.String("path", path),
+					zap.String("board", b.Name()),
+				)
+				router.PathPrefix(path).Handler(h)
+				rpcPaths[path] = struct{}{}
+			}
+		}
+	}
+
+	return router, endpoints, nil
+}
+
+func (s *SportsMatrix) setBoardRouter(router *mux.Router, endpoints []string) {
+	s.boardRoutesLock.Lock()
+	defer s.boardRoutesLock.Unlock()
+
+	s.boardRoutes = router
+	s.boardEndpoints = endpoints
+}
+
+func (s *SportsMatrix) getBoardRouter() *mux.Router {
+	s.boardRoutesLock.RLock()
+	defer s.boardRoutesLock.RUnlock()
+
+	return s.boardRoutes
+}
+
 func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 	return []*board.HTTPHandler{
 		{
@@ -282,7 +328,7 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 		{
 			Path: "/api/nextboard",
 			Handler: func(w http.ResponseWriter, req *http.Request) {
-				s.currentBoardCancel()
+				s.cancelCurrentBoard()
 			},
 		},
 	}
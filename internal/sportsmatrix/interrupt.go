@@ -0,0 +1,172 @@
+package sportsmatrix
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+const defaultInterruptDuration = 20 * time.Second
+
+var errInterrupted = errors.New("board was interrupted")
+
+// RequestInterrupt asks the matrix to preempt whatever is on the screen. A board that
+// is interrupted is resumed when the Interrupt is done. Scrolling boards, including
+// combined scroll, are paused and continue from where they left off.
+// RequestInterrupt implements board.Interrupter
+func (s *SportsMatrix) RequestInterrupt(interrupt *board.Interrupt) {
+	if interrupt == nil || interrupt.Render == nil {
+		return
+	}
+	if interrupt.Duration <= 0 {
+		interrupt.Duration = defaultInterruptDuration
+	}
+
+	s.interruptLock.Lock()
+	defer s.interruptLock.Unlock()
+
+	if s.pendingInterrupt != nil && s.pendingInterrupt.Priority > interrupt.Priority {
+		s.log.Info("dropping interrupt, a higher priority interrupt is pending",
+			zap.String("board", interrupt.Board),
+			zap.Int("priority", interrupt.Priority),
+		)
+		return
+	}
+
+	s.pendingInterrupt = interrupt
+
+	if s.activeInterrupt != nil && interrupt.Priority > s.activeInterrupt.Priority {
+		s.log.Info("preempting active interrupt",
+			zap.String("active", s.activeInterrupt.Board),
+			zap.String("board", interrupt.Board),
+		)
+		s.interruptCancel()
+	}
+
+	select {
+	case s.interruptSignal <- struct{}{}:
+	default:
+	}
+}
+
+func (s *SportsMatrix) setInterrupters(boards []board.Board) {
+	for _, b := range boards {
+		if i, ok := b.(board.InterruptRequester); ok {
+			i.SetInterrupter(s.RequestInterrupt)
+		}
+	}
+}
+
+// serveInterrupts renders Interrupts as they are requested until the context is canceled
+func (s *SportsMatrix) serveInterrupts(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.interruptSignal:
+		}
+
+		for {
+			interrupt, interruptCtx := s.nextInterrupt(ctx)
+			if interrupt == nil {
+				break
+			}
+			s.doInterrupt(interruptCtx, interrupt)
+			s.finishInterrupt()
+		}
+	}
+}
+
+func (s *SportsMatrix) nextInterrupt(ctx context.Context) (*board.Interrupt, context.Context) {
+	s.interruptLock.Lock()
+	defer s.interruptLock.Unlock()
+
+	interrupt := s.pendingInterrupt
+	if interrupt == nil {
+		return nil, nil
+	}
+	s.pendingInterrupt = nil
+
+	var interruptCtx context.Context
+	interruptCtx, s.interruptCancel = context.WithTimeout(ctx, interrupt.Duration)
+	s.activeInterrupt = interrupt
+
+	return interrupt, interruptCtx
+}
+
+func (s *SportsMatrix) finishInterrupt() {
+	s.interruptLock.Lock()
+	defer s.interruptLock.Unlock()
+
+	s.interruptCancel()
+	s.activeInterrupt = nil
+	s.interruptCancel = nil
+}
+
+func (s *SportsMatrix) doInterrupt(ctx context.Context, interrupt *board.Interrupt) {
+	if !s.screenIsOn.Load() {
+		s.log.Info("screen is off, ignoring interrupt",
+			zap.String("board", interrupt.Board),
+		)
+		return
+	}
+
+	s.log.Info("interrupting matrix",
+		zap.String("board", interrupt.Board),
+		zap.Int("priority", interrupt.Priority),
+		zap.Duration("duration", interrupt.Duration),
+	)
+
+	// New boards wait to render until the interrupt is done
+	s.interruptHold.Lock()
+	defer s.interruptHold.Unlock()
+
+	if s.scrollInProgress.Load() || s.currentScrolling.Load() {
+		s.playGate.Pause()
+		defer s.playGate.Resume()
+	} else {
+		s.interruptCount.Inc()
+		s.cancelCurrentBoard()
+		s.boardLock.Lock()
+		defer s.boardLock.Unlock()
+	}
+
+	s.events.Publish(&Event{
+		Type:  BoardChanged,
+		Board: interrupt.Board,
+	})
+
+	for _, canvas := range s.canvases {
+		if !canvas.Enabled() || canvas.Scrollable() {
+			continue
+		}
+		if err := canvas.Clear(); err != nil {
+			s.log.Error("failed to clear canvas for interrupt", zap.Error(err))
+		}
+		if err := interrupt.Render(ctx, canvas); err != nil {
+			s.log.Error("interrupt render failed",
+				zap.Error(err),
+				zap.String("board", interrupt.Board),
+			)
+			continue
+		}
+		if err := canvas.Render(ctx); err != nil {
+			s.log.Error("failed to render interrupt canvas",
+				zap.Error(err),
+				zap.String("board", interrupt.Board),
+			)
+		}
+	}
+
+	<-ctx.Done()
+}
+
+// waitForInterrupt blocks while an Interrupt is on the screen
+func (s *SportsMatrix) waitForInterrupt() {
+	s.interruptHold.RLock()
+	defer s.interruptHold.RUnlock()
+}
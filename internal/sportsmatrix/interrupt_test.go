@@ -0,0 +1,292 @@
+package sportsmatrix
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/robbydyer/sports/internal/board"
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+// blockingBoard renders until its context is canceled
+type blockingBoard struct {
+	enabler board.Enabler
+	started chan struct{}
+}
+
+func (b *blockingBoard) Enabler() board.Enabler {
+	return b.enabler
+}
+
+func (b *blockingBoard) InBetween() bool {
+	return false
+}
+
+func (b *blockingBoard) Name() string {
+	return "Blocking Board"
+}
+
+func (b *blockingBoard) ScrollRender(ctx context.Context, canvas board.Canvas, pad int) (board.Canvas, error) {
+	return nil, nil
+}
+
+func (b *blockingBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	close(b.started)
+	<-ctx.Done()
+	return context.Canceled
+}
+
+func (b *blockingBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+func (b *blockingBoard) GetRPCHandler() (string, http.Handler) {
+	return "", nil
+}
+
+func (b *blockingBoard) ScrollMode() bool {
+	return false
+}
+
+func TestInterrupt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		WebBoardWidth: 1,
+	}
+
+	canvas := board.NewBlankCanvas(1, 1, logger)
+	canvas.Enable()
+
+	b := &blockingBoard{
+		enabler: enabler.New(),
+		started: make(chan struct{}),
+	}
+	b.enabler.Enable()
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{canvas}, b)
+	require.NoError(t, err)
+
+	go s.serveInterrupts(ctx)
+
+	boardCtx, boardCancel := context.WithCancel(ctx)
+	s.setCurrentBoardCancel(boardCancel)
+	boardDone := make(chan error)
+	go func() {
+		boardDone <- s.doBoard(boardCtx, b)
+	}()
+	<-b.started
+
+	rendered := atomic.NewString("")
+	s.RequestInterrupt(&board.Interrupt{
+		Board:    "low",
+		Priority: 1,
+		Duration: time.Minute,
+		Render: func(ctx context.Context, canvas board.Canvas) error {
+			rendered.Store("low")
+			return nil
+		},
+	})
+
+	select {
+	case err := <-boardDone:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for board to be interrupted")
+	}
+	require.Equal(t, int64(1), s.interruptCount.Load())
+	require.Eventually(t, func() bool { return rendered.Load() == "low" }, 5*time.Second, 10*time.Millisecond)
+
+	// A higher priority interrupt preempts the active one
+	s.RequestInterrupt(&board.Interrupt{
+		Board:    "high",
+		Priority: 2,
+		Duration: 10 * time.Millisecond,
+		Render: func(ctx context.Context, canvas board.Canvas) error {
+			rendered.Store("high")
+			return nil
+		},
+	})
+	require.Eventually(t, func() bool { return rendered.Load() == "high" }, 5*time.Second, 10*time.Millisecond)
+
+	// Boards wait for the interrupt to finish, then render again
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.waitForInterrupt()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for interrupt to finish")
+	}
+}
+
+type scoreTeam struct {
+	abbreviation string
+	score        *atomic.Int64
+}
+
+func (t *scoreTeam) GetID() string           { return t.abbreviation }
+func (t *scoreTeam) GetName() string         { return t.abbreviation }
+func (t *scoreTeam) GetAbbreviation() string { return t.abbreviation }
+func (t *scoreTeam) GetDisplayName() string  { return t.abbreviation }
+func (t *scoreTeam) Score() int              { return int(t.score.Load()) }
+func (t *scoreTeam) ConferenceName() string  { return "" }
+
+// scoreGame is a live game whose updates return the current score of its teams
+type scoreGame struct {
+	home  *scoreTeam
+	away  *scoreTeam
+	score int64
+}
+
+func (g *scoreGame) GetID() int                       { return 1 }
+func (g *scoreGame) GetLink() (string, error)         { return "", nil }
+func (g *scoreGame) IsLive() (bool, error)            { return true, nil }
+func (g *scoreGame) IsComplete() (bool, error)        { return false, nil }
+func (g *scoreGame) IsPostponed() (bool, error)       { return false, nil }
+func (g *scoreGame) GetQuarter() (string, error)      { return "1", nil }
+func (g *scoreGame) GetClock() (string, error)        { return "10:00", nil }
+func (g *scoreGame) GetOdds() (string, string, error) { return "", "", nil }
+
+func (g *scoreGame) HomeTeam() (sportboard.Team, error) {
+	return &scoreTeam{abbreviation: g.home.abbreviation, score: atomic.NewInt64(g.score)}, nil
+}
+
+func (g *scoreGame) AwayTeam() (sportboard.Team, error) {
+	return g.away, nil
+}
+
+func (g *scoreGame) GetUpdate(ctx context.Context) (sportboard.Game, error) {
+	return &scoreGame{home: g.home, away: g.away, score: g.home.score.Load()}, nil
+}
+
+func (g *scoreGame) GetStartTime(ctx context.Context) (time.Time, error) {
+	return time.Now(), nil
+}
+
+type scoreAPI struct {
+	game *scoreGame
+}
+
+func (a *scoreAPI) GetTeams(ctx context.Context) ([]sportboard.Team, error) {
+	return []sportboard.Team{a.game.home, a.game.away}, nil
+}
+
+func (a *scoreAPI) TeamFromID(ctx context.Context, id string) (sportboard.Team, error) {
+	return nil, fmt.Errorf("no team %s", id)
+}
+
+func (a *scoreAPI) GetScheduledGames(ctx context.Context, date []time.Time) ([]sportboard.Game, error) {
+	return []sportboard.Game{a.game}, nil
+}
+
+func (a *scoreAPI) DateStr(d time.Time) string { return d.Format("20060102") }
+func (a *scoreAPI) League() string             { return "TEST" }
+func (a *scoreAPI) HTTPPathPrefix() string     { return "test" }
+
+func (a *scoreAPI) GetLogo(ctx context.Context, logoKey string, logoConf *logo.Config, bounds image.Rectangle) (*logo.Logo, error) {
+	return nil, fmt.Errorf("no logo %s", logoKey)
+}
+
+func (a *scoreAPI) GetWatchTeams(teams []string, season string) []string { return teams }
+
+func (a *scoreAPI) TeamRecord(ctx context.Context, team sportboard.Team, season string) string {
+	return ""
+}
+
+func (a *scoreAPI) TeamRank(ctx context.Context, team sportboard.Team, season string) string {
+	return ""
+}
+
+func (a *scoreAPI) CacheClear(ctx context.Context) {}
+func (a *scoreAPI) HomeSideSwap() bool             { return false }
+
+func TestInterruptOnScore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		WebBoardWidth: 1,
+	}
+
+	canvas := board.NewBlankCanvas(1, 1, logger)
+	canvas.Enable()
+
+	api := &scoreAPI{
+		game: &scoreGame{
+			home: &scoreTeam{abbreviation: "HOME", score: atomic.NewInt64(0)},
+			away: &scoreTeam{abbreviation: "AWAY", score: atomic.NewInt64(0)},
+		},
+	}
+	sportCfg := &sportboard.Config{
+		StartEnabled:           atomic.NewBool(true),
+		FavoriteTeams:          []string{"HOME"},
+		InterruptOnScore:       atomic.NewBool(true),
+		InterruptCheckInterval: "10ms",
+		InterruptDuration:      "10ms",
+	}
+	sportCfg.SetDefaults()
+	sportBoard, err := sportboard.New(ctx, api, canvas.Bounds(), nil, logger, sportCfg)
+	require.NoError(t, err)
+	defer sportBoard.Close()
+
+	b := &blockingBoard{
+		enabler: enabler.New(),
+		started: make(chan struct{}),
+	}
+	b.enabler.Enable()
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{canvas}, b, sportBoard)
+	require.NoError(t, err)
+
+	go s.serveInterrupts(ctx)
+
+	boardCtx, boardCancel := context.WithCancel(ctx)
+	s.setCurrentBoardCancel(boardCancel)
+	boardDone := make(chan error)
+	go func() {
+		boardDone <- s.doBoard(boardCtx, b)
+	}()
+	<-b.started
+
+	// Let the sport board see the game before the score changes
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, int64(0), s.interruptCount.Load())
+
+	api.game.home.score.Store(1)
+
+	select {
+	case err := <-boardDone:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for score change to interrupt board")
+	}
+	require.Equal(t, int64(1), s.interruptCount.Load())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.waitForInterrupt()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for interrupt to finish")
+	}
+}
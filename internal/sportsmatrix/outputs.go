@@ -0,0 +1,145 @@
+package sportsmatrix
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+// outputIdleWait is how long an output waits before checking its boards again when none of
+// them are enabled or the screen is off
+const outputIdleWait = 5 * time.Second
+
+// Output is an additional display the matrix draws to
+type Output struct {
+	Name string
+	// Boards are the names of the boards the output rotates through. If empty, the output
+	// mirrors the main matrix.
+	Boards   []string
+	Canvases []board.Canvas
+}
+
+// AddOutput adds an output. It must be called before Serve.
+func (s *SportsMatrix) AddOutput(o *Output) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.log.Info("adding output",
+		zap.String("output", o.Name),
+		zap.Strings("boards", o.Boards),
+	)
+
+	if len(o.Boards) < 1 {
+		s.canvases = append(s.canvases, o.Canvases...)
+		return
+	}
+
+	s.outputs = append(s.outputs, o)
+}
+
+// serveOutput rotates through the boards of an output until the context is canceled
+func (s *SportsMatrix) serveOutput(ctx context.Context, o *Output) {
+	for _, name := range o.Boards {
+		if s.boardByName(name) == nil {
+			s.log.Warn("output board does not exist",
+				zap.String("output", o.Name),
+				zap.String("board", name),
+			)
+		}
+	}
+
+	for {
+		rendered := false
+		for _, name := range o.Boards {
+			if ctx.Err() != nil {
+				return
+			}
+			if !s.screenIsOn.Load() {
+				break
+			}
+
+			s.waitForInterrupt()
+
+			b := s.boardByName(name)
+			if b == nil || !b.Enabler().Enabled() || !s.boardAllowed(ctx, b) {
+				continue
+			}
+
+			s.Lock()
+			boardCtx := s.boardCtx
+			s.Unlock()
+
+			if err := s.renderOutput(boardCtx, o, b); err != nil {
+				s.log.Error("output board render returned error",
+					zap.String("output", o.Name),
+					zap.String("board", b.Name()),
+					zap.Error(err),
+				)
+				continue
+			}
+			rendered = true
+		}
+
+		if rendered {
+			continue
+		}
+
+		s.clearOutput(o)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(outputIdleWait):
+		}
+	}
+}
+
+// renderOutput renders a board to each of an output's canvases that suit the board's scroll mode.
+// It waits for the main loop or another output to finish rendering the board.
+func (s *SportsMatrix) renderOutput(ctx context.Context, o *Output, b board.Board) error {
+	unlock, err := s.lockBoard(ctx, b)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var boardErr error
+
+	for _, canvas := range o.Canvases {
+		if !canvas.Enabled() {
+			continue
+		}
+		if b.ScrollMode() != canvas.Scrollable() && !canvas.AlwaysRender() {
+			continue
+		}
+
+		wg.Add(1)
+		go func(canvas board.Canvas) {
+			defer wg.Done()
+			if err := b.Render(ctx, canvas); err != nil {
+				lock.Lock()
+				boardErr = err
+				lock.Unlock()
+			}
+		}(canvas)
+	}
+	wg.Wait()
+
+	return boardErr
+}
+
+func (s *SportsMatrix) clearOutput(o *Output) {
+	for _, canvas := range o.Canvases {
+		if err := canvas.Clear(); err != nil {
+			s.log.Error("failed to clear output",
+				zap.String("output", o.Name),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package sportsmatrix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+)
+
+func TestOutputs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+
+	cfg := &Config{
+		WebBoardWidth: 1,
+	}
+	cfg.Defaults()
+
+	b := &TestBoard{
+		log:         logger,
+		hasRendered: atomic.NewBool(false),
+		tester:      t,
+		enabler:     enabler.New(),
+	}
+	b.enabler.Enable()
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{}, b)
+	require.NoError(t, err)
+
+	mirror := board.NewBlankCanvas(1, 1, logger)
+	s.AddOutput(&Output{
+		Name:     "mirror",
+		Canvases: []board.Canvas{mirror},
+	})
+	require.Contains(t, s.canvases, board.Canvas(mirror))
+	require.Empty(t, s.outputs)
+
+	rotation := board.NewBlankCanvas(1, 1, logger)
+	rotation.Enable()
+	o := &Output{
+		Name:     "desk",
+		Boards:   []string{"blank board"},
+		Canvases: []board.Canvas{rotation},
+	}
+	s.AddOutput(o)
+	require.Len(t, s.outputs, 1)
+	require.NotContains(t, s.canvases, board.Canvas(rotation))
+
+	go s.serveOutput(ctx, o)
+
+	require.Eventually(t, b.HasRendered, 10*time.Second, 100*time.Millisecond)
+}
+
+// sharedBoard records the canvas it last rendered to without a lock, like boards that keep
+// render state, so the race detector catches concurrent renders
+type sharedBoard struct {
+	enabler    board.Enabler
+	main       board.Canvas
+	last       board.Canvas
+	mainCount  *atomic.Int32
+	otherCount *atomic.Int32
+}
+
+func (b *sharedBoard) Enabler() board.Enabler { return b.enabler }
+func (b *sharedBoard) InBetween() bool        { return false }
+func (b *sharedBoard) Name() string           { return "shared board" }
+func (b *sharedBoard) ScrollMode() bool       { return false }
+func (b *sharedBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+func (b *sharedBoard) GetRPCHandler() (string, http.Handler) {
+	return "", nil
+}
+func (b *sharedBoard) SetStateChangeNotifier(st board.StateChangeNotifier) {}
+
+func (b *sharedBoard) ScrollRender(ctx context.Context, canvas board.Canvas, pad int) (board.Canvas, error) {
+	return nil, nil
+}
+
+func (b *sharedBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	b.last = canvas
+	time.Sleep(time.Millisecond)
+	if b.last == b.main {
+		b.mainCount.Inc()
+	} else {
+		b.otherCount.Inc()
+	}
+	return nil
+}
+
+func TestOutputSharesBoardWithMainLoop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+
+	cfg := &Config{
+		WebBoardWidth: 1,
+	}
+	cfg.Defaults()
+
+	mainCanvas := board.NewBlankCanvas(1, 1, logger)
+	mainCanvas.Enable()
+	b := &sharedBoard{
+		enabler:    enabler.New(),
+		main:       mainCanvas,
+		mainCount:  atomic.NewInt32(0),
+		otherCount: atomic.NewInt32(0),
+	}
+	b.enabler.Enable()
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{mainCanvas}, b)
+	require.NoError(t, err)
+
+	rotation := board.NewBlankCanvas(1, 1, logger)
+	rotation.Enable()
+	o := &Output{
+		Name:     "desk",
+		Boards:   []string{b.Name()},
+		Canvases: []board.Canvas{rotation},
+	}
+	s.AddOutput(o)
+
+	loopDone := make(chan struct{})
+	go func() {
+		defer close(loopDone)
+		for ctx.Err() == nil {
+			s.serveLoop(ctx)
+		}
+	}()
+	outputDone := make(chan struct{})
+	go func() {
+		defer close(outputDone)
+		s.serveOutput(ctx, o)
+	}()
+
+	require.Eventually(t, func() bool {
+		return b.mainCount.Load() > 10 && b.otherCount.Load() > 10
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Outputs don't start boards while an interrupt is on the screen
+	s.interruptHold.Lock()
+	time.Sleep(50 * time.Millisecond)
+	held := b.otherCount.Load()
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, held, b.otherCount.Load())
+	s.interruptHold.Unlock()
+
+	require.Eventually(t, func() bool {
+		return b.otherCount.Load() > held
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-loopDone
+	<-outputDone
+}
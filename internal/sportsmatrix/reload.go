@@ -0,0 +1,244 @@
+package sportsmatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/playlist"
+	"github.com/robbydyer/sports/internal/rules"
+)
+
+// SetBoards replaces the boards being served, ie. after they were rebuilt from a reloaded config.
+// Boards with the in-between setting enabled are run between each of the other boards.
+func (s *SportsMatrix) SetBoards(boards []board.Board) error {
+	var newBoards []board.Board
+	var betweenBoards []board.Board
+	for _, b := range boards {
+		if b.InBetween() {
+			betweenBoards = append(betweenBoards, b)
+		} else {
+			newBoards = append(newBoards, b)
+		}
+	}
+
+	all := make([]board.Board, 0, len(boards))
+	all = append(all, newBoards...)
+	all = append(all, betweenBoards...)
+	router, endpoints, err := s.boardRouter(all)
+	if err != nil {
+		return fmt.Errorf("failed to register board handlers: %w", err)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	replaced := make([]board.Board, 0, len(s.boards)+len(s.betweenBoards))
+	replaced = append(replaced, s.boards...)
+	replaced = append(replaced, s.betweenBoards...)
+	for _, b := range replaced {
+		s.scheduler.RemoveBoard(b)
+	}
+	for _, b := range all {
+		if err := s.scheduler.AddBoard(b); err != nil {
+			return err
+		}
+	}
+	s.setInterrupters(all)
+
+	s.boards = newBoards
+	s.betweenBoards = betweenBoards
+	s.setBoardRouter(router, endpoints)
+
+	order := []string{}
+	for _, b := range all {
+		order = append(order, b.Name())
+	}
+	s.log.Info("boards replaced",
+		zap.Strings("boards", order),
+	)
+
+	s.restartBoards()
+
+	s.closeBoards(replaced, all)
+
+	return nil
+}
+
+// closeBoards closes the replaced boards that aren't in use anymore
+func (s *SportsMatrix) closeBoards(replaced []board.Board, current []board.Board) {
+	inUse := make(map[board.Board]struct{}, len(current))
+	for _, b := range current {
+		inUse[b] = struct{}{}
+	}
+
+	for _, b := range replaced {
+		if _, ok := inUse[b]; ok {
+			continue
+		}
+		c, ok := b.(board.Closer)
+		if !ok {
+			continue
+		}
+		if err := c.Close(); err != nil {
+			s.log.Error("failed to close replaced board",
+				zap.String("board", b.Name()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// UpdateConfig applies the settings of a reloaded config that can be changed while the
+// matrix is running. It returns the settings that differ from the running config but
+// can't be changed until the service is restarted.
+func (s *SportsMatrix) UpdateConfig(cfg *Config) ([]string, error) {
+	cfg.Defaults()
+
+	restart, err := restartRequired(s.cfg, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, spec := range append(append([]string{}, cfg.ScreenOffTimes...), cfg.ScreenOnTimes...) {
+		if _, err := cron.ParseStandard(spec); err != nil {
+			return nil, fmt.Errorf("invalid screen on/off time '%s': %w", spec, err)
+		}
+	}
+
+	var playlists *playlist.Playlists
+	if len(cfg.Playlists) > 0 {
+		playlists, err = playlist.New(cfg.Playlists, cfg.DefaultPlaylist, s.log)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := rules.New(cfg.Rules, s.log)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Brightness != nil {
+		for _, sched := range cfg.Brightness.Schedules {
+			for _, spec := range sched.Times {
+				if _, err := cron.ParseStandard(spec); err != nil {
+					return nil, fmt.Errorf("invalid brightness time '%s': %w", spec, err)
+				}
+			}
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	// The running schedule is kept if the new one can't be applied
+	if err := s.scheduler.Swap([]string{"screen off", "screen on", "brightness"}, func() error {
+		if err := s.scheduleScreen(cfg); err != nil {
+			return err
+		}
+		return s.updateBrightness(cfg)
+	}); err != nil {
+		return nil, err
+	}
+
+	s.cfg.ScreenOffTimes = cfg.ScreenOffTimes
+	s.cfg.ScreenOnTimes = cfg.ScreenOnTimes
+	s.cfg.Brightness = cfg.Brightness
+	s.cfg.CombinedScroll.Store(cfg.CombinedScroll.Load())
+	s.cfg.CombinedScrollDelay = cfg.CombinedScrollDelay
+	s.cfg.combinedScrollDelay = cfg.combinedScrollDelay
+	s.cfg.CombinedScrollPadding = cfg.CombinedScrollPadding
+	s.cfg.PreloadThreads = cfg.PreloadThreads
+	s.cfg.LaunchWebBoard = cfg.LaunchWebBoard
+	s.cfg.Playlists = cfg.Playlists
+	s.cfg.DefaultPlaylist = cfg.DefaultPlaylist
+	s.cfg.Rules = cfg.Rules
+	s.playlists = playlists
+	s.rules = r
+
+	s.restartBoards()
+
+	return restart, nil
+}
+
+// SetRestartRequired sets the config settings that won't be applied until the service is restarted
+func (s *SportsMatrix) SetRestartRequired(settings []string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if len(settings) > 0 {
+		s.log.Warn("config changes require a service restart",
+			zap.Strings("settings", settings),
+		)
+	}
+
+	s.restartRequired = settings
+}
+
+// RestartRequired returns the config settings that won't be applied until the service is restarted
+func (s *SportsMatrix) RestartRequired() []string {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.restartRequired
+}
+
+// restartBoards stops the boards currently being served, so that serving starts over with
+// the current boards and config. Must be called while locked.
+func (s *SportsMatrix) restartBoards() {
+	if s.serveContext == nil {
+		return
+	}
+
+	s.boardCancel()
+	s.boardCtx, s.boardCancel = context.WithCancel(s.serveContext)
+}
+
+func (s *SportsMatrix) getBoards() []board.Board {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.boards
+}
+
+// restartRequired returns the json names of the settings that differ between the configs
+// and can't be changed while running
+func restartRequired(running *Config, cfg *Config) ([]string, error) {
+	settings := []struct {
+		name    string
+		running interface{}
+		cfg     interface{}
+	}{
+		{"serveWebUI", running.ServeWebUI, cfg.ServeWebUI},
+		{"httpListenPort", running.HTTPListenPort, cfg.HTTPListenPort},
+		{"hardwareConfig", running.HardwareConfig, cfg.HardwareConfig},
+		{"runtimeOptions", running.RuntimeOptions, cfg.RuntimeOptions},
+		{"webBoardWidth", running.WebBoardWidth, cfg.WebBoardWidth},
+		{"webBoardHeight", running.WebBoardHeight, cfg.WebBoardHeight},
+		{"webBoardUser", running.WebBoardUser, cfg.WebBoardUser},
+		{"outputs", running.Outputs, cfg.Outputs},
+	}
+
+	var restart []string
+	for _, setting := range settings {
+		a, err := json.Marshal(setting.running)
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(setting.cfg)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(a, b) {
+			restart = append(restart, setting.name)
+		}
+	}
+
+	return restart, nil
+}
@@ -0,0 +1,119 @@
+package sportsmatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/brightness"
+	"github.com/robbydyer/sports/internal/enabler"
+)
+
+// closeBoard is a TestBoard that records when it's closed
+type closeBoard struct {
+	*TestBoard
+	closed *atomic.Bool
+}
+
+func (b *closeBoard) Close() error {
+	b.closed.Store(true)
+	return nil
+}
+
+func TestUpdateConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		HTTPListenPort: 8080,
+		WebBoardWidth:  1,
+		ScreenOffTimes: []string{"0 22 * * *"},
+	}
+	cfg.Defaults()
+
+	canvas := board.NewBlankCanvas(1, 1, logger)
+
+	b := &closeBoard{
+		TestBoard: &TestBoard{
+			log:         logger,
+			hasRendered: atomic.NewBool(false),
+			enabler:     enabler.New(),
+		},
+		closed: atomic.NewBool(false),
+	}
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{canvas}, b)
+	require.NoError(t, err)
+
+	screenJobs := func() []string {
+		specs := []string{}
+		for _, j := range s.scheduler.Jobs() {
+			if j.Name == "screen off" || j.Name == "screen on" {
+				specs = append(specs, j.Name+" "+j.Spec)
+			}
+		}
+		return specs
+	}
+	require.Equal(t, []string{"screen off 0 22 * * *"}, screenJobs())
+
+	restart, err := s.UpdateConfig(&Config{
+		HTTPListenPort: 8080,
+		WebBoardWidth:  1,
+		ScreenOffTimes: []string{"0 23 * * *"},
+		ScreenOnTimes:  []string{"0 7 * * *"},
+		CombinedScroll: atomic.NewBool(true),
+	})
+	require.NoError(t, err)
+	require.Empty(t, restart)
+	require.Equal(t, []string{"screen off 0 23 * * *", "screen on 0 7 * * *"}, screenJobs())
+	require.True(t, s.cfg.CombinedScroll.Load())
+
+	// A config that fails to apply leaves the running schedule in place
+	require.NoError(t, s.SetBrightnessControl(ctx, brightness.Setters{}))
+	_, err = s.UpdateConfig(&Config{
+		HTTPListenPort: 8080,
+		WebBoardWidth:  1,
+		ScreenOffTimes: []string{"0 21 * * *"},
+		Brightness: &brightness.Config{
+			Schedules: []*brightness.Schedule{
+				{
+					Times:      []string{"0 6 * * *"},
+					Brightness: 200,
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, []string{"screen off 0 23 * * *", "screen on 0 7 * * *"}, screenJobs())
+	for _, j := range s.scheduler.Jobs() {
+		require.NotEqual(t, "brightness", j.Name)
+	}
+
+	restart, err = s.UpdateConfig(&Config{
+		HTTPListenPort: 8081,
+		WebBoardWidth:  1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"httpListenPort"}, restart)
+	require.Empty(t, screenJobs())
+
+	_, err = s.UpdateConfig(&Config{
+		ScreenOnTimes: []string{"not a cron spec"},
+	})
+	require.Error(t, err)
+
+	replacement := &TestBoard{
+		log:         logger,
+		hasRendered: atomic.NewBool(false),
+		enabler:     enabler.New(),
+	}
+	require.NoError(t, s.SetBoards([]board.Board{replacement}))
+	require.Equal(t, []board.Board{replacement}, s.getBoards())
+	require.True(t, b.closed.Load())
+}
@@ -120,17 +120,16 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.Status) (*emptypb.Empty,
 // GetStatus ...
 func (s *Server) GetStatus(ctx context.Context, req *emptypb.Empty) (*pb.Status, error) {
 	return &pb.Status{
-		ScreenOn:       s.sm.screenIsOn.Load(),
-		WebboardOn:     s.sm.webBoardIsOn.Load(),
-		CombinedScroll: s.sm.cfg.CombinedScroll.Load(),
+		ScreenOn:        s.sm.screenIsOn.Load(),
+		WebboardOn:      s.sm.webBoardIsOn.Load(),
+		CombinedScroll:  s.sm.cfg.CombinedScroll.Load(),
+		RestartRequired: s.sm.RestartRequired(),
 	}, nil
 }
 
 // NextBoard jumps to the next board in the sequence
 func (s *Server) NextBoard(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
-	s.sm.Lock()
-	defer s.sm.Unlock()
-	s.sm.currentBoardCancel()
+	s.sm.cancelCurrentBoard()
 	return &emptypb.Empty{}, nil
 }
 
@@ -195,3 +194,75 @@ func (s *Server) SlowDown(ctx context.Context, req *emptypb.Empty) (*emptypb.Emp
 	}
 	return &emptypb.Empty{}, nil
 }
+
+// GetPlaylists returns the configured playlists and which one is playing
+func (s *Server) GetPlaylists(ctx context.Context, req *emptypb.Empty) (*pb.PlaylistsResp, error) {
+	if s.sm.playlists == nil {
+		return &pb.PlaylistsResp{}, nil
+	}
+
+	return &pb.PlaylistsResp{
+		Playlists: s.sm.playlists.Names(),
+		Active:    s.sm.playlists.Active(time.Now()).Name,
+		Selected:  s.sm.playlists.Override(),
+	}, nil
+}
+
+// SetPlaylist overrides the playlist schedule. An empty playlist returns to the schedule.
+func (s *Server) SetPlaylist(ctx context.Context, req *pb.SetPlaylistReq) (*emptypb.Empty, error) {
+	if s.sm.playlists == nil {
+		return nil, twirp.NewError(twirp.FailedPrecondition, "no playlists are configured")
+	}
+
+	if err := s.sm.playlists.Set(req.Playlist); err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+
+	s.sm.cancelCurrentBoard()
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetBrightness returns the matrix brightness, and the sunrise and sunset used for automatic
+// brightness
+func (s *Server) GetBrightness(ctx context.Context, req *emptypb.Empty) (*pb.BrightnessResp, error) {
+	if s.sm.brightness == nil {
+		return nil, twirp.NewError(twirp.FailedPrecondition, "brightness control is not available")
+	}
+
+	resp := &pb.BrightnessResp{
+		Brightness: int32(s.sm.brightness.Brightness()),
+		Auto:       s.sm.brightness.Auto(),
+	}
+	if sunrise, sunset, ok := s.sm.brightness.SunTimes(); ok {
+		resp.Sunrise = sunrise.Format(time.RFC3339)
+		resp.Sunset = sunset.Format(time.RFC3339)
+	}
+
+	return resp, nil
+}
+
+// SetBrightness sets the matrix brightness, or turns on automatic brightness. Setting a
+// brightness turns automatic brightness off.
+func (s *Server) SetBrightness(ctx context.Context, req *pb.SetBrightnessReq) (*emptypb.Empty, error) {
+	if s.sm.brightness == nil {
+		return nil, twirp.NewError(twirp.FailedPrecondition, "brightness control is not available")
+	}
+
+	if req.Auto {
+		if err := s.sm.brightness.SetAuto(true); err != nil {
+			return nil, twirp.NewError(twirp.FailedPrecondition, err.Error())
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	// Automatic brightness is turned off first, so it doesn't override the new brightness
+	if err := s.sm.brightness.SetAuto(false); err != nil {
+		return nil, twirp.NewError(twirp.Internal, err.Error())
+	}
+	if err := s.sm.brightness.Set(int(req.Brightness)); err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
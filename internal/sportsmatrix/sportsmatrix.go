@@ -10,19 +10,27 @@ import (
 	"sync"
 	"time"
 
-	"github.com/robfig/cron/v3"
+	"github.com/gorilla/mux"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/brightness"
 	"github.com/robbydyer/sports/internal/imgcanvas"
 	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/output"
+	"github.com/robbydyer/sports/internal/playlist"
 	rgb "github.com/robbydyer/sports/internal/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/internal/rules"
+	"github.com/robbydyer/sports/internal/scheduler"
 	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 )
 
 const speedUpIncrement = 10 * time.Millisecond
 
+// ruleTimeout is how long evaluating board rules can take before the boards are shown anyway
+const ruleTimeout = 30 * time.Second
+
 var version = "noversion"
 
 // SportsMatrix controls the RGB matrix. It rotates through a list of given board.Board
@@ -39,8 +47,8 @@ type SportsMatrix struct {
 	log                  *zap.Logger
 	boardCtx             context.Context
 	boardCancel          context.CancelFunc
-	currentBoardCtx      context.Context
 	currentBoardCancel   context.CancelFunc
+	currentBoardLock     sync.Mutex
 	server               http.Server
 	close                chan struct{}
 	httpEndpoints        []string
@@ -64,6 +72,27 @@ type SportsMatrix struct {
 	scrollInProgress     *atomic.Bool
 	defaultScrollSpeeds  map[string]time.Duration
 	activeScrollCanvases []*scrcnvs.ScrollCanvas
+	events               *EventBus
+	playGate             *matrix.PlayGate
+	interruptLock        sync.Mutex
+	interruptHold        sync.RWMutex
+	interruptSignal      chan struct{}
+	interruptCount       *atomic.Int64
+	pendingInterrupt     *board.Interrupt
+	activeInterrupt      *board.Interrupt
+	interruptCancel      context.CancelFunc
+	currentScrolling     *atomic.Bool
+	scheduler            *scheduler.Scheduler
+	playlists            *playlist.Playlists
+	rules                *rules.Rules
+	boardRoutes          *mux.Router
+	boardEndpoints       []string
+	boardRoutesLock      sync.RWMutex
+	restartRequired      []string
+	brightness           *brightness.Controller
+	outputs              []*Output
+	renderLocks          map[board.Board]chan struct{}
+	renderLocksLock      sync.Mutex
 	sync.Mutex
 }
 
@@ -76,6 +105,7 @@ type Config struct {
 	RuntimeOptions        *rgb.RuntimeOptions `json:"runtimeOptions"`
 	ScreenOffTimes        []string            `json:"screenOffTimes"`
 	ScreenOnTimes         []string            `json:"screenOnTimes"`
+	Brightness            *brightness.Config  `json:"brightness"`
 	WebBoardWidth         int                 `json:"webBoardWidth"`
 	WebBoardHeight        int                 `json:"webBoardHeight"`
 	LaunchWebBoard        bool                `json:"launchWebBoard"`
@@ -84,6 +114,10 @@ type Config struct {
 	CombinedScrollDelay   string              `json:"combinedScrollDelay"`
 	CombinedScrollPadding int                 `json:"combinedScrollPadding"`
 	PreloadThreads        int                 `json:"preloadThreads"`
+	Playlists             []*playlist.Config  `json:"playlists"`
+	DefaultPlaylist       string              `json:"defaultPlaylist"`
+	Rules                 []*rules.Config     `json:"rules"`
+	Outputs               []*output.Config    `json:"outputs"`
 }
 
 type orderedBoard struct {
@@ -138,6 +172,16 @@ func (c *Config) Defaults() {
 	if c.HardwareConfig.PWMLSBNanoseconds == 0 {
 		c.HardwareConfig.PWMLSBNanoseconds = 130
 	}
+	if c.WebBoardWidth == 0 {
+		if c.WebBoardHeight != 0 {
+			c.WebBoardWidth = c.WebBoardHeight * 2
+		} else {
+			c.WebBoardWidth = 800
+		}
+	}
+	if c.WebBoardHeight == 0 {
+		c.WebBoardHeight = c.WebBoardWidth / 2
+	}
 	if c.CombinedScroll == nil {
 		c.CombinedScroll = atomic.NewBool(false)
 	}
@@ -151,6 +195,9 @@ func (c *Config) Defaults() {
 	} else {
 		c.combinedScrollDelay = scrcnvs.DefaultScrollDelay
 	}
+	for _, o := range c.Outputs {
+		o.SetDefaults()
+	}
 }
 
 // New ...
@@ -177,6 +224,12 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 		scrollStatus:        make(chan float64),
 		scrollInProgress:    atomic.NewBool(false),
 		defaultScrollSpeeds: make(map[string]time.Duration),
+		events:              NewEventBus(logger),
+		playGate:            matrix.NewPlayGate(),
+		interruptSignal:     make(chan struct{}, 1),
+		interruptCount:      atomic.NewInt64(0),
+		currentScrolling:    atomic.NewBool(false),
+		scheduler:           scheduler.New(logger),
 	}
 
 	for _, canvas := range canvases {
@@ -190,16 +243,6 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 	s.boardCtx, s.boardCancel = context.WithCancel(context.Background())
 
 	// Add an ImgCanvas
-	if s.cfg.WebBoardWidth == 0 {
-		if s.cfg.WebBoardHeight != 0 {
-			s.cfg.WebBoardWidth = s.cfg.WebBoardHeight * 2
-		} else {
-			s.cfg.WebBoardWidth = 800
-		}
-	}
-	if s.cfg.WebBoardHeight == 0 {
-		s.cfg.WebBoardHeight = s.cfg.WebBoardWidth / 2
-	}
 	s.log.Info("init web baord",
 		zap.Int("X", s.cfg.WebBoardWidth),
 		zap.Int("Y", s.cfg.WebBoardHeight),
@@ -208,46 +251,79 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 
 	for _, b := range s.boards {
 		s.log.Info("Registering board", zap.String("board", b.Name()))
+		if err := s.scheduler.AddBoard(b); err != nil {
+			return nil, err
+		}
 	}
+	s.setInterrupters(s.boards)
 
-	c := cron.New()
-
-	for _, off := range s.cfg.ScreenOffTimes {
-		s.log.Info("Screen will be scheduled to turn off", zap.String("turn off", off))
-		_, err := c.AddFunc(off, func() {
-			s.log.Warn("Turning screen off!")
-			if err := s.ScreenOff(context.Background()); err != nil {
-				s.log.Error("failed to turn screen off during ScreenOfftimes",
-					zap.Error(err),
-				)
-			}
-		})
+	var err error
+	if len(s.cfg.Playlists) > 0 {
+		s.playlists, err = playlist.New(s.cfg.Playlists, s.cfg.DefaultPlaylist, s.log)
 		if err != nil {
-			return nil, fmt.Errorf("failed to add cron for screen off times: %w", err)
+			return nil, err
 		}
 	}
-	for _, on := range s.cfg.ScreenOnTimes {
-		s.log.Info("Screen will be scheduled to turn on", zap.String("turn on", on))
-		_, err := c.AddFunc(on, func() {
-			s.log.Warn("Turning screen on!")
-			if err := s.ScreenOn(context.Background()); err != nil {
-				s.log.Error("failed to turn screen on during ScreenOnTimes",
-					zap.Error(err),
-				)
-			}
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to add cron for screen on times: %w", err)
-		}
+
+	s.rules, err = rules.New(s.cfg.Rules, s.log)
+	if err != nil {
+		return nil, err
 	}
-	c.Start()
+
+	if err := s.scheduleScreen(s.cfg); err != nil {
+		return nil, err
+	}
+	s.scheduler.Start()
 
 	return s, nil
 }
 
+// scheduleScreen schedules the screen on and off times
+func (s *SportsMatrix) scheduleScreen(cfg *Config) error {
+	if err := s.scheduler.Add("screen off", cfg.ScreenOffTimes, func() {
+		s.log.Warn("Turning screen off!")
+		if err := s.ScreenOff(context.Background()); err != nil {
+			s.log.Error("failed to turn screen off during ScreenOfftimes",
+				zap.Error(err),
+			)
+		}
+	}); err != nil {
+		return err
+	}
+	if err := s.scheduler.Add("screen on", cfg.ScreenOnTimes, func() {
+		s.log.Warn("Turning screen on!")
+		if err := s.ScreenOn(context.Background()); err != nil {
+			s.log.Error("failed to turn screen on during ScreenOnTimes",
+				zap.Error(err),
+			)
+		}
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddBetweenBoard adds a board to be run between each enabled board
 func (s *SportsMatrix) AddBetweenBoard(board board.Board) {
 	s.betweenBoards = append(s.betweenBoards, board)
+	s.setInterrupters(s.betweenBoards)
+	if err := s.scheduler.AddBoard(board); err != nil {
+		s.log.Error("failed to schedule in-between board",
+			zap.Error(err),
+			zap.String("board", board.Name()),
+		)
+	}
+}
+
+// SetEventBus sets the EventBus that boards publish game events to
+func (s *SportsMatrix) SetEventBus(bus *EventBus) {
+	s.events = bus
+}
+
+// EventBus returns the matrix's EventBus
+func (s *SportsMatrix) EventBus() *EventBus {
+	return s.events
 }
 
 // ScreenOn turns the matrix on
@@ -277,6 +353,7 @@ func (s *SportsMatrix) ScreenOn(ctx context.Context) error {
 		s.switchedOn++
 	}
 	s.log.Warn("screen turning on")
+	s.events.Publish(&Event{Type: ScreenOn})
 	select {
 	case s.serveBlock <- struct{}{}:
 	case <-time.After(10 * time.Second):
@@ -310,6 +387,7 @@ func (s *SportsMatrix) ScreenOff(ctx context.Context) error {
 	}
 
 	s.log.Warn("screen turning off")
+	s.events.Publish(&Event{Type: ScreenOff})
 
 	if s.switchTestSleep {
 		s.switchedOff++
@@ -319,6 +397,9 @@ func (s *SportsMatrix) ScreenOff(ctx context.Context) error {
 	for _, canvas := range s.canvases {
 		_ = canvas.Clear()
 	}
+	for _, o := range s.outputs {
+		s.clearOutput(o)
+	}
 
 	s.boardCtx, s.boardCancel = context.WithCancel(s.serveContext)
 
@@ -410,6 +491,11 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 		for _, canvas := range s.canvases {
 			_ = canvas.Close()
 		}
+		for _, o := range s.outputs {
+			for _, canvas := range o.Canvases {
+				_ = canvas.Close()
+			}
+		}
 	}()
 
 	s.serveContext = ctx
@@ -421,6 +507,13 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 		s.startWebBoard(ctx)
 	}
 
+	go s.logEvents(ctx)
+	go s.serveInterrupts(ctx)
+
+	for _, o := range s.outputs {
+		go s.serveOutput(ctx, o)
+	}
+
 	if len(s.boards) < 1 {
 		return fmt.Errorf("no boards configured")
 	}
@@ -501,64 +594,325 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 }
 
 func (s *SportsMatrix) serveLoop(ctx context.Context) {
-BOARDS:
-	for _, b := range s.boards {
+	if s.playlists != nil {
+		s.servePlaylist(ctx)
+		return
+	}
+
+	boards := s.getBoards()
+	resume := false
+	for i := 0; i < len(boards); i++ {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 		}
 
-		s.currentBoardCtx, s.currentBoardCancel = context.WithCancel(ctx)
-		if err := s.doBoard(s.currentBoardCtx, b); err != nil {
-			s.currentBoardCancel()
-			continue BOARDS
+		boardCtx := ctx
+		if resume {
+			boardCtx = board.WithResume(ctx)
 		}
 
-		if b.Enabler().Enabled() {
-		BETWEEN_BOARDS:
-			for _, between := range s.betweenBoards {
-				select {
-				case <-ctx.Done():
-					return
-				case <-s.currentBoardCtx.Done():
-					s.log.Debug("current board context canceled while rendering in-between boards",
-						zap.String("board", b.Name()),
-						zap.String("in-between", between.Name()),
-					)
-					continue BOARDS
-				default:
-				}
-				s.log.Debug("rendering in-between board",
-					zap.String("board", between.Name()),
-					zap.String("prior board", b.Name()),
-				)
-				if err := s.doBoard(s.currentBoardCtx, between); err != nil {
-					continue BETWEEN_BOARDS
-				}
-			}
+		resume = s.serveBoard(boardCtx, boards[i], 0)
+		if resume {
+			// Resume the board that was interrupted
+			s.log.Debug("resuming interrupted board",
+				zap.String("board", boards[i].Name()),
+			)
+			i--
 		}
+	}
+}
+
+// setCurrentBoardCancel sets the func that skips the board on the screen
+func (s *SportsMatrix) setCurrentBoardCancel(cancel context.CancelFunc) {
+	s.currentBoardLock.Lock()
+	defer s.currentBoardLock.Unlock()
+
+	s.currentBoardCancel = cancel
+}
 
+// cancelCurrentBoard skips the board on the screen
+func (s *SportsMatrix) cancelCurrentBoard() {
+	s.currentBoardLock.Lock()
+	defer s.currentBoardLock.Unlock()
+
+	if s.currentBoardCancel != nil {
 		s.currentBoardCancel()
 	}
 }
 
+// lockBoard waits until the board isn't being rendered by the main loop, combined scroll or an
+// output, so that a board instance never renders to two of them at once. The returned func
+// unlocks the board.
+func (s *SportsMatrix) lockBoard(ctx context.Context, b board.Board) (func(), error) {
+	s.renderLocksLock.Lock()
+	if s.renderLocks == nil {
+		s.renderLocks = make(map[board.Board]chan struct{})
+	}
+	lock, ok := s.renderLocks[b]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		s.renderLocks[b] = lock
+	}
+	s.renderLocksLock.Unlock()
+
+	select {
+	case lock <- struct{}{}:
+		return func() { <-lock }, nil
+	case <-ctx.Done():
+		return nil, context.Canceled
+	}
+}
+
+// serveBoard renders a board followed by the in-between boards. If dwell is set, the
+// board is rendered repeatedly until the dwell time is up. It returns true if the board
+// was interrupted and should be resumed.
+func (s *SportsMatrix) serveBoard(ctx context.Context, b board.Board, dwell time.Duration) bool {
+	s.waitForInterrupt()
+
+	// The board's rules are evaluated once, its in-between boards are skipped with it
+	if !b.Enabler().Enabled() || !s.boardAllowed(ctx, b) {
+		return false
+	}
+
+	interrupts := s.interruptCount.Load()
+
+	boardCtx := matrix.WithPlayGate(ctx, s.playGate)
+	if dwell > 0 {
+		var cancel context.CancelFunc
+		boardCtx, cancel = context.WithTimeout(boardCtx, dwell)
+		defer cancel()
+	}
+
+	currentCtx, currentCancel := context.WithCancel(boardCtx)
+	s.setCurrentBoardCancel(currentCancel)
+	// currentCancel is replaced for the in-between boards
+	defer func() {
+		currentCancel()
+	}()
+
+	for {
+		err := s.doBoard(currentCtx, b)
+		if err != nil && s.interruptCount.Load() != interrupts {
+			return true
+		}
+		dwellDone := dwell > 0 && boardCtx.Err() != nil
+		if err != nil && !dwellDone {
+			return false
+		}
+		if dwell == 0 || dwellDone || !b.Enabler().Enabled() {
+			break
+		}
+		if currentCtx.Err() != nil {
+			// The board was skipped
+			return false
+		}
+	}
+
+	if !b.Enabler().Enabled() {
+		return false
+	}
+
+	if dwell > 0 {
+		// In-between boards aren't limited by the board's dwell time
+		currentCancel()
+		currentCtx, currentCancel = context.WithCancel(matrix.WithPlayGate(ctx, s.playGate))
+		s.setCurrentBoardCancel(currentCancel)
+	}
+
+BETWEEN_BOARDS:
+	for _, between := range s.betweenBoards {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-currentCtx.Done():
+			s.log.Debug("current board context canceled while rendering in-between boards",
+				zap.String("board", b.Name()),
+				zap.String("in-between", between.Name()),
+			)
+			return false
+		default:
+		}
+		s.log.Debug("rendering in-between board",
+			zap.String("board", between.Name()),
+			zap.String("prior board", b.Name()),
+		)
+		if !between.Enabler().Enabled() || !s.boardAllowed(currentCtx, between) {
+			continue BETWEEN_BOARDS
+		}
+		if err := s.doBoard(currentCtx, between); err != nil {
+			continue BETWEEN_BOARDS
+		}
+	}
+
+	return false
+}
+
+// servePlaylist renders one cycle of the active playlist. The cycle ends early if
+// a different playlist becomes active.
+func (s *SportsMatrix) servePlaylist(ctx context.Context) {
+	pl := s.playlists.Active(time.Now())
+	entries := pl.Cycle(time.Now())
+
+	served := false
+	defer func() {
+		if served {
+			return
+		}
+		s.log.Debug("no playlist boards are available right now",
+			zap.String("playlist", pl.Name),
+		)
+		select {
+		case <-ctx.Done():
+		case <-time.After(10 * time.Second):
+		}
+	}()
+
+	resume := false
+	for i := 0; i < len(entries); i++ {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if active := s.playlists.Active(time.Now()); active != pl {
+			s.log.Info("playlist changed",
+				zap.String("from", pl.Name),
+				zap.String("to", active.Name),
+			)
+			served = true
+			return
+		}
+
+		b := s.boardByName(entries[i].Board)
+		if b == nil {
+			s.log.Error("playlist board does not exist",
+				zap.String("playlist", pl.Name),
+				zap.String("board", entries[i].Board),
+			)
+			continue
+		}
+		if !b.Enabler().Enabled() {
+			continue
+		}
+		served = true
+
+		boardCtx := ctx
+		if resume {
+			boardCtx = board.WithResume(ctx)
+		}
+
+		resume = s.serveBoard(boardCtx, b, entries[i].DwellTime())
+		if resume {
+			i--
+		}
+	}
+}
+
+// boardAllowed evaluates the board's rules. Boards are shown if their rules can't be evaluated.
+func (s *SportsMatrix) boardAllowed(ctx context.Context, b board.Board) bool {
+	ctx, cancel := context.WithTimeout(ctx, ruleTimeout)
+	defer cancel()
+
+	return s.evalRules(ctx, b)
+}
+
+// allowedBoards returns the enabled boards whose rules allow them, in order. Rules are
+// evaluated concurrently with one shared deadline, so that slow fact providers don't add up.
+func (s *SportsMatrix) allowedBoards(ctx context.Context, boards []board.Board) []board.Board {
+	ctx, cancel := context.WithTimeout(ctx, ruleTimeout)
+	defer cancel()
+
+	allowed := make([]bool, len(boards))
+	wg := sync.WaitGroup{}
+	for i, b := range boards {
+		if !b.Enabler().Enabled() {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, b board.Board) {
+			defer wg.Done()
+			allowed[i] = s.evalRules(ctx, b)
+		}(i, b)
+	}
+	wg.Wait()
+
+	ret := []board.Board{}
+	for i, b := range boards {
+		if allowed[i] {
+			ret = append(ret, b)
+		}
+	}
+
+	return ret
+}
+
+func (s *SportsMatrix) evalRules(ctx context.Context, b board.Board) bool {
+	allow, err := s.rules.Allow(ctx, b)
+	if err != nil {
+		s.log.Error("failed to evaluate board rules",
+			zap.String("board", b.Name()),
+			zap.Error(err),
+		)
+		return true
+	}
+	if !allow {
+		s.log.Debug("skipping board due to rules",
+			zap.String("board", b.Name()),
+		)
+	}
+
+	return allow
+}
+
+func (s *SportsMatrix) boardByName(name string) board.Board {
+	for _, b := range s.getBoards() {
+		if strings.EqualFold(b.Name(), name) {
+			return b
+		}
+	}
+
+	return nil
+}
+
+// activeBoards returns the boards in the order they are played
+func (s *SportsMatrix) activeBoards() []board.Board {
+	if s.playlists == nil {
+		return s.getBoards()
+	}
+
+	boards := []board.Board{}
+	seen := make(map[board.Board]struct{})
+	for _, e := range s.playlists.Active(time.Now()).Cycle(time.Now()) {
+		b := s.boardByName(e.Board)
+		if b == nil {
+			continue
+		}
+		if _, ok := seen[b]; ok {
+			continue
+		}
+		seen[b] = struct{}{}
+		boards = append(boards, b)
+	}
+
+	return boards
+}
+
 // doCombinedScroll gets a scrollCanvas version of each board, then combines them
 // into one large ScrollCanvas. It maintains ordering
 func (s *SportsMatrix) doCombinedScroll(ctx context.Context) error {
 	// nolint: govet
-	scrollCtx, cancel := context.WithCancel(ctx)
+	scrollCtx, cancel := context.WithCancel(matrix.WithPlayGate(ctx, s.playGate))
 
-	boards := []board.Board{}
+	activeBoards := s.activeBoards()
 
 	canceler := func() {
 		cancel()
 	}
-	for _, board := range s.boards {
+	for _, board := range activeBoards {
 		board.Enabler().SetStateChangeCallback(canceler)
-		if board.Enabler().Enabled() {
-			boards = append(boards, board)
-		}
 	}
 	for _, board := range s.betweenBoards {
 		if board.Enabler().Enabled() {
@@ -566,6 +920,17 @@ func (s *SportsMatrix) doCombinedScroll(ctx context.Context) error {
 		}
 	}
 
+	allowed := s.allowedBoards(ctx, append(append([]board.Board{}, activeBoards...), s.betweenBoards...))
+	activeBoards = []board.Board{}
+	inBetween := []board.Board{}
+	for _, b := range allowed {
+		if b.InBetween() {
+			inBetween = append(inBetween, b)
+		} else {
+			activeBoards = append(activeBoards, b)
+		}
+	}
+
 	defer func() {
 		s.activeScrollCanvases = []*scrcnvs.ScrollCanvas{}
 	}()
@@ -594,11 +959,11 @@ CANVASES:
 
 		s.activeScrollCanvases = append(s.activeScrollCanvases, scrollCanvas)
 
-		ch := make(chan *orderedBoard, len(boards))
-		s.prepOrderedBoards(ctx, s.boards, base.Matrix, ch)
+		ch := make(chan *orderedBoard, len(activeBoards))
+		s.prepOrderedBoards(ctx, activeBoards, base.Matrix, ch)
 
-		betweenCh := make(chan *orderedBoard, len(boards))
-		s.prepOrderedBoards(ctx, s.betweenBoards, base.Matrix, betweenCh)
+		betweenCh := make(chan *orderedBoard, len(inBetween))
+		s.prepOrderedBoards(ctx, inBetween, base.Matrix, betweenCh)
 
 		allOrderedBoards := []*orderedBoard{}
 
@@ -717,6 +1082,12 @@ func (s *SportsMatrix) doBoard(ctx context.Context, b board.Board) error {
 	s.boardLock.Lock()
 	defer s.boardLock.Unlock()
 
+	// An interrupt is waiting for the screen
+	if !s.interruptHold.TryRLock() {
+		return errInterrupted
+	}
+	s.interruptHold.RUnlock()
+
 	select {
 	case <-ctx.Done():
 		s.log.Error("serve loop context was canceled",
@@ -746,6 +1117,21 @@ func (s *SportsMatrix) doBoard(ctx context.Context, b board.Board) error {
 		return nil
 	}
 
+	// Interrupts cancel the context, so they don't wait on an output rendering the board
+	unlock, err := s.lockBoard(ctx, b)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	s.events.Publish(&Event{
+		Type:  BoardChanged,
+		Board: b.Name(),
+	})
+
+	s.currentScrolling.Store(b.ScrollMode())
+	defer s.currentScrolling.Store(false)
+
 	var wg sync.WaitGroup
 
 	var boardErr error
@@ -767,7 +1153,7 @@ CANVASES:
 		go func(canvas board.Canvas) {
 			defer wg.Done()
 			s.log.Debug("rendering board", zap.String("board", b.Name()))
-			if err := b.Render(s.currentBoardCtx, canvas); err != nil {
+			if err := b.Render(ctx, canvas); err != nil {
 				boardErr = err
 				s.log.Error("board render returned error",
 					zap.Error(err),
@@ -794,6 +1180,38 @@ CANVASES:
 	return boardErr
 }
 
+func (s *SportsMatrix) logEvents(ctx context.Context) {
+	events, unsubscribe := s.events.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			if !event.IsGameEvent() {
+				s.log.Debug("matrix event",
+					zap.String("type", string(event.Type)),
+					zap.String("board", event.Board),
+				)
+				continue
+			}
+			s.log.Info("game event",
+				zap.String("type", string(event.Type)),
+				zap.String("league", event.League),
+				zap.Int("game ID", event.GameID),
+				zap.String("home", event.Home),
+				zap.String("away", event.Away),
+				zap.Int("home score", event.HomeScore),
+				zap.Int("away score", event.AwayScore),
+				zap.String("team", event.Team),
+				zap.Int("delta", event.Delta),
+				zap.String("period", event.Period),
+			)
+		}
+	}
+}
+
 // Close closes the matrix
 func (s *SportsMatrix) Close() {
 	s.close <- struct{}{}
@@ -801,7 +1219,7 @@ func (s *SportsMatrix) Close() {
 }
 
 func (s *SportsMatrix) allDisabled() bool {
-	for _, b := range s.boards {
+	for _, b := range s.getBoards() {
 		if b.Enabler().Enabled() {
 			return false
 		}
@@ -818,7 +1236,9 @@ func (s *SportsMatrix) JumpTo(ctx context.Context, boardName string) error {
 	s.jumping.Store(true)
 	defer s.jumping.Store(false)
 
-	boards := append(s.boards, s.betweenBoards...)
+	s.Lock()
+	boards := append(append([]board.Board{}, s.boards...), s.betweenBoards...)
+	s.Unlock()
 
 	for _, b := range boards {
 		if strings.EqualFold(b.Name(), boardName) {
@@ -886,7 +1306,12 @@ func (s *SportsMatrix) prepOrderedBoards(ctx context.Context, boards []board.Boa
 				)
 				return
 			}
+			unlock, err := s.lockBoard(ctx, thisBoard)
+			if err != nil {
+				return
+			}
 			boardCanvas, err := thisBoard.ScrollRender(ctx, myBase, s.cfg.CombinedScrollPadding)
+			unlock()
 			if err != nil {
 				s.log.Error("failed to render between board scroll canvas",
 					zap.Error(err),
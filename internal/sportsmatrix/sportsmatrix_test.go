@@ -15,6 +15,7 @@ import (
 
 	"github.com/robbydyer/sports/internal/board"
 	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/rules"
 )
 
 type TestBoard struct {
@@ -249,3 +250,122 @@ func TestScreenSwitch(t *testing.T) {
 		require.NotNil(t, nil, "timed out waiting for serve to close")
 	}
 }
+
+// factBoard is a TestBoard that counts how often its facts are evaluated
+type factBoard struct {
+	*TestBoard
+	factCalls *atomic.Int64
+}
+
+func (b *factBoard) Facts(ctx context.Context) (board.Facts, error) {
+	b.factCalls.Inc()
+	return board.Facts{"ready": false}, nil
+}
+
+func TestServeBoardRules(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		WebBoardWidth: 1,
+		Rules: []*rules.Config{
+			{
+				Board: "Blank Board",
+				When:  "ready",
+			},
+		},
+	}
+
+	canvas := board.NewBlankCanvas(1, 1, logger)
+	canvas.Enable()
+
+	b := &factBoard{
+		TestBoard: &TestBoard{
+			log:         logger,
+			hasRendered: atomic.NewBool(false),
+			tester:      t,
+			enabler:     enabler.New(),
+		},
+		factCalls: atomic.NewInt64(0),
+	}
+	b.enabler.Enable()
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{canvas}, b)
+	require.NoError(t, err)
+
+	// A rejected board doesn't hold the screen for its dwell time
+	start := time.Now()
+	require.False(t, s.serveBoard(ctx, b, time.Minute))
+	require.Less(t, time.Since(start), 10*time.Second)
+	require.Equal(t, int64(1), b.factCalls.Load())
+	require.False(t, b.HasRendered())
+}
+
+// slowFactBoard is a TestBoard whose facts take a while to gather
+type slowFactBoard struct {
+	*TestBoard
+	name      string
+	ready     bool
+	inBetween bool
+}
+
+func (b *slowFactBoard) Name() string {
+	return b.name
+}
+
+func (b *slowFactBoard) InBetween() bool {
+	return b.inBetween
+}
+
+func (b *slowFactBoard) Facts(ctx context.Context) (board.Facts, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(200 * time.Millisecond):
+	}
+	return board.Facts{"ready": b.ready}, nil
+}
+
+func TestAllowedBoards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		WebBoardWidth: 1,
+	}
+
+	var boards []board.Board
+	for _, b := range []*slowFactBoard{
+		{name: "one", ready: true},
+		{name: "two", ready: false},
+		{name: "three", ready: true},
+		{name: "between", ready: false, inBetween: true},
+		{name: "four", ready: true},
+	} {
+		b.TestBoard = &TestBoard{
+			log:         logger,
+			hasRendered: atomic.NewBool(false),
+			enabler:     enabler.New(),
+		}
+		b.enabler.Enable()
+		cfg.Rules = append(cfg.Rules, &rules.Config{Board: b.name, When: "ready"})
+		boards = append(boards, b)
+	}
+	boards[4].Enabler().Disable()
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{board.NewBlankCanvas(1, 1, logger)}, boards...)
+	require.NoError(t, err)
+
+	// Slow facts are gathered concurrently
+	start := time.Now()
+	allowed := s.allowedBoards(ctx, boards)
+	require.Less(t, time.Since(start), 600*time.Millisecond)
+
+	names := []string{}
+	for _, b := range allowed {
+		names = append(names, b.Name())
+	}
+	require.Equal(t, []string{"one", "three"}, names)
+}
@@ -0,0 +1,75 @@
+package twirphelpers
+
+import (
+	"context"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/robbydyer/sports/internal/board"
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+)
+
+// BasicBoardServer implements the basicboard Twirp service for boards whose only settings
+// are enabled and scroll mode. A status change is sent on the board's cancel channel, so
+// that the board stops rendering and picks up the change.
+type BasicBoardServer struct {
+	board      board.Board
+	scrollMode *atomic.Bool
+	cancel     chan struct{}
+	log        *zap.Logger
+}
+
+// NewBasicBoardServer returns the basicboard Twirp server of a board, served at prefix
+func NewBasicBoardServer(b board.Board, scrollMode *atomic.Bool, cancel chan struct{}, prefix string, logger *zap.Logger) pb.TwirpServer {
+	svr := &BasicBoardServer{
+		board:      b,
+		scrollMode: scrollMode,
+		cancel:     cancel,
+		log:        logger,
+	}
+
+	return pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(prefix),
+		twirp.ChainHooks(
+			GetDefaultHooks(b, logger),
+		),
+	)
+}
+
+// SetStatus ...
+func (s *BasicBoardServer) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.Empty, error) {
+	if req.Status == nil {
+		return &emptypb.Empty{}, twirp.NewError(twirp.InvalidArgument, "nil status sent")
+	}
+
+	cancelBoard := false
+	if s.board.Enabler().Store(req.Status.Enabled) {
+		cancelBoard = true
+	}
+	if s.scrollMode.CompareAndSwap(!req.Status.ScrollEnabled, req.Status.ScrollEnabled) {
+		cancelBoard = true
+	}
+
+	if cancelBoard {
+		select {
+		case s.cancel <- struct{}{}:
+			s.log.Info("sent cancel board signal on status change")
+		default:
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetStatus ...
+func (s *BasicBoardServer) GetStatus(ctx context.Context, req *emptypb.Empty) (*pb.StatusResp, error) {
+	return &pb.StatusResp{
+		Status: &pb.Status{
+			Enabled:       s.board.Enabler().Enabled(),
+			ScrollEnabled: s.scrollMode.Load(),
+		},
+	}, nil
+}
@@ -277,18 +277,19 @@ func FileExists(fileName string) (bool, error) {
 	return true, nil
 }
 
-func SetCrons(times []string, f func()) error {
+// SetCrons runs f at each of the cron times. Stopping the returned cron removes them.
+func SetCrons(times []string, f func()) (*cron.Cron, error) {
 	if len(times) < 1 {
-		return nil
+		return nil, nil
 	}
 
 	c := cron.New()
 	for _, t := range times {
 		if _, err := c.AddFunc(t, f); err != nil {
-			return fmt.Errorf("failed to add cron func: %w", err)
+			return nil, fmt.Errorf("failed to add cron func: %w", err)
 		}
 	}
 	c.Start()
 
-	return nil
+	return c, nil
 }
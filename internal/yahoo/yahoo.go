@@ -74,6 +74,11 @@ func New(log *zap.Logger) (*API, error) {
 	return a, nil
 }
 
+// Provider ...
+func (a *API) Provider() string {
+	return "yahoo"
+}
+
 // Get fetch data about a list of given stock symbols
 func (a *API) Get(ctx context.Context, symbols []string, interval time.Duration) ([]*stockboard.Stock, error) {
 	if interval.Hours() > 1 || interval.Minutes() > 60 {
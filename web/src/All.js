@@ -55,10 +55,12 @@ class All extends React.Component {
                 </Accordion.Body>
             </Accordion.Item>
         );
-        var racing = ["f1", "irl"].map((sport) =>
+        // Leagues without a bundled logo are named instead
+        var racingNames = { "nascar": "NASCAR Cup", "xfinity": "NASCAR Xfinity", "motogp": "MotoGP" };
+        var racing = ["f1", "irl", "nascar", "xfinity", "motogp"].map((sport) =>
             <Col>
                 <Accordion.Item eventKey={sport}>
-                    <Accordion.Header><Image fluid src={LogoSrc(sport)} style={{ height: '100px', width: 'auto' }} /></Accordion.Header>
+                    <Accordion.Header>{LogoSrc(sport) ? <Image fluid src={LogoSrc(sport)} style={{ height: '100px', width: 'auto' }} /> : racingNames[sport]}</Accordion.Header>
                     <Accordion.Body>
                         <Card style={{ width: { card_border } }}>
                             <Racing sport={sport} id={sport} key={sport + this.state.sync} doSync={this.doSync} />
@@ -89,6 +91,38 @@ class All extends React.Component {
                                     </Card>
                                 </Accordion.Body>
                             </Accordion.Item>
+                            <Accordion.Item eventKey="golfpga">
+                                <Accordion.Header>PGA Tour</Accordion.Header>
+                                <Accordion.Body>
+                                    <Card style={{ width: { card_border } }}>
+                                        <BasicBoard id="golfpga" name="pga" doSync={this.doSync} key={"golfpga" + this.state.sync} path="golf/pga" />
+                                    </Card>
+                                </Accordion.Body>
+                            </Accordion.Item>
+                            <Accordion.Item eventKey="golflpga">
+                                <Accordion.Header>LPGA</Accordion.Header>
+                                <Accordion.Body>
+                                    <Card style={{ width: { card_border } }}>
+                                        <BasicBoard id="golflpga" name="lpga" doSync={this.doSync} key={"golflpga" + this.state.sync} path="golf/lpga" />
+                                    </Card>
+                                </Accordion.Body>
+                            </Accordion.Item>
+                            <Accordion.Item eventKey="golfdpworld">
+                                <Accordion.Header>DP World Tour</Accordion.Header>
+                                <Accordion.Body>
+                                    <Card style={{ width: { card_border } }}>
+                                        <BasicBoard id="golfdpworld" name="dpworld" doSync={this.doSync} key={"golfdpworld" + this.state.sync} path="golf/dpworld" />
+                                    </Card>
+                                </Accordion.Body>
+                            </Accordion.Item>
+                            <Accordion.Item eventKey="golfchampions">
+                                <Accordion.Header>PGA Tour Champions</Accordion.Header>
+                                <Accordion.Body>
+                                    <Card style={{ width: { card_border } }}>
+                                        <BasicBoard id="golfchampions" name="champions" doSync={this.doSync} key={"golfchampions" + this.state.sync} path="golf/champions" />
+                                    </Card>
+                                </Accordion.Body>
+                            </Accordion.Item>
                             <Accordion.Item eventKey="weather">
                                 <Accordion.Header><Image src={LogoSrc("weather")} style={{ height: '100px', width: 'auto' }} fluid /></Accordion.Header>
                                 <Accordion.Body>
@@ -113,6 +147,30 @@ class All extends React.Component {
                                     </Card>
                                 </Accordion.Body>
                             </Accordion.Item>
+                            <Accordion.Item eventKey="fantasy">
+                                <Accordion.Header>Fantasy</Accordion.Header>
+                                <Accordion.Body>
+                                    <Card style={{ width: { card_border } }}>
+                                        <BasicBoard id="fantasy" name="fantasy" doSync={this.doSync} key={"fantasy" + this.state.sync} path="fantasy/sleeper" />
+                                    </Card>
+                                </Accordion.Body>
+                            </Accordion.Item>
+                            <Accordion.Item eventKey="atp">
+                                <Accordion.Header>ATP</Accordion.Header>
+                                <Accordion.Body>
+                                    <Card style={{ width: { card_border } }}>
+                                        <BasicBoard id="atp" name="atp" doSync={this.doSync} key={"atp" + this.state.sync} path="tennis/atp" />
+                                    </Card>
+                                </Accordion.Body>
+                            </Accordion.Item>
+                            <Accordion.Item eventKey="wta">
+                                <Accordion.Header>WTA</Accordion.Header>
+                                <Accordion.Body>
+                                    <Card style={{ width: { card_border } }}>
+                                        <BasicBoard id="wta" name="wta" doSync={this.doSync} key={"wta" + this.state.sync} path="tennis/wta" />
+                                    </Card>
+                                </Accordion.Body>
+                            </Accordion.Item>
                             <Accordion.Item eventKey="clock">
                                 <Accordion.Header><Image src={LogoSrc("clock")} style={{ height: '100px', width: 'auto' }} fluid /></Accordion.Header>
                                 <Accordion.Body>
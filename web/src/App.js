@@ -33,16 +33,26 @@ class App extends React.Component {
           <Route path="/uefa" render={() => <Sport sport="uefa" id="uefa" key="uefa" withImg="true" />} />
           <Route path="/fifa" render={() => <Sport sport="fifa" id="fifa" key="fifa" withImg="true" />} />
           <Route path="/pga" render={() => <BasicBoard id="pga" name="pga" key="pga" path="stat/pga" withImg="true" />} />
+          <Route path="/golf/pga" render={() => <BasicBoard id="golfpga" name="pga" key="golfpga" path="golf/pga" withImg="true" />} />
+          <Route path="/golf/lpga" render={() => <BasicBoard id="golflpga" name="lpga" key="golflpga" path="golf/lpga" withImg="true" />} />
+          <Route path="/golf/dpworld" render={() => <BasicBoard id="golfdpworld" name="dpworld" key="golfdpworld" path="golf/dpworld" withImg="true" />} />
+          <Route path="/golf/champions" render={() => <BasicBoard id="golfchampions" name="champions" key="golfchampions" path="golf/champions" withImg="true" />} />
           <Route path="/img" render={() => <ImageBoard withImg="true" />} />
           <Route path="/clock" render={() => <BasicBoard id="clock" name="clock" key="clock" withImg="true" />} />
           <Route path="/sys" render={() => <BasicBoard id="sys" name="sys" key="sys" withImg="true" />} />
           <Route path="/stocks" render={() => <BasicBoard id="stocks" name="stocks" key="stocks" withImg="true" />} />
           <Route path="/gcal" render={() => <BasicBoard id="gcal" name="gcal" key="gcal" withImg="true" />} />
           <Route path="/weather" render={() => <Weather withImg="true" />} />
+          <Route path="/fantasy" render={() => <BasicBoard id="fantasy" name="fantasy" key="fantasy" path="fantasy/sleeper" withImg="true" />} />
+          <Route path="/atp" render={() => <BasicBoard id="atp" name="atp" key="atp" path="tennis/atp" withImg="true" />} />
+          <Route path="/wta" render={() => <BasicBoard id="wta" name="wta" key="wta" path="tennis/wta" withImg="true" />} />
           <Route path="/board" exact component={Board} />
           <Route path="/docs" exact component={() => <SwaggerUI spec={swag} />} />
           <Route path="/f1" exact component={() => <Racing sport="f1" id="f1" key="f1" withImg="true" />} />
           <Route path="/irl" exact component={() => <Racing sport="irl" id="irl" key="irl" withImg="true" />} />
+          <Route path="/nascar" exact component={() => <Racing sport="nascar" id="nascar" key="nascar" />} />
+          <Route path="/xfinity" exact component={() => <Racing sport="xfinity" id="xfinity" key="xfinity" />} />
+          <Route path="/motogp" exact component={() => <Racing sport="motogp" id="motogp" key="motogp" />} />
           <Route path="/ncaaw" render={() => <Sport sport="ncaaw" id="ncaaw" key="ncaaw" withImg="true" />} />
           <Route path="/wnba" render={() => <Sport sport="wnba" id="wnba" key="wnba" withImg="true" />} />
           <Route path="/ligue" render={() => <Sport sport="ligue" id="ligue" key="ligue" withImg="true" />} />
@@ -58,7 +58,7 @@ class BasicBoard extends React.Component {
         )
         return (
             <Container fluid>
-                {this.props.withImg ? img : ""}
+                {this.props.withImg && LogoSrc(this.props.name) ? img : ""}
                 <Row className="text-left">
                     <Col>
                         <Form.Switch id={this.props.name + "enabler"} label="Enable/Disable" checked={this.state.status.getEnabled()}
@@ -11,15 +11,25 @@ class Board extends React.Component {
         super(props);
         this.state = {
             t: Date.now(),
+            stream: true,
         }
     }
 
     componentDidMount() {
         document.body.style.backgroundColor = "black"
+    }
+    // Fall back to polling PNGs of the web board if the matrix can't be streamed
+    streamFailed = () => {
+        if (!this.state.stream) {
+            return
+        }
+        this.setState({ stream: false })
         this.interval = setInterval(() => this.setState({ t: Date.now() }), 2000)
     }
     componentWillUnmount() {
-        clearInterval(this.interval)
+        if (this.interval) {
+            clearInterval(this.interval)
+        }
         fetch(`${BACKEND}/api/imgcanvas/disable`, {
             method: "GET",
             mode: "cors",
@@ -35,7 +45,11 @@ class Board extends React.Component {
                     }}
                 />
                 <Container fluid>
-                    <Row className="text-center"><Col><Image src={`${BACKEND}/api/imgcanvas/board?${this.state.t}`} style={{ height: 'auto', width: 'auto' }} name={this.state.t} fluid /></Col></Row>
+                    {this.state.stream ?
+                        <Row className="text-center"><Col><Image src={`${BACKEND}/api/matrix/stream`} style={{ height: 'auto', width: '100%', imageRendering: 'pixelated' }} onError={this.streamFailed} fluid /></Col></Row>
+                        :
+                        <Row className="text-center"><Col><Image src={`${BACKEND}/api/imgcanvas/board?${this.state.t}`} style={{ height: 'auto', width: 'auto' }} name={this.state.t} fluid /></Col></Row>
+                    }
                 </Container>
             </>
         )
@@ -1,11 +1,12 @@
 import React from 'react';
+import Alert from 'react-bootstrap/Alert';
 import Button from 'react-bootstrap/Button';
 import Container from 'react-bootstrap/Container';
 import Row from 'react-bootstrap/Row';
 import Col from 'react-bootstrap/Col';
 import Form from 'react-bootstrap/Form';
 import Spinner from 'react-bootstrap/Spinner';
-import { MatrixPostRet } from './util.js';
+import { MatrixPostRet, BACKEND } from './util.js';
 import { SetAllReq, LiveOnlyReq, Status } from './sportsmatrix/sportsmatrix_pb';
 import 'bootstrap/dist/css/bootstrap.min.css';
 
@@ -26,10 +27,20 @@ class Home extends React.Component {
         this.state = {
             "status": status,
             "loading": false,
+            "playlists": [],
+            "activePlaylist": "",
+            "selectedPlaylist": "",
+            "restartRequired": [],
+            "brightness": 0,
+            "autoBrightness": false,
+            "sunrise": "",
+            "sunset": "",
         };
     }
     async componentDidMount() {
         await this.getStatus();
+        await this.getPlaylists();
+        await this.getBrightness();
     }
 
     getStatus = async () => {
@@ -42,10 +53,54 @@ class Home extends React.Component {
             var dat = jsonToStatus(data);
             this.setState({
                 "status": dat,
+                "restartRequired": JSON.parse(data).restart_required || [],
             })
         })
     }
 
+    getPlaylists = async () => {
+        await MatrixPostRet("matrix.v1.Sportsmatrix/GetPlaylists", '{}').then((resp) => {
+            if (resp.ok) {
+                return resp.text();
+            }
+            throw resp;
+        }).then((data) => {
+            var dat = JSON.parse(data);
+            this.setState({
+                "playlists": dat.playlists || [],
+                "activePlaylist": dat.active || "",
+                "selectedPlaylist": dat.selected || "",
+            })
+        })
+    }
+
+    setPlaylist = async (playlist) => {
+        await MatrixPostRet("matrix.v1.Sportsmatrix/SetPlaylist", JSON.stringify({ "playlist": playlist }));
+        await this.getPlaylists();
+    }
+
+    getBrightness = async () => {
+        await MatrixPostRet("matrix.v1.Sportsmatrix/GetBrightness", '{}').then((resp) => {
+            if (resp.ok) {
+                return resp.text();
+            }
+            throw resp;
+        }).then((data) => {
+            var dat = JSON.parse(data);
+            this.setState({
+                "brightness": dat.brightness || 0,
+                "autoBrightness": dat.auto || false,
+                "sunrise": dat.sunrise ? new Date(dat.sunrise).toLocaleTimeString() : "",
+                "sunset": dat.sunset ? new Date(dat.sunset).toLocaleTimeString() : "",
+            })
+        }).catch(() => { })
+    }
+
+    setBrightness = async (brightness, auto) => {
+        await MatrixPostRet("matrix.v1.Sportsmatrix/SetBrightness", JSON.stringify({ "brightness": brightness, "auto": auto }));
+        await this.getBrightness();
+    }
+
     speedUp = async () => {
         await MatrixPostRet("matrix.v1.Sportsmatrix/SpeedUp", '{}').then((resp) => {
             if (!resp.ok) {
@@ -100,6 +155,13 @@ class Home extends React.Component {
         }, 10000);
     }
 
+    startRecording = () => {
+        fetch(`${BACKEND}/api/recorder/start`)
+    }
+    stopRecording = () => {
+        fetch(`${BACKEND}/api/recorder/stop`)
+    }
+
     nextBoard = () => {
         MatrixPostRet("matrix.v1.Sportsmatrix/NextBoard", '{}')
     }
@@ -107,6 +169,13 @@ class Home extends React.Component {
     render() {
         return (
             <Container fluid>
+                {this.state.restartRequired.length > 0 &&
+                    <Row className="text-left">
+                        <Col>
+                            <Alert variant="warning">Config changes require a restart: {this.state.restartRequired.join(", ")}</Alert>
+                        </Col>
+                    </Row>
+                }
                 <Row className="text-left">
                     <Col>
                         <Form.Switch id="screen" label="Screen On/Off" checked={this.state.status.getScreenOn()}
@@ -130,6 +199,30 @@ class Home extends React.Component {
                         <Button variant="primary" onClick={this.nextBoard}>Next Board</Button>
                     </Col>
                 </Row>
+                {this.state.brightness > 0 &&
+                    <Row className="text-left">
+                        <Col>
+                            <Form.Label htmlFor="brightness">Brightness: {this.state.brightness}%</Form.Label>
+                            <Form.Range id="brightness" min={1} max={100} value={this.state.brightness}
+                                onChange={(e) => this.setState({ "brightness": parseInt(e.target.value) })}
+                                onMouseUp={(e) => this.setBrightness(parseInt(e.target.value), false)}
+                                onTouchEnd={(e) => this.setBrightness(parseInt(e.target.value), false)} />
+                            <Form.Switch id="autobrightness" checked={this.state.autoBrightness}
+                                label={this.state.sunset ? `Auto Brightness (sunset ${this.state.sunset}, sunrise ${this.state.sunrise})` : "Auto Brightness"}
+                                onChange={() => this.setBrightness(this.state.brightness, !this.state.autoBrightness)} />
+                        </Col>
+                    </Row>}
+                {this.state.playlists.length > 0 &&
+                    <Row className="text-left">
+                        <Col>
+                            <Form.Label htmlFor="playlist">Playlist (playing: {this.state.activePlaylist})</Form.Label>
+                            <Form.Control as="select" id="playlist" value={this.state.selectedPlaylist}
+                                onChange={(e) => this.setPlaylist(e.target.value)}>
+                                <option value="">Scheduled</option>
+                                {this.state.playlists.map((name) => <option key={name} value={name}>{name}</option>)}
+                            </Form.Control>
+                        </Col>
+                    </Row>}
                 <Row>
                     <Col>
                         <Button variant="primary" onClick={() => this.handleLiveOnlySwitch(true)}>Live Only</Button>
@@ -154,6 +247,21 @@ class Home extends React.Component {
                         <Button variant="primary" onClick={this.slowDown}>Slow Down</Button>
                     </Col>
                 </Row>
+                <Row className="text-left">
+                    <Col>
+                        <Button variant="primary" onClick={this.startRecording}>Start Recording</Button>
+                    </Col>
+                    <Col>
+                        <Button variant="primary" onClick={this.stopRecording}>Stop Recording</Button>
+                    </Col>
+                </Row>
+                <Row className="text-left">
+                    <Col>
+                        Download recording: <a href={`${BACKEND}/api/recorder/download?format=gif`}>GIF</a>{' '}
+                        <a href={`${BACKEND}/api/recorder/download?format=apng`}>APNG</a>{' '}
+                        <a href={`${BACKEND}/api/recorder/download?format=frames`}>PNG frames</a>
+                    </Col>
+                </Row>
                 <Row className="text-left">
                     <Col>
                         <Button variant="danger" onClick={this.restartMatrix} disabled={this.state.loading}>
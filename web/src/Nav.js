@@ -58,9 +58,23 @@ class TopNav extends React.Component {
                             </NavDropDown>
                             <Nav.Link as={Link} to="/stocks">Stocks</Nav.Link>
                             <Nav.Link as={Link} to="/weather">Weather</Nav.Link>
+                            <Nav.Link as={Link} to="/fantasy">Fantasy</Nav.Link>
+                            <NavDropDown bg="dark" variant="dark" title="Golf" id="golf-drop">
+                                <NavDropDown.Item as={Link} to="/golf/pga">PGA Tour</NavDropDown.Item>
+                                <NavDropDown.Item as={Link} to="/golf/lpga">LPGA</NavDropDown.Item>
+                                <NavDropDown.Item as={Link} to="/golf/dpworld">DP World Tour</NavDropDown.Item>
+                                <NavDropDown.Item as={Link} to="/golf/champions">PGA Tour Champions</NavDropDown.Item>
+                            </NavDropDown>
+                            <NavDropDown bg="dark" variant="dark" title="Tennis" id="tennis-drop">
+                                <NavDropDown.Item as={Link} to="/atp">ATP</NavDropDown.Item>
+                                <NavDropDown.Item as={Link} to="/wta">WTA</NavDropDown.Item>
+                            </NavDropDown>
                             <NavDropDown bg="dark" variant="dark" title="Racing" id="racing-drop">
                                 <NavDropDown.Item as={Link} to="/f1">F1</NavDropDown.Item>
                                 <NavDropDown.Item as={Link} to="/irl">IndyCar</NavDropDown.Item>
+                                <NavDropDown.Item as={Link} to="/nascar">NASCAR Cup</NavDropDown.Item>
+                                <NavDropDown.Item as={Link} to="/xfinity">NASCAR Xfinity</NavDropDown.Item>
+                                <NavDropDown.Item as={Link} to="/motogp">MotoGP</NavDropDown.Item>
                             </NavDropDown>
                             <NavDropDown bg="dark" variant="dark" title="Misc" id="misc-drop">
                                 <NavDropDown.Item as={Link} to="/img">Image Board</NavDropDown.Item>
@@ -39,7 +39,9 @@ class Sport extends React.Component {
             "status": status,
             "stats": new basicboard_pb.Status(),
             "headlines": new basicboard_pb.Status(),
+            "standings": new basicboard_pb.Status(),
             "has_stats": false,
+            "has_standings": false,
         };
         if (this.props.sport === "nhl") {
             console.log("Sport created ", this.props.sport, this.state.status)
@@ -115,6 +117,29 @@ class Sport extends React.Component {
                 "has_headlines": false,
             });
         });
+
+        await MatrixPostRet("standings/" + this.props.sport + "/board.v1.BasicBoard/GetStatus", '{}').then((resp) => {
+            if (resp.ok) {
+                return resp.text();
+            }
+            throw resp;
+        }).then((data) => {
+            try {
+                var dat = JSONToStatus(data);
+                this.setState({
+                    "standings": dat,
+                    "has_standings": true,
+                })
+            } catch (e) {
+                this.setState({
+                    "has_standings": false,
+                });
+            }
+        }).catch(error => {
+            this.setState({
+                "has_standings": false,
+            });
+        });
     }
 
     updateStatus = async () => {
@@ -129,6 +154,12 @@ class Sport extends React.Component {
         var hreq = new basicboard_pb.SetStatusReq();
         hreq.setStatus(this.state.headlines);
         await MatrixPostRet("headlines/" + this.props.sport + "/board.v1.BasicBoard/SetStatus", JSON.stringify(hreq.toObject()));
+
+        if (this.state.has_standings) {
+            var streq = new basicboard_pb.SetStatusReq();
+            streq.setStatus(this.state.standings);
+            await MatrixPostRet("standings/" + this.props.sport + "/board.v1.BasicBoard/SetStatus", JSON.stringify(streq.toObject()));
+        }
         await this.getStatus();
     }
 
@@ -179,6 +210,18 @@ class Sport extends React.Component {
                             onChange={() => { this.state.headlines.setEnabled(!this.state.headlines.getEnabled()); this.updateStatus(); }} />
                     </Col>
                 </Row>
+                <Row className="text-left">
+                    <Col>
+                        <Form.Switch id={this.props.sport + "standings"} label="Standings" checked={this.state.standings.getEnabled()} disabled={!this.state.has_standings}
+                            onChange={() => { this.state.standings.setEnabled(!this.state.standings.getEnabled()); this.updateStatus(); }} />
+                    </Col>
+                </Row>
+                <Row className="text-left">
+                    <Col>
+                        <Form.Switch id={this.props.sport + "standingsscroll"} label="Standings Scroll Mode" checked={this.state.standings.getScrollEnabled()} disabled={!this.state.has_standings}
+                            onChange={() => { this.state.standings.setScrollEnabled(!this.state.standings.getScrollEnabled()); this.updateStatus(); }} />
+                    </Col>
+                </Row>
                 <Row className="text-left">
                     <Col>
                         <Form.Switch id={this.props.sport + "statscroll"} label="Stats Scroll Mode" checked={this.state.stats.getScrollEnabled()} disabled={!this.state.has_stats}